@@ -0,0 +1,13 @@
+data "ovh_cloud_project_kube" "my_kube_cluster" {
+  service_name = "XXXXXX"
+  name         = "my_kube_cluster"
+  region       = "GRA11"
+}
+
+output "kube_id" {
+  value = data.ovh_cloud_project_kube.my_kube_cluster.kube_id
+}
+
+output "nodepools" {
+  value = [for pool in data.ovh_cloud_project_kube.my_kube_cluster.nodepools : "${pool.name}: ${pool.current_nodes}/${pool.desired_nodes}"]
+}
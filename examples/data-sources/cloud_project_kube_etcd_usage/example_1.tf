@@ -0,0 +1,17 @@
+data "ovh_cloud_project_kube_etcd_usage" "usage" {
+  service_name = "XXXXXX"
+  kube_id      = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+
+  # Fail the plan before the etcd quota is reached, as the cluster refuses
+  # all writes once it is full
+  lifecycle {
+    postcondition {
+      condition     = self.usage_percent < 80
+      error_message = "etcd usage of the cluster is above 80% of its quota."
+    }
+  }
+}
+
+output "etcd-usage" {
+  value = "${data.ovh_cloud_project_kube_etcd_usage.usage.usage} / ${data.ovh_cloud_project_kube_etcd_usage.usage.quota} bytes"
+}
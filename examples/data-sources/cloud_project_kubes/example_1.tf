@@ -0,0 +1,10 @@
+data "ovh_cloud_project_kubes" "kubes" {
+  service_name = "XXXXXX"
+  region       = "GRA11"
+  version      = "1.31"
+  status       = "READY"
+}
+
+output "kube_ids" {
+  value = { for kube in data.ovh_cloud_project_kubes.kubes.kubes : kube.name => kube.id }
+}
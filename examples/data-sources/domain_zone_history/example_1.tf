@@ -0,0 +1,8 @@
+data "ovh_domain_zone_history" "history" {
+  zone_name          = "mysite.ovh"
+  creation_date_from = "2026-01-01T00:00:00Z"
+}
+
+output "latest_zone_file_url" {
+  value = data.ovh_domain_zone_history.history.history[length(data.ovh_domain_zone_history.history.history) - 1].zone_file_url
+}
@@ -0,0 +1,11 @@
+data "ovh_ip_antihack" "relay" {
+  ip         = "192.0.2.0/29"
+  ip_blocked = "192.0.2.1"
+
+  lifecycle {
+    postcondition {
+      condition     = !self.blocked
+      error_message = "Mail relay ${self.ip_blocked} is blocked by the anti-hack since ${self.blocked_since}"
+    }
+  }
+}
@@ -0,0 +1,11 @@
+data "ovh_ip_spam" "relay" {
+  ip          = "192.0.2.0/29"
+  ip_spamming = "192.0.2.1"
+
+  lifecycle {
+    postcondition {
+      condition     = !self.blocked
+      error_message = "Mail relay ${self.ip_spamming} is blocked for spam since ${self.date}"
+    }
+  }
+}
@@ -0,0 +1,9 @@
+data "ovh_iploadbalancing_farm_health" "api" {
+  service_name = "loadbalancer-xxxxxxxxxxxxxxxxxx"
+  farm_type    = "http"
+  farm_id      = 1234
+}
+
+output "api_unhealthy_servers" {
+  value = [for server in data.ovh_iploadbalancing_farm_health.api.servers : server.address if server.healthy == false]
+}
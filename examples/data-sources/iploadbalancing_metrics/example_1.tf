@@ -0,0 +1,11 @@
+data "ovh_iploadbalancing_metrics" "metrics" {
+  service_name = "ip-1.2.3.4"
+}
+
+output "prometheus_remote_read" {
+  value = {
+    url          = "${data.ovh_iploadbalancing_metrics.metrics.endpoint}/api/v1/read"
+    bearer_token = data.ovh_iploadbalancing_metrics.metrics.token
+  }
+  sensitive = true
+}
@@ -0,0 +1,20 @@
+resource "ovh_cloud_project_containerregistry_user" "admin" {
+  service_name = "XXXXXX"
+  registry_id  = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxx"
+  email        = "admin@example.com"
+  login        = "terraform"
+}
+
+resource "ovh_cloud_project_containerregistry_gc" "gc" {
+  service_name    = ovh_cloud_project_containerregistry_user.admin.service_name
+  registry_id     = ovh_cloud_project_containerregistry_user.admin.registry_id
+  username        = ovh_cloud_project_containerregistry_user.admin.user
+  password        = ovh_cloud_project_containerregistry_user.admin.password
+  schedule_type   = "Custom"
+  cron            = "0 0 3 * * 6"
+  delete_untagged = true
+}
+
+output "registry_storage_usage_percent" {
+  value = ovh_cloud_project_containerregistry_gc.gc.storage_usage_percent
+}
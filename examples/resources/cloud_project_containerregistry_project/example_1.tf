@@ -0,0 +1,18 @@
+resource "ovh_cloud_project_containerregistry_user" "admin" {
+  service_name = "XXXXXX"
+  registry_id  = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxx"
+  email        = "admin@example.com"
+  login        = "terraform"
+}
+
+resource "ovh_cloud_project_containerregistry_project" "apps" {
+  service_name       = ovh_cloud_project_containerregistry_user.admin.service_name
+  registry_id        = ovh_cloud_project_containerregistry_user.admin.registry_id
+  username           = ovh_cloud_project_containerregistry_user.admin.user
+  password           = ovh_cloud_project_containerregistry_user.admin.password
+  name               = "apps"
+  storage_limit      = 10 * 1024 * 1024 * 1024
+  auto_scan          = true
+  prevent_vulnerable = true
+  severity           = "high"
+}
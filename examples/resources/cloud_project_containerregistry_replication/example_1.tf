@@ -0,0 +1,26 @@
+resource "ovh_cloud_project_containerregistry_user" "admin" {
+  service_name = "XXXXXX"
+  registry_id  = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxx"
+  email        = "admin@example.com"
+  login        = "terraform"
+}
+
+resource "ovh_cloud_project_containerregistry_replication" "alpine" {
+  service_name   = ovh_cloud_project_containerregistry_user.admin.service_name
+  registry_id    = ovh_cloud_project_containerregistry_user.admin.registry_id
+  username       = ovh_cloud_project_containerregistry_user.admin.user
+  password       = ovh_cloud_project_containerregistry_user.admin.password
+  name           = "mirror-alpine"
+  direction      = "pull"
+  name_filter    = "library/alpine"
+  tag_filter     = "3.*"
+  dest_namespace = "mirror"
+  trigger        = "scheduled"
+  cron           = "0 0 3 * * *"
+
+  remote_registry {
+    name = "docker-hub"
+    type = "docker-hub"
+    url  = "https://hub.docker.com"
+  }
+}
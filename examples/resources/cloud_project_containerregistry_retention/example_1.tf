@@ -0,0 +1,28 @@
+resource "ovh_cloud_project_containerregistry_user" "admin" {
+  service_name = "XXXXXX"
+  registry_id  = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxx"
+  email        = "admin@example.com"
+  login        = "terraform"
+}
+
+resource "ovh_cloud_project_containerregistry_retention" "apps" {
+  service_name = ovh_cloud_project_containerregistry_user.admin.service_name
+  registry_id  = ovh_cloud_project_containerregistry_user.admin.registry_id
+  username     = ovh_cloud_project_containerregistry_user.admin.user
+  password     = ovh_cloud_project_containerregistry_user.admin.password
+  project_name = "apps"
+  schedule     = "0 0 0 * * *"
+
+  # Keep the 10 most recently pushed artifacts of each repository
+  rule {
+    template = "latestPushedK"
+    count    = 10
+  }
+
+  # Always keep the releases
+  rule {
+    template    = "always"
+    tag_pattern = "v*"
+    untagged    = false
+  }
+}
@@ -0,0 +1,37 @@
+resource "ovh_cloud_project_containerregistry_user" "admin" {
+  service_name = "XXXXXX"
+  registry_id  = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxx"
+  email        = "admin@example.com"
+  login        = "terraform"
+}
+
+resource "ovh_cloud_project_containerregistry_robot" "ci" {
+  service_name = ovh_cloud_project_containerregistry_user.admin.service_name
+  registry_id  = ovh_cloud_project_containerregistry_user.admin.registry_id
+  username     = ovh_cloud_project_containerregistry_user.admin.user
+  password     = ovh_cloud_project_containerregistry_user.admin.password
+  name         = "ci"
+  description  = "Push images from the CI pipelines"
+
+  permission {
+    namespace = "apps"
+
+    access {
+      resource = "repository"
+      action   = "pull"
+    }
+    access {
+      resource = "repository"
+      action   = "push"
+    }
+  }
+}
+
+output "robot_login" {
+  value = ovh_cloud_project_containerregistry_robot.ci.full_name
+}
+
+output "robot_secret" {
+  value     = ovh_cloud_project_containerregistry_robot.ci.secret
+  sensitive = true
+}
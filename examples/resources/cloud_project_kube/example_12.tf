@@ -0,0 +1,10 @@
+resource "ovh_cloud_project_kube" "my_kube_cluster" {
+  service_name = "<public cloud project ID>"
+  name         = "my_kube_cluster"
+  region       = "GRA11"
+  version      = "1.32" # was 1.30, upgraded to 1.31 then 1.32
+
+  upgrade {
+    staged = true
+  }
+}
@@ -0,0 +1,12 @@
+# Rotates every 3 months, see https://registry.terraform.io/providers/hashicorp/time/latest/docs/resources/rotating
+resource "time_rotating" "kubeconfig" {
+  rotation_months = 3
+}
+
+resource "ovh_cloud_project_kube" "my_kube_cluster" {
+  service_name = "<public cloud project ID>"
+  name         = "my_kube_cluster"
+  region       = "GRA11"
+
+  kubeconfig_rotation = time_rotating.kubeconfig.id
+}
@@ -0,0 +1,39 @@
+resource "ovh_cloud_project_kube_backup_target" "velero" {
+  service_name = "<public cloud project ID>"
+  region       = "GRA"
+  bucket_name  = "my-cluster-backups"
+}
+
+resource "helm_release" "velero" {
+  name             = "velero"
+  repository       = "https://vmware-tanzu.github.io/helm-charts"
+  chart            = "velero"
+  namespace        = "velero"
+  create_namespace = true
+
+  values = [yamlencode({
+    initContainers = [{
+      name         = "velero-plugin-for-aws"
+      image        = "velero/velero-plugin-for-aws:v1.10.0"
+      volumeMounts = [{ mountPath = "/target", name = "plugins" }]
+    }]
+    configuration = {
+      backupStorageLocation = [{
+        name     = "default"
+        provider = "aws"
+        bucket   = ovh_cloud_project_kube_backup_target.velero.bucket_name
+        config = {
+          region           = ovh_cloud_project_kube_backup_target.velero.s3_region
+          s3Url            = ovh_cloud_project_kube_backup_target.velero.s3_endpoint
+          s3ForcePathStyle = "true"
+        }
+      }]
+    }
+    credentials = {
+      secretContents = {
+        cloud = ovh_cloud_project_kube_backup_target.velero.velero_credentials
+      }
+    }
+    snapshotsEnabled = false
+  })]
+}
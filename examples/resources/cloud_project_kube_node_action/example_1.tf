@@ -0,0 +1,13 @@
+resource "ovh_cloud_project_kube_node_action" "recycle" {
+  service_name  = "<public cloud project ID>"
+  kube_id       = "<kube cluster ID>"
+  node_name     = "my-pool-node-1bb290"
+  action        = "reinstall"
+  drain         = true
+  drain_timeout = "10m"
+
+  # Change the value to reinstall the node again
+  triggers = {
+    kernel_cve = "CVE-2024-1086"
+  }
+}
@@ -0,0 +1,35 @@
+resource "ovh_cloud_project_kube_nodepool" "pool" {
+  service_name  = "xxxxxxxxxxxxxxxxxxxxxxxxxxxxxx"
+  kube_id       = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  name          = "my-pool" //Warning: "_" char is not allowed!
+  flavor_name   = "b3-8"
+  desired_nodes = 3
+  max_nodes     = 4
+  min_nodes     = 3
+  template {
+    metadata {
+      annotations = {}
+      finalizers  = []
+      labels = {
+        role = "backend"
+      }
+    }
+    spec {
+      unschedulable = false
+      taints = [
+        {
+          effect = "NoSchedule"
+          key    = "role"
+          value  = "backend"
+        }
+      ]
+    }
+  }
+
+  // Replace the existing nodes, one at a time, when the template changes
+  template_rollout {
+    max_unavailable = 1
+    drain           = true
+    drain_timeout   = "10m"
+  }
+}
@@ -0,0 +1,17 @@
+resource "time_rotating" "rancher_admin" {
+  rotation_days = 90
+}
+
+resource "ovh_cloud_project_rancher_admin_credentials" "admin" {
+  project_id = "<public cloud project ID>"
+  rancher_id = "<managed Rancher ID>"
+
+  triggers = {
+    rotation = time_rotating.rancher_admin.id
+  }
+}
+
+output "rancher_admin_password" {
+  value     = ovh_cloud_project_rancher_admin_credentials.admin.password
+  sensitive = true
+}
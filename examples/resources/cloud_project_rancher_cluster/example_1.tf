@@ -0,0 +1,12 @@
+resource "ovh_cloud_project_rancher_admin_credentials" "admin" {
+  project_id = "<public cloud project ID>"
+  rancher_id = "<managed Rancher ID>"
+}
+
+resource "ovh_cloud_project_rancher_cluster" "cluster" {
+  project_id = ovh_cloud_project_rancher_admin_credentials.admin.project_id
+  rancher_id = ovh_cloud_project_rancher_admin_credentials.admin.rancher_id
+  api_token  = ovh_cloud_project_rancher_admin_credentials.admin.api_token
+  kube_id    = "<Managed Kubernetes Service cluster ID>"
+  name       = "my-kube"
+}
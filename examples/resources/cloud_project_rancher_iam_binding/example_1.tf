@@ -0,0 +1,22 @@
+resource "ovh_cloud_project_rancher" "rancher" {
+  project_id = "<public cloud project ID>"
+  target_spec = {
+    name             = "my-rancher"
+    plan             = "STANDARD"
+    iam_auth_enabled = true
+  }
+}
+
+resource "ovh_cloud_project_rancher_admin_credentials" "admin" {
+  project_id = ovh_cloud_project_rancher.rancher.project_id
+  rancher_id = ovh_cloud_project_rancher.rancher.id
+}
+
+resource "ovh_cloud_project_rancher_iam_binding" "ops" {
+  project_id     = ovh_cloud_project_rancher_admin_credentials.admin.project_id
+  rancher_id     = ovh_cloud_project_rancher_admin_credentials.admin.rancher_id
+  api_token      = ovh_cloud_project_rancher_admin_credentials.admin.api_token
+  principal_id   = "genericoidc_group://ops"
+  principal_type = "group"
+  global_role_id = "restricted-admin"
+}
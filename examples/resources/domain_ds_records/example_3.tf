@@ -0,0 +1,12 @@
+resource "ovh_domain_ds_records" "ds_records" {
+  domain        = "mydomain.ovh"
+  rollover_mode = "staged"
+  rollover_wait = "48h"
+
+  ds_records {
+    algorithm  = "ECDSAP256SHA256"
+    flags      = "KEY_SIGNING_KEY"
+    public_key = "my_new_base64_encoded_public_key"
+    tag        = 23456
+  }
+}
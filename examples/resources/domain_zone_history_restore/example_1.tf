@@ -0,0 +1,8 @@
+data "ovh_domain_zone_history" "history" {
+  zone_name = "mysite.ovh"
+}
+
+resource "ovh_domain_zone_history_restore" "rollback" {
+  zone_name     = data.ovh_domain_zone_history.history.zone_name
+  creation_date = data.ovh_domain_zone_history.history.history[length(data.ovh_domain_zone_history.history.history) - 2].creation_date
+}
@@ -0,0 +1,9 @@
+resource "ovh_domain_zone_soa" "soa" {
+  zone_name     = "mysite.ovh"
+  email         = "hostmaster.mysite.ovh"
+  ttl           = 3600
+  refresh       = 86400
+  retry         = 3600
+  expire        = 3600000
+  nx_domain_ttl = 300
+}
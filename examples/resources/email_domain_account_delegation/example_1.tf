@@ -0,0 +1,5 @@
+resource "ovh_email_domain_account_delegation" "delegation" {
+  domain       = "mydomain.ovh"
+  account_name = "contact"
+  account_id   = "xx1111-ovh"
+}
@@ -0,0 +1,11 @@
+resource "ovh_email_domain_account_filter" "invoices" {
+  domain       = "mydomain.ovh"
+  account_name = "contact"
+  name         = "invoices"
+  priority     = 1
+  action       = "redirect"
+  action_param = "accounting@mydomain.ovh"
+  header       = "Subject"
+  operand      = "contains"
+  value        = "invoice"
+}
@@ -0,0 +1,9 @@
+resource "ovh_email_domain_mailing_list" "team" {
+  domain                 = "mydomain.ovh"
+  name                   = "team"
+  language               = "en"
+  owner_email            = "owner@mydomain.ovh"
+  moderator_message      = false
+  subscribe_by_moderator = true
+  users_post_only        = true
+}
@@ -0,0 +1,14 @@
+resource "ovh_email_domain_mailing_list" "team" {
+  domain      = "mydomain.ovh"
+  name        = "team"
+  language    = "en"
+  owner_email = "owner@mydomain.ovh"
+}
+
+resource "ovh_email_domain_mailing_list_subscriber" "subscribers" {
+  for_each = toset(["alice@example.com", "bob@example.com"])
+
+  domain            = ovh_email_domain_mailing_list.team.domain
+  mailing_list_name = ovh_email_domain_mailing_list.team.name
+  email             = each.value
+}
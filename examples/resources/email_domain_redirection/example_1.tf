@@ -0,0 +1,6 @@
+resource "ovh_email_domain_redirection" "contact" {
+  domain     = "mydomain.ovh"
+  from       = "contact@mydomain.ovh"
+  to         = "john.doe@example.com"
+  local_copy = false
+}
@@ -0,0 +1,8 @@
+resource "ovh_email_domain_responder" "holidays" {
+  domain       = "mydomain.ovh"
+  account_name = "contact"
+  content      = "I am on holidays until the end of August."
+  copy         = true
+  from         = "2026-08-01T00:00:00+02:00"
+  to           = "2026-08-31T23:59:59+02:00"
+}
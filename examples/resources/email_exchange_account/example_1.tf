@@ -0,0 +1,12 @@
+resource "ovh_email_exchange_account" "john" {
+  organization_name = "hosted-xx11111-1"
+  exchange_service  = "hosted-xx11111-1"
+  login             = "john.doe"
+  domain            = "mydomain.ovh"
+  license           = "standard"
+  password          = var.john_password
+  display_name      = "John Doe"
+  first_name        = "John"
+  last_name         = "Doe"
+  aliases           = ["john@mydomain.ovh", "jd@mydomain.ovh"]
+}
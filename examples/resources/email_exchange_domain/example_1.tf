@@ -0,0 +1,8 @@
+resource "ovh_email_exchange_domain" "domain" {
+  organization_name      = "hosted-xx11111-1"
+  exchange_service       = "hosted-xx11111-1"
+  name                   = "mydomain.ovh"
+  type                   = "authoritative"
+  configure_mx           = true
+  configure_autodiscover = true
+}
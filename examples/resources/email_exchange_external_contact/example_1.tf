@@ -0,0 +1,8 @@
+resource "ovh_email_exchange_external_contact" "partner" {
+  organization_name      = "hosted-xx11111-1"
+  exchange_service       = "hosted-xx11111-1"
+  external_email_address = "jane.doe@partner.com"
+  display_name           = "Jane Doe (Partner)"
+  first_name             = "Jane"
+  last_name              = "Doe"
+}
@@ -0,0 +1,8 @@
+resource "ovh_email_exchange_mailing_list" "sales" {
+  organization_name    = "hosted-xx11111-1"
+  exchange_service     = "hosted-xx11111-1"
+  mailing_list_address = "sales@mydomain.ovh"
+  display_name         = "Sales team"
+  depart_restriction   = "closed"
+  join_restriction     = "approvalRequired"
+}
@@ -0,0 +1,7 @@
+resource "ovh_email_exchange_shared_account" "support" {
+  organization_name    = "hosted-xx11111-1"
+  exchange_service     = "hosted-xx11111-1"
+  shared_email_address = "support@mydomain.ovh"
+  display_name         = "Support"
+  quota                = 10240
+}
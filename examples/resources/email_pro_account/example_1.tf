@@ -0,0 +1,8 @@
+resource "ovh_email_pro_account" "john" {
+  service_name = "emailpro-xx11111-1"
+  login        = "john.doe"
+  domain       = "mydomain.ovh"
+  password     = var.john_password
+  display_name = "John Doe"
+  aliases      = ["john@mydomain.ovh"]
+}
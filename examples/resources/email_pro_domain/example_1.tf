@@ -0,0 +1,7 @@
+resource "ovh_email_pro_domain" "domain" {
+  service_name           = "emailpro-xx11111-1"
+  name                   = "mydomain.ovh"
+  type                   = "authoritative"
+  configure_mx           = true
+  configure_autodiscover = true
+}
@@ -0,0 +1,7 @@
+resource "ovh_email_pro_external_contact" "partner" {
+  service_name           = "emailpro-xx11111-1"
+  external_email_address = "jane.doe@partner.com"
+  display_name           = "Jane Doe (Partner)"
+  first_name             = "Jane"
+  last_name              = "Doe"
+}
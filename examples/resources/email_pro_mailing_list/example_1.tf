@@ -0,0 +1,7 @@
+resource "ovh_email_pro_mailing_list" "sales" {
+  service_name         = "emailpro-xx11111-1"
+  mailing_list_address = "sales@mydomain.ovh"
+  display_name         = "Sales team"
+  depart_restriction   = "closed"
+  join_restriction     = "approvalRequired"
+}
@@ -0,0 +1,6 @@
+resource "ovh_email_pro_shared_account" "support" {
+  service_name         = "emailpro-xx11111-1"
+  shared_email_address = "support@mydomain.ovh"
+  display_name         = "Support"
+  quota                = 10240
+}
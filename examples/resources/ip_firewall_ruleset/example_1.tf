@@ -0,0 +1,33 @@
+resource "ovh_ip_firewall" "firewall" {
+  ip             = "XXXXXX"
+  ip_on_firewall = "XXXXXX"
+  enabled        = true
+}
+
+resource "ovh_ip_firewall_ruleset" "rules" {
+  ip             = ovh_ip_firewall.firewall.ip
+  ip_on_firewall = ovh_ip_firewall.firewall.ip_on_firewall
+
+  disable_firewall_during_update = true
+
+  rules = [
+    {
+      sequence         = 0
+      action           = "permit"
+      protocol         = "tcp"
+      source           = "192.0.2.0/24"
+      destination_port = 22
+    },
+    {
+      sequence   = 1
+      action     = "permit"
+      protocol   = "tcp"
+      tcp_option = "established"
+    },
+    {
+      sequence = 19
+      action   = "deny"
+      protocol = "ipv4"
+    },
+  ]
+}
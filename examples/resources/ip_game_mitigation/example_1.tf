@@ -0,0 +1,5 @@
+resource "ovh_ip_game_mitigation" "game" {
+  ip                    = "192.0.2.0/29"
+  ip_on_game            = "192.0.2.1"
+  firewall_mode_enabled = true
+}
@@ -0,0 +1,20 @@
+resource "ovh_ip_game_mitigation" "game" {
+  ip                    = "192.0.2.0/29"
+  ip_on_game            = "192.0.2.1"
+  firewall_mode_enabled = true
+}
+
+resource "ovh_ip_game_rule" "minecraft" {
+  ip         = ovh_ip_game_mitigation.game.ip
+  ip_on_game = ovh_ip_game_mitigation.game.ip_on_game
+  protocol   = "minecraftQuery"
+  port_from  = 25565
+}
+
+resource "ovh_ip_game_rule" "teamspeak" {
+  ip         = ovh_ip_game_mitigation.game.ip
+  ip_on_game = ovh_ip_game_mitigation.game.ip_on_game
+  protocol   = "teamspeak3"
+  port_from  = 9987
+  port_to    = 9990
+}
@@ -0,0 +1,11 @@
+# Set mail-1.example.com. to mail-254.example.com. on the addresses of the /24,
+# except on 192.0.2.10 that uses its own reverse
+resource "ovh_ip_reverse_block" "mail" {
+  ip       = "192.0.2.0/24"
+  template = "mail-{d}.example.com"
+  resolver = "1.1.1.1:53"
+
+  reverses = {
+    "192.0.2.10" = "mx.example.com"
+  }
+}
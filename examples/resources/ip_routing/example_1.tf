@@ -0,0 +1,8 @@
+resource "ovh_ip_routing" "web" {
+  ip              = "192.0.2.0/29"
+  destination_urn = "urn:v1:eu:resource:dedicatedServer:ns1234.ip-192-0-2.eu"
+}
+
+output "web_virtual_mac" {
+  value = ovh_ip_routing.web.virtual_mac
+}
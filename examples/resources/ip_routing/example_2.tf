@@ -0,0 +1,4 @@
+resource "ovh_ip_routing" "failover" {
+  ip              = "192.0.2.8/32"
+  destination_urn = "urn:v1:eu:resource:publicCloudProject:${var.project_id}/instance/${ovh_cloud_project_instance.web.id}"
+}
@@ -0,0 +1,16 @@
+data "ovh_ip_spam" "relay" {
+  ip          = "192.0.2.0/29"
+  ip_spamming = "192.0.2.1"
+}
+
+resource "ovh_ip_unblock" "relay" {
+  count = data.ovh_ip_spam.relay.blocked ? 1 : 0
+
+  ip         = data.ovh_ip_spam.relay.ip
+  ip_blocked = data.ovh_ip_spam.relay.ip_spamming
+  type       = "spam"
+
+  triggers = {
+    blocked_on = data.ovh_ip_spam.relay.date
+  }
+}
@@ -0,0 +1,19 @@
+data "ovh_iploadbalancing" "lb" {
+  service_name = "ip-1.2.3.4"
+  state        = "ok"
+}
+
+resource "ovh_iploadbalancing_free_certificate" "cert" {
+  service_name = data.ovh_iploadbalancing.lb.service_name
+  fqdn         = ["www.example.com", "example.com"]
+  display_name = "www.example.com"
+}
+
+resource "ovh_iploadbalancing_http_frontend" "https" {
+  service_name   = data.ovh_iploadbalancing.lb.service_name
+  display_name   = "https"
+  zone           = "all"
+  port           = "443"
+  ssl            = true
+  default_ssl_id = ovh_iploadbalancing_free_certificate.cert.id
+}
@@ -0,0 +1,23 @@
+resource "ovh_iploadbalancing_http_route" "api" {
+  service_name = "loadbalancer-xxxxxxxxxxxxxxxxxx"
+  display_name = "Route API calls to the API farm"
+  frontend_id  = ovh_iploadbalancing_http_frontend.front.id
+  weight       = 10
+
+  action {
+    type   = "farm"
+    target = ovh_iploadbalancing_http_farm.api.id
+  }
+
+  rules {
+    field   = "host"
+    match   = "is"
+    pattern = "api.example.com"
+  }
+
+  rules {
+    field     = "header"
+    match     = "exists"
+    sub_field = "Authorization"
+  }
+}
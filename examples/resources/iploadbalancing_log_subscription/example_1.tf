@@ -0,0 +1,15 @@
+data "ovh_iploadbalancing" "iplb" {
+  service_name = "ip-1.2.3.4"
+}
+
+resource "ovh_dbaas_logs_output_graylog_stream" "stream" {
+  service_name = "ldp-xx-xxxxx"
+  title        = "iplb-access-logs"
+  description  = "Access logs of my IP load balancing"
+}
+
+resource "ovh_iploadbalancing_log_subscription" "subscription" {
+  service_name = data.ovh_iploadbalancing.iplb.service_name
+  kind         = "haproxy"
+  stream_id    = ovh_dbaas_logs_output_graylog_stream.stream.stream_id
+}
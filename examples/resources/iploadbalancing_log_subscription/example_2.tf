@@ -0,0 +1,8 @@
+resource "ovh_iploadbalancing_log_subscription" "subscription" {
+  # ...
+
+  timeouts {
+    create = "30m"
+    delete = "30m"
+  }
+}
@@ -40,6 +40,10 @@ type Config struct {
 	// Ignore initialization errors
 	IgnoreInitError bool
 
+	// How pending changes of IP load balancers are applied
+	IpLoadbalancingRefreshMode string
+	ipLoadbalancingRefresher   *iploadbalancingRefresher
+
 	OVHClient     *ovhwrap.Client
 	authenticated bool
 	authFailed    error
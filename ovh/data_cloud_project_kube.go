@@ -19,12 +19,15 @@ func dataSourceCloudProjectKube() *schema.Resource {
 				DefaultFunc: schema.EnvDefaultFunc("OVH_CLOUD_PROJECT_SERVICE", nil),
 			},
 			kubeKubeIdKey: {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				AtLeastOneOf: []string{kubeKubeIdKey, kubeNameKey},
 			},
 			kubeNameKey: {
 				Type:     schema.TypeString,
 				Optional: true,
+				Computed: true,
 			},
 			kubeVersionKey: {
 				Type:     schema.TypeString,
@@ -470,6 +473,7 @@ func dataSourceCloudProjectKube() *schema.Resource {
 			kubeRegionKey: {
 				Type:     schema.TypeString,
 				Optional: true,
+				Computed: true,
 			},
 			kubeStatusKey: {
 				Type:     schema.TypeString,
@@ -525,6 +529,7 @@ func dataSourceCloudProjectKube() *schema.Resource {
 					},
 				},
 			},
+			kubeClusterNodePoolsKey: cloudProjectKubeNodePoolsSummarySchema(),
 			kubeClusterIpAllocationPolicyKey: {
 				Description: "IP Allocation policy for the MKS cluster",
 				Optional:    true,
@@ -560,6 +565,35 @@ func dataSourceCloudProjectKubeRead(d *schema.ResourceData, meta interface{}) er
 	serviceName := d.Get(kubeServiceNameKey).(string)
 	kubeId := d.Get(kubeKubeIdKey).(string)
 
+	// Without id, look for the cluster by name, and region when given
+	if kubeId == "" {
+		name := d.Get(kubeNameKey).(string)
+		region := d.Get(kubeRegionKey).(string)
+
+		log.Printf("[DEBUG] Will look for public cloud kube named %s for project: %s", name, serviceName)
+		kubes, err := cloudProjectKubeList(config, serviceName)
+		if err != nil {
+			return err
+		}
+
+		ids := []string{}
+		for _, kube := range kubes {
+			if kube.Matches(name, region, "", "", "") {
+				ids = append(ids, kube.Id)
+			}
+		}
+
+		switch len(ids) {
+		case 0:
+			return fmt.Errorf("no kube named %q found in project %s", name, serviceName)
+		case 1:
+			kubeId = ids[0]
+			d.Set(kubeKubeIdKey, kubeId)
+		default:
+			return fmt.Errorf("%d kubes named %q found in project %s, set %s or %s: %v", len(ids), name, serviceName, kubeRegionKey, kubeKubeIdKey, ids)
+		}
+	}
+
 	log.Printf("[DEBUG] Will read public cloud kube %s for project: %s", kubeId, serviceName)
 
 	res := &CloudProjectKubeResponse{}
@@ -580,6 +614,12 @@ func dataSourceCloudProjectKubeRead(d *schema.ResourceData, meta interface{}) er
 		}
 	}
 
+	nodePools, err := cloudProjectKubeNodePoolsSummary(config, serviceName, kubeId)
+	if err != nil {
+		return err
+	}
+	d.Set(kubeClusterNodePoolsKey, nodePools)
+
 	// add kubeconfig in state
 	if err := dataSourceKubeconfig(d, meta); err != nil {
 		return err
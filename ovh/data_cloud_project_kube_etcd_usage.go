@@ -0,0 +1,72 @@
+package ovh
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceCloudProjectKubeEtcdUsage() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceCloudProjectKubeEtcdUsageRead,
+
+		Schema: map[string]*schema.Schema{
+			kubeServiceNameKey: {
+				Type:        schema.TypeString,
+				Description: "Service name of the resource representing the id of the cloud project.",
+				Required:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OVH_CLOUD_PROJECT_SERVICE", nil),
+			},
+			kubeKubeIdKey: {
+				Type:        schema.TypeString,
+				Description: "Id of the managed kubernetes cluster.",
+				Required:    true,
+			},
+			// Computed
+			kubeEtcdUsageQuotaKey: {
+				Type:        schema.TypeInt,
+				Description: "Quota of the etcd database of the cluster, in bytes.",
+				Computed:    true,
+			},
+			kubeEtcdUsageUsageKey: {
+				Type:        schema.TypeInt,
+				Description: "Current usage of the etcd database of the cluster, in bytes.",
+				Computed:    true,
+			},
+			kubeEtcdUsageUsagePercentKey: {
+				Type:        schema.TypeFloat,
+				Description: "Current usage of the etcd database of the cluster, in percent of the quota.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceCloudProjectKubeEtcdUsageRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	serviceName := d.Get(kubeServiceNameKey).(string)
+	kubeID := d.Get(kubeKubeIdKey).(string)
+
+	endpoint := fmt.Sprintf("/cloud/project/%s/kube/%s/metrics/etcdUsage",
+		url.PathEscape(serviceName),
+		url.PathEscape(kubeID),
+	)
+	res := &CloudProjectKubeEtcdUsageResponse{}
+
+	log.Printf("[DEBUG] Will read etcd usage from kube %s from project %s", kubeID, serviceName)
+	if err := config.OVHClient.GetWithContext(ctx, endpoint, res); err != nil {
+		return diag.Errorf("calling GET %s:\n\t %q", endpoint, err)
+	}
+
+	for k, v := range res.ToMap() {
+		d.Set(k, v)
+	}
+	d.SetId(kubeID)
+
+	log.Printf("[DEBUG] Read etcd usage %+v", res)
+	return nil
+}
@@ -0,0 +1,52 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+var testAccCloudProjectKubeEtcdUsageDataSourceConfig = `
+resource "ovh_cloud_project_kube" "cluster" {
+  service_name = "%s"
+  name         = "%s"
+  region       = "%s"
+}
+
+data "ovh_cloud_project_kube_etcd_usage" "usage" {
+  service_name = ovh_cloud_project_kube.cluster.service_name
+  kube_id      = ovh_cloud_project_kube.cluster.id
+}
+`
+
+func TestAccCloudProjectKubeEtcdUsageDataSource_basic(t *testing.T) {
+	name := acctest.RandomWithPrefix(test_prefix)
+	region := os.Getenv("OVH_CLOUD_PROJECT_KUBE_REGION_TEST")
+
+	config := fmt.Sprintf(
+		testAccCloudProjectKubeEtcdUsageDataSourceConfig,
+		os.Getenv("OVH_CLOUD_PROJECT_SERVICE_TEST"),
+		name,
+		region,
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckKubernetes(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ovh_cloud_project_kube_etcd_usage.usage", "quota"),
+					resource.TestCheckResourceAttrSet("data.ovh_cloud_project_kube_etcd_usage.usage", "usage"),
+					resource.TestCheckResourceAttrSet("data.ovh_cloud_project_kube_etcd_usage.usage", "usage_percent"),
+				),
+			},
+		},
+	})
+}
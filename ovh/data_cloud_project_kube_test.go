@@ -52,6 +52,39 @@ func TestAccCloudProjectKubeDataSource_basic(t *testing.T) {
 	})
 }
 
+func TestAccCloudProjectKubeDataSource_byName(t *testing.T) {
+	name := acctest.RandomWithPrefix(test_prefix)
+	region := os.Getenv("OVH_CLOUD_PROJECT_KUBE_REGION_TEST")
+	config := fmt.Sprintf(
+		testAccCloudProjectKubeDatasourceByNameConfig,
+		os.Getenv("OVH_CLOUD_PROJECT_SERVICE_TEST"),
+		name,
+		region,
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckCloud(t)
+			testAccCheckCloudProjectExists(t)
+			testAccPreCheckKubernetes(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.ovh_cloud_project_kube.cluster", "kube_id", "ovh_cloud_project_kube.cluster", "id"),
+					resource.TestCheckResourceAttr("data.ovh_cloud_project_kube.cluster", "region", region),
+					resource.TestCheckResourceAttr("data.ovh_cloud_project_kube.cluster", "nodepools.#", "1"),
+					resource.TestCheckResourceAttrPair("data.ovh_cloud_project_kube.cluster", "nodepools.0.id", "ovh_cloud_project_kube_nodepool.pool", "id"),
+					resource.TestCheckResourceAttr("data.ovh_cloud_project_kube.cluster", "nodepools.0.desired_nodes", "1"),
+					resource.TestCheckResourceAttrSet("data.ovh_cloud_project_kube.cluster", "kubeconfig"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccCloudProjectKubeDataSource_kubeProxy(t *testing.T) {
 	name := acctest.RandomWithPrefix(test_prefix)
 	region := os.Getenv("OVH_CLOUD_PROJECT_KUBE_REGION_TEST")
@@ -115,6 +148,30 @@ data "ovh_cloud_project_kube" "cluster" {
 }
 `
 
+var testAccCloudProjectKubeDatasourceByNameConfig = `
+resource "ovh_cloud_project_kube" "cluster" {
+  service_name = "%s"
+  name         = "%s"
+  region       = "%s"
+}
+
+resource "ovh_cloud_project_kube_nodepool" "pool" {
+  service_name  = ovh_cloud_project_kube.cluster.service_name
+  kube_id       = ovh_cloud_project_kube.cluster.id
+  name          = ovh_cloud_project_kube.cluster.name
+  flavor_name   = "b3-8"
+  desired_nodes = 1
+}
+
+data "ovh_cloud_project_kube" "cluster" {
+  service_name = ovh_cloud_project_kube.cluster.service_name
+  name         = ovh_cloud_project_kube.cluster.name
+  region       = ovh_cloud_project_kube.cluster.region
+
+  depends_on = [ovh_cloud_project_kube_nodepool.pool]
+}
+`
+
 var testAccCloudProjectKubeDatasourceKubeProxyConfig = `
 resource "ovh_cloud_project_kube" "cluster" {
 	service_name  = "%s"
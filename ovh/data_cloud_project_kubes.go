@@ -0,0 +1,298 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/ovh/terraform-provider-ovh/v2/ovh/helpers"
+	"github.com/ovh/terraform-provider-ovh/v2/ovh/helpers/hashcode"
+)
+
+func dataSourceCloudProjectKubes() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceCloudProjectKubesRead,
+		Schema: map[string]*schema.Schema{
+			kubeServiceNameKey: {
+				Type:        schema.TypeString,
+				Description: "Service name",
+				Required:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OVH_CLOUD_PROJECT_SERVICE", nil),
+			},
+			kubeRegionKey: {
+				Type:        schema.TypeString,
+				Description: "Only list the clusters of this region",
+				Optional:    true,
+			},
+			kubeVersionKey: {
+				Type:        schema.TypeString,
+				Description: "Only list the clusters of this minor (e.g. 1.31) or patch version",
+				Optional:    true,
+			},
+			kubeStatusKey: {
+				Type:        schema.TypeString,
+				Description: "Only list the clusters with this status",
+				Optional:    true,
+			},
+			kubeClusterPlanKey: {
+				Type:         schema.TypeString,
+				Description:  "Only list the clusters with this plan",
+				Optional:     true,
+				ValidateFunc: helpers.ValidateEnum([]string{"standard", "free"}),
+			},
+
+			// Computed
+			kubeKubesKey: {
+				Type:        schema.TypeList,
+				Description: "Clusters of the project",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						kubeNodeIdKey: {
+							Type:        schema.TypeString,
+							Description: "Cluster ID",
+							Computed:    true,
+						},
+						kubeNameKey: {
+							Type:        schema.TypeString,
+							Description: "Cluster name",
+							Computed:    true,
+						},
+						kubeRegionKey: {
+							Type:        schema.TypeString,
+							Description: "Cluster region",
+							Computed:    true,
+						},
+						kubeVersionKey: {
+							Type:        schema.TypeString,
+							Description: "Kubernetes minor version of the cluster",
+							Computed:    true,
+						},
+						kubeStatusKey: {
+							Type:        schema.TypeString,
+							Description: "Current status",
+							Computed:    true,
+						},
+						kubeClusterPlanKey: {
+							Type:        schema.TypeString,
+							Description: "Plan of the cluster",
+							Computed:    true,
+						},
+						kubeClusterUrlKey: {
+							Type:        schema.TypeString,
+							Description: "Management URL of the cluster",
+							Computed:    true,
+						},
+						kubeClusterUpdatePolicyKey: {
+							Type:        schema.TypeString,
+							Description: "Update policy of the cluster",
+							Computed:    true,
+						},
+						kubeClusterProxyModeKey: {
+							Type:        schema.TypeString,
+							Description: "Kube proxy mode of the cluster",
+							Computed:    true,
+						},
+						kubeClusterIsUpToDateKey: {
+							Type:        schema.TypeBool,
+							Description: "True if all nodes and control-plane are up to date",
+							Computed:    true,
+						},
+						kubeClusterControlPlaneIsUpToDateKey: {
+							Type:        schema.TypeBool,
+							Description: "True if control-plane is up to date",
+							Computed:    true,
+						},
+						kubeClusterNextUpgradeVersionsKey: {
+							Type:        schema.TypeList,
+							Description: "Kubernetes versions available for upgrade",
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						kubeClusterPrivateNetworkIDKey: {
+							Type:        schema.TypeString,
+							Description: "Private network ID of the cluster",
+							Computed:    true,
+						},
+						kubeClusterNodesSubnetIdKey: {
+							Type:        schema.TypeString,
+							Description: "Subnet ID of the nodes",
+							Computed:    true,
+						},
+						kubeClusterLoadBalancersSubnetIdKey: {
+							Type:        schema.TypeString,
+							Description: "Subnet ID of the load balancers",
+							Computed:    true,
+						},
+						kubeClusterNodePoolsKey: cloudProjectKubeNodePoolsSummarySchema(),
+					},
+				},
+			},
+		},
+	}
+}
+
+// cloudProjectKubeNodePoolsSummarySchema is the schema of the node pools
+// exposed by the kube data sources
+func cloudProjectKubeNodePoolsSummarySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "Node pools of the cluster",
+		Computed:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				kubeNodeIdKey: {
+					Type:        schema.TypeString,
+					Description: "Node pool ID",
+					Computed:    true,
+				},
+				kubeNameKey: {
+					Type:        schema.TypeString,
+					Description: "Node pool name",
+					Computed:    true,
+				},
+				kubeFlavorKey: {
+					Type:        schema.TypeString,
+					Description: "Flavor of the nodes",
+					Computed:    true,
+				},
+				kubeStatusKey: {
+					Type:        schema.TypeString,
+					Description: "Current status",
+					Computed:    true,
+				},
+				kubeNodePoolAutoscaleKey: {
+					Type:        schema.TypeBool,
+					Description: "Whether auto-scaling is enabled",
+					Computed:    true,
+				},
+				kubeNodePoolAvailabilityZonesKey: {
+					Type:        schema.TypeList,
+					Description: "Availability zones of the node pool",
+					Computed:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+				},
+				kubeNodePoolDesiredNodesKey: {
+					Type:        schema.TypeInt,
+					Description: "Number of nodes desired in the pool",
+					Computed:    true,
+				},
+				kubeNodePoolCurrentNodesKey: {
+					Type:        schema.TypeInt,
+					Description: "Number of nodes present in the pool",
+					Computed:    true,
+				},
+				kubeNodePoolMinNodesKey: {
+					Type:        schema.TypeInt,
+					Description: "Minimum number of nodes allowed in the pool",
+					Computed:    true,
+				},
+				kubeNodePoolMaxNodesKey: {
+					Type:        schema.TypeInt,
+					Description: "Maximum number of nodes allowed in the pool",
+					Computed:    true,
+				},
+				kubeNodePoolUpToDateNodesKey: {
+					Type:        schema.TypeInt,
+					Description: "Number of nodes with the latest version installed in the pool",
+					Computed:    true,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceCloudProjectKubesRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get(kubeServiceNameKey).(string)
+
+	log.Printf("[DEBUG] Will read public cloud kubes for project: %s", serviceName)
+	kubes, err := cloudProjectKubeList(config, serviceName)
+	if err != nil {
+		return err
+	}
+
+	res := make([]map[string]interface{}, 0, len(kubes))
+	ids := make([]string, 0, len(kubes))
+	for _, kube := range kubes {
+		if !kube.Matches("",
+			d.Get(kubeRegionKey).(string),
+			d.Get(kubeVersionKey).(string),
+			d.Get(kubeStatusKey).(string),
+			d.Get(kubeClusterPlanKey).(string),
+		) {
+			continue
+		}
+
+		obj := kube.ToSummaryMap()
+		if obj[kubeClusterNodePoolsKey], err = cloudProjectKubeNodePoolsSummary(config, serviceName, kube.Id); err != nil {
+			return err
+		}
+
+		res = append(res, obj)
+		ids = append(ids, kube.Id)
+	}
+
+	// sort.Strings sorts in place, returns nothing
+	sort.Strings(ids)
+
+	d.SetId(hashcode.Strings(append([]string{serviceName}, ids...)))
+	d.Set(kubeKubesKey, res)
+
+	log.Printf("[DEBUG] Read kubes: %+v", ids)
+	return nil
+}
+
+// cloudProjectKubeList returns the clusters of the project, sorted by name
+func cloudProjectKubeList(config *Config, serviceName string) ([]CloudProjectKubeResponse, error) {
+	endpoint := fmt.Sprintf("/cloud/project/%s/kube", url.PathEscape(serviceName))
+
+	ids := []string{}
+	if err := config.OVHClient.Get(endpoint, &ids); err != nil {
+		return nil, fmt.Errorf("Error calling %s:\n\t %q", endpoint, err)
+	}
+
+	kubes := make([]CloudProjectKubeResponse, 0, len(ids))
+	for _, id := range ids {
+		kube := CloudProjectKubeResponse{}
+		kubeEndpoint := fmt.Sprintf("%s/%s", endpoint, url.PathEscape(id))
+		if err := config.OVHClient.Get(kubeEndpoint, &kube); err != nil {
+			return nil, fmt.Errorf("Error calling %s:\n\t %q", kubeEndpoint, err)
+		}
+		kubes = append(kubes, kube)
+	}
+
+	sort.Slice(kubes, func(i, j int) bool {
+		if kubes[i].Name != kubes[j].Name {
+			return kubes[i].Name < kubes[j].Name
+		}
+		return kubes[i].Id < kubes[j].Id
+	})
+
+	return kubes, nil
+}
+
+// cloudProjectKubeNodePoolsSummary returns the node pools of the cluster,
+// sorted by name
+func cloudProjectKubeNodePoolsSummary(config *Config, serviceName, kubeId string) ([]map[string]interface{}, error) {
+	endpoint := fmt.Sprintf("/cloud/project/%s/kube/%s/nodepool",
+		url.PathEscape(serviceName),
+		url.PathEscape(kubeId),
+	)
+
+	pools := []CloudProjectKubeNodePoolResponse{}
+	if err := config.OVHClient.Get(endpoint, &pools); err != nil {
+		return nil, fmt.Errorf("Error calling %s:\n\t %q", endpoint, err)
+	}
+
+	sort.Slice(pools, func(i, j int) bool { return pools[i].Name < pools[j].Name })
+
+	res := make([]map[string]interface{}, 0, len(pools))
+	for _, pool := range pools {
+		res = append(res, pool.ToSummaryMap())
+	}
+
+	return res, nil
+}
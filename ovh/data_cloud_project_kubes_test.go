@@ -0,0 +1,58 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+var testAccCloudProjectKubesDataSourceConfig = `
+resource "ovh_cloud_project_kube" "cluster" {
+  service_name = "%s"
+  name         = "%s"
+  region       = "%s"
+}
+
+data "ovh_cloud_project_kubes" "kubes" {
+  service_name = ovh_cloud_project_kube.cluster.service_name
+  region       = ovh_cloud_project_kube.cluster.region
+  version      = ovh_cloud_project_kube.cluster.version
+  status       = "READY"
+}
+
+output "found" {
+  value = contains(data.ovh_cloud_project_kubes.kubes.kubes[*].id, ovh_cloud_project_kube.cluster.id)
+}
+`
+
+func TestAccCloudProjectKubesDataSource_basic(t *testing.T) {
+	name := acctest.RandomWithPrefix(test_prefix)
+	region := os.Getenv("OVH_CLOUD_PROJECT_KUBE_REGION_TEST")
+
+	config := fmt.Sprintf(
+		testAccCloudProjectKubesDataSourceConfig,
+		os.Getenv("OVH_CLOUD_PROJECT_SERVICE_TEST"),
+		name,
+		region,
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckCloud(t)
+			testAccCheckCloudProjectExists(t)
+			testAccPreCheckKubernetes(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckOutput("found", "true"),
+				),
+			},
+		},
+	})
+}
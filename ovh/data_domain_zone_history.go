@@ -0,0 +1,162 @@
+package ovh
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	ovhtypes "github.com/ovh/terraform-provider-ovh/v2/ovh/types"
+)
+
+var _ datasource.DataSourceWithConfigure = (*domainZoneHistoryDataSource)(nil)
+
+func NewDomainZoneHistoryDataSource() datasource.DataSource {
+	return &domainZoneHistoryDataSource{}
+}
+
+type domainZoneHistoryDataSource struct {
+	config *Config
+}
+
+func (d *domainZoneHistoryDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_domain_zone_history"
+}
+
+func (d *domainZoneHistoryDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.config = config
+}
+
+func (d *domainZoneHistoryDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "List the history entries of a DNS zone.",
+		MarkdownDescription: "List the history entries of a DNS zone.",
+		Attributes: map[string]schema.Attribute{
+			"zone_name": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Required:            true,
+				Description:         "Zone name",
+				MarkdownDescription: "Zone name",
+			},
+			"creation_date_from": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Optional:            true,
+				Description:         "Only return history entries created after this date (RFC 3339)",
+				MarkdownDescription: "Only return history entries created after this date (RFC 3339)",
+			},
+			"creation_date_to": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Optional:            true,
+				Description:         "Only return history entries created before this date (RFC 3339)",
+				MarkdownDescription: "Only return history entries created before this date (RFC 3339)",
+			},
+			"history": schema.ListNestedAttribute{
+				Computed:            true,
+				Description:         "History entries of the zone, sorted by creation date",
+				MarkdownDescription: "History entries of the zone, sorted by creation date",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"creation_date": schema.StringAttribute{
+							CustomType:          ovhtypes.TfStringType{},
+							Computed:            true,
+							Description:         "Creation date of the history entry",
+							MarkdownDescription: "Creation date of the history entry",
+						},
+						"zone_file_url": schema.StringAttribute{
+							CustomType:          ovhtypes.TfStringType{},
+							Computed:            true,
+							Description:         "URL to download the zone file as it was at this point in time",
+							MarkdownDescription: "URL to download the zone file as it was at this point in time",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *domainZoneHistoryDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var (
+		data  domainZoneHistoryDataSourceModel
+		dates []string
+	)
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	queryParams := url.Values{}
+	if !data.CreationDateFrom.IsNull() && !data.CreationDateFrom.IsUnknown() {
+		queryParams.Add("creationDate.from", data.CreationDateFrom.ValueString())
+	}
+	if !data.CreationDateTo.IsNull() && !data.CreationDateTo.IsUnknown() {
+		queryParams.Add("creationDate.to", data.CreationDateTo.ValueString())
+	}
+
+	baseEndpoint := "/domain/zone/" + url.PathEscape(data.ZoneName.ValueString()) + "/history"
+	endpoint := baseEndpoint
+	if len(queryParams) > 0 {
+		endpoint += "?" + queryParams.Encode()
+	}
+
+	if err := d.config.OVHClient.GetWithContext(ctx, endpoint, &dates); err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Error calling Get %s", endpoint),
+			err.Error(),
+		)
+		return
+	}
+
+	sort.Strings(dates)
+
+	historyObjs := make([]attr.Value, 0, len(dates))
+	for _, date := range dates {
+		var point DomainZoneRestorePoint
+
+		endpoint := baseEndpoint + "/" + url.PathEscape(date)
+		if err := d.config.OVHClient.GetWithContext(ctx, endpoint, &point); err != nil {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Error calling Get %s", endpoint),
+				err.Error(),
+			)
+			return
+		}
+
+		obj, diags := domainZoneRestorePointToObject(point)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		historyObjs = append(historyObjs, obj)
+	}
+
+	history, diags := types.ListValue(
+		types.ObjectType{AttrTypes: domainZoneRestorePointObjectAttrTypes()},
+		historyObjs,
+	)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.History = history
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
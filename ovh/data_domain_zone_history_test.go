@@ -0,0 +1,32 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDomainZoneHistoryDataSource_basic(t *testing.T) {
+	zoneName := os.Getenv("OVH_ZONE_TEST")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckDomain(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "ovh_domain_zone_history" "history" {
+						zone_name = "%s"
+					}`, zoneName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ovh_domain_zone_history.history", "zone_name", zoneName),
+					resource.TestCheckResourceAttrSet("data.ovh_domain_zone_history.history", "history.#"),
+					resource.TestCheckResourceAttrSet("data.ovh_domain_zone_history.history", "history.0.creation_date"),
+					resource.TestCheckResourceAttrSet("data.ovh_domain_zone_history.history", "history.0.zone_file_url"),
+				),
+			},
+		},
+	})
+}
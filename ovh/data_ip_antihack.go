@@ -0,0 +1,131 @@
+package ovh
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/ovh/go-ovh/ovh"
+	ovhtypes "github.com/ovh/terraform-provider-ovh/v2/ovh/types"
+)
+
+var _ datasource.DataSourceWithConfigure = (*ipAntihackDataSource)(nil)
+
+func NewIpAntihackDataSource() datasource.DataSource {
+	return &ipAntihackDataSource{}
+}
+
+type ipAntihackDataSource struct {
+	config *Config
+}
+
+func (d *ipAntihackDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ip_antihack"
+}
+
+func (d *ipAntihackDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.config = config
+}
+
+func (d *ipAntihackDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Get the anti-hack blocking state of an IP address",
+		Attributes: map[string]schema.Attribute{
+			"ip": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Required:            true,
+				Description:         "IP block (e.g., 192.0.2.0/24)",
+				MarkdownDescription: "IP block (e.g., 192.0.2.0/24)",
+			},
+			"ip_blocked": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Required:            true,
+				Description:         "IPv4 address of the block to check (e.g., 192.0.2.1)",
+				MarkdownDescription: "IPv4 address of the block to check (e.g., 192.0.2.1)",
+			},
+			"blocked": schema.BoolAttribute{
+				CustomType:          ovhtypes.TfBoolType{},
+				Computed:            true,
+				Description:         "Whether the IP address is currently blocked by the anti-hack protection",
+				MarkdownDescription: "Whether the IP address is currently blocked by the anti-hack protection",
+			},
+			"state": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Computed:            true,
+				Description:         "Anti-hack state of the IP address (blocked, unblocking or unblocked), empty if it was never blocked",
+				MarkdownDescription: "Anti-hack state of the IP address (`blocked`, `unblocking` or `unblocked`), empty if it was never blocked",
+			},
+			"blocked_since": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Computed:            true,
+				Description:         "Date the IP address was blocked",
+				MarkdownDescription: "Date the IP address was blocked",
+			},
+			"time": schema.Int64Attribute{
+				CustomType:          ovhtypes.TfInt64Type{},
+				Computed:            true,
+				Description:         "Time (in seconds) the IP address stays blocked",
+				MarkdownDescription: "Time (in seconds) the IP address stays blocked",
+			},
+			"logs": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Computed:            true,
+				Description:         "Logs of the attack that caused the blocking",
+				MarkdownDescription: "Logs of the attack that caused the blocking",
+			},
+		},
+	}
+}
+
+func (d *ipAntihackDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var (
+		data    ipAntihackDataSourceModel
+		blocked IpBlocked
+	)
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	endpoint := "/ip/" + url.PathEscape(data.Ip.ValueString()) + "/antihack/" + url.PathEscape(data.IpBlocked.ValueString())
+	if err := d.config.OVHClient.GetWithContext(ctx, endpoint, &blocked); err != nil {
+		// An IP address that was never blocked is unknown to the anti-hack
+		if errOvh, ok := err.(*ovh.APIError); ok && errOvh.Code == http.StatusNotFound {
+			data.Blocked = ovhtypes.NewTfBoolValue(false)
+			data.State = ovhtypes.NewTfStringValue("")
+			data.BlockedSince = ovhtypes.NewTfStringNull()
+			data.Time = ovhtypes.NewTfInt64Value(0)
+			data.Logs = ovhtypes.NewTfStringNull()
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			return
+		}
+
+		resp.Diagnostics.AddError(fmt.Sprintf("Error calling Get %s", endpoint), err.Error())
+		return
+	}
+
+	data.Blocked = ovhtypes.NewTfBoolValue(blocked.State == "blocked")
+	data.State = ovhtypes.NewTfStringValue(blocked.State)
+	data.BlockedSince = ovhtypes.NewTfStringValue(blocked.BlockedSince)
+	data.Time = ovhtypes.NewTfInt64Value(blocked.Time)
+	data.Logs = ovhtypes.NewTfStringValue(blocked.Logs)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,36 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const testAccIPAntihackDataSourceConfig = `
+data "ovh_ip_antihack" "antihack" {
+	ip         = "%s"
+	ip_blocked = "%s"
+}
+`
+
+func TestAccIPAntihackData_basic(t *testing.T) {
+	ip := os.Getenv("OVH_IP_TEST")
+
+	config := fmt.Sprintf(testAccIPAntihackDataSourceConfig, ip, ip)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckIp(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ovh_ip_antihack.antihack", "ip_blocked", ip),
+					resource.TestCheckResourceAttr("data.ovh_ip_antihack.antihack", "blocked", "false"),
+				),
+			},
+		},
+	})
+}
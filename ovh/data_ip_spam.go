@@ -0,0 +1,259 @@
+package ovh
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/ovh/go-ovh/ovh"
+	ovhtypes "github.com/ovh/terraform-provider-ovh/v2/ovh/types"
+)
+
+var _ datasource.DataSourceWithConfigure = (*ipSpamDataSource)(nil)
+
+func NewIpSpamDataSource() datasource.DataSource {
+	return &ipSpamDataSource{}
+}
+
+type ipSpamDataSource struct {
+	config *Config
+}
+
+func (d *ipSpamDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ip_spam"
+}
+
+func (d *ipSpamDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.config = config
+}
+
+func (d *ipSpamDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Get the anti-spam blocking state of an IP address",
+		Attributes: map[string]schema.Attribute{
+			"ip": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Required:            true,
+				Description:         "IP block (e.g., 192.0.2.0/24)",
+				MarkdownDescription: "IP block (e.g., 192.0.2.0/24)",
+			},
+			"ip_spamming": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Required:            true,
+				Description:         "IPv4 address of the block to check (e.g., 192.0.2.1)",
+				MarkdownDescription: "IPv4 address of the block to check (e.g., 192.0.2.1)",
+			},
+			"stats_from": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Optional:            true,
+				Computed:            true,
+				Description:         "Start of the period of the spam statistics, in RFC 3339 format. Defaults to 24 hours before stats_to",
+				MarkdownDescription: "Start of the period of the spam statistics, in RFC 3339 format. Defaults to 24 hours before `stats_to`",
+			},
+			"stats_to": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Optional:            true,
+				Computed:            true,
+				Description:         "End of the period of the spam statistics, in RFC 3339 format. Defaults to now",
+				MarkdownDescription: "End of the period of the spam statistics, in RFC 3339 format. Defaults to now",
+			},
+			"blocked": schema.BoolAttribute{
+				CustomType:          ovhtypes.TfBoolType{},
+				Computed:            true,
+				Description:         "Whether the IP address is currently blocked for spam",
+				MarkdownDescription: "Whether the IP address is currently blocked for spam",
+			},
+			"state": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Computed:            true,
+				Description:         "Anti-spam state of the IP address (blockedForSpam, unblocking or unblocked), empty if it was never blocked",
+				MarkdownDescription: "Anti-spam state of the IP address (`blockedForSpam`, `unblocking` or `unblocked`), empty if it was never blocked",
+			},
+			"date": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Computed:            true,
+				Description:         "Date the IP address was last blocked",
+				MarkdownDescription: "Date the IP address was last blocked",
+			},
+			"time": schema.Int64Attribute{
+				CustomType:          ovhtypes.TfInt64Type{},
+				Computed:            true,
+				Description:         "Time (in seconds) the IP address stays blocked",
+				MarkdownDescription: "Time (in seconds) the IP address stays blocked",
+			},
+			"stats": schema.ListNestedAttribute{
+				Computed:            true,
+				Description:         "Spam statistics of the IP address over the period",
+				MarkdownDescription: "Spam statistics of the IP address over the period",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"timestamp": schema.Int64Attribute{
+							CustomType:          ovhtypes.TfInt64Type{},
+							Computed:            true,
+							Description:         "Time of the statistics",
+							MarkdownDescription: "Time of the statistics",
+						},
+						"total": schema.Int64Attribute{
+							CustomType:          ovhtypes.TfInt64Type{},
+							Computed:            true,
+							Description:         "Number of emails sent",
+							MarkdownDescription: "Number of emails sent",
+						},
+						"number_of_spams": schema.Int64Attribute{
+							CustomType:          ovhtypes.TfInt64Type{},
+							Computed:            true,
+							Description:         "Number of emails detected as spam",
+							MarkdownDescription: "Number of emails detected as spam",
+						},
+						"average_spamscore": schema.Float64Attribute{
+							Computed:            true,
+							Description:         "Average spam score of the emails",
+							MarkdownDescription: "Average spam score of the emails",
+						},
+						"detected_spams": schema.ListNestedAttribute{
+							Computed:            true,
+							Description:         "Emails detected as spam",
+							MarkdownDescription: "Emails detected as spam",
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"date": schema.StringAttribute{
+										CustomType:          ovhtypes.TfStringType{},
+										Computed:            true,
+										Description:         "Date the email was sent",
+										MarkdownDescription: "Date the email was sent",
+									},
+									"destination_ip": schema.StringAttribute{
+										CustomType:          ovhtypes.TfStringType{},
+										Computed:            true,
+										Description:         "IP address the email was sent to",
+										MarkdownDescription: "IP address the email was sent to",
+									},
+									"message_id": schema.StringAttribute{
+										CustomType:          ovhtypes.TfStringType{},
+										Computed:            true,
+										Description:         "Message-ID of the email",
+										MarkdownDescription: "Message-ID of the email",
+									},
+									"spamscore": schema.Int64Attribute{
+										CustomType:          ovhtypes.TfInt64Type{},
+										Computed:            true,
+										Description:         "Spam score of the email",
+										MarkdownDescription: "Spam score of the email",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ipSpamDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var (
+		data     ipSpamDataSourceModel
+		spamming IpSpamming
+		stats    []IpSpamStats
+	)
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	to := time.Now().UTC()
+	if !data.StatsTo.IsNull() && !data.StatsTo.IsUnknown() {
+		parsed, err := time.Parse(time.RFC3339, data.StatsTo.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid stats_to", err.Error())
+			return
+		}
+		to = parsed
+	}
+
+	from := to.Add(-24 * time.Hour)
+	if !data.StatsFrom.IsNull() && !data.StatsFrom.IsUnknown() {
+		parsed, err := time.Parse(time.RFC3339, data.StatsFrom.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid stats_from", err.Error())
+			return
+		}
+		from = parsed
+	}
+
+	data.StatsFrom = ovhtypes.NewTfStringValue(from.Format(time.RFC3339))
+	data.StatsTo = ovhtypes.NewTfStringValue(to.Format(time.RFC3339))
+	data.Stats = []ipSpamStatsModel{}
+
+	endpoint := "/ip/" + url.PathEscape(data.Ip.ValueString()) + "/spam/" + url.PathEscape(data.IpSpamming.ValueString())
+	if err := d.config.OVHClient.GetWithContext(ctx, endpoint, &spamming); err != nil {
+		// An IP address that was never blocked is unknown to the anti-spam
+		if errOvh, ok := err.(*ovh.APIError); ok && errOvh.Code == http.StatusNotFound {
+			data.Blocked = ovhtypes.NewTfBoolValue(false)
+			data.State = ovhtypes.NewTfStringValue("")
+			data.Date = ovhtypes.NewTfStringNull()
+			data.Time = ovhtypes.NewTfInt64Value(0)
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			return
+		}
+
+		resp.Diagnostics.AddError(fmt.Sprintf("Error calling Get %s", endpoint), err.Error())
+		return
+	}
+
+	data.Blocked = ovhtypes.NewTfBoolValue(spamming.State == "blockedForSpam")
+	data.State = ovhtypes.NewTfStringValue(spamming.State)
+	data.Date = ovhtypes.NewTfStringValue(spamming.Date)
+	data.Time = ovhtypes.NewTfInt64Value(spamming.Time)
+
+	statsEndpoint := endpoint + "/stats?from=" + url.QueryEscape(from.Format(time.RFC3339)) + "&to=" + url.QueryEscape(to.Format(time.RFC3339))
+	if err := d.config.OVHClient.GetWithContext(ctx, statsEndpoint, &stats); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error calling Get %s", statsEndpoint), err.Error())
+		return
+	}
+
+	for _, stat := range stats {
+		statModel := ipSpamStatsModel{
+			Timestamp:        ovhtypes.NewTfInt64Value(stat.Timestamp),
+			Total:            ovhtypes.NewTfInt64Value(stat.Total),
+			NumberOfSpams:    ovhtypes.NewTfInt64Value(stat.NumberOfSpams),
+			AverageSpamscore: types.Float64Null(),
+			DetectedSpams:    make([]ipSpamDetectedSpamModel, 0, len(stat.DetectedSpams)),
+		}
+		if stat.AverageSpamscore != nil {
+			statModel.AverageSpamscore = types.Float64Value(*stat.AverageSpamscore)
+		}
+
+		for _, spam := range stat.DetectedSpams {
+			statModel.DetectedSpams = append(statModel.DetectedSpams, ipSpamDetectedSpamModel{
+				Date:          ovhtypes.NewTfStringValue(spam.Date),
+				DestinationIp: ovhtypes.NewTfStringValue(spam.DestinationIp),
+				MessageId:     ovhtypes.NewTfStringValue(spam.MessageId),
+				Spamscore:     ovhtypes.NewTfInt64Value(spam.Spamscore),
+			})
+		}
+
+		data.Stats = append(data.Stats, statModel)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
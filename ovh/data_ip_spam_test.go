@@ -0,0 +1,38 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const testAccIPSpamDataSourceConfig = `
+data "ovh_ip_spam" "spam" {
+	ip          = "%s"
+	ip_spamming = "%s"
+}
+`
+
+func TestAccIPSpamData_basic(t *testing.T) {
+	ip := os.Getenv("OVH_IP_TEST")
+
+	config := fmt.Sprintf(testAccIPSpamDataSourceConfig, ip, ip)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckIp(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ovh_ip_spam.spam", "ip_spamming", ip),
+					resource.TestCheckResourceAttr("data.ovh_ip_spam.spam", "blocked", "false"),
+					resource.TestCheckResourceAttrSet("data.ovh_ip_spam.spam", "stats_from"),
+					resource.TestCheckResourceAttrSet("data.ovh_ip_spam.spam", "stats_to"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,264 @@
+package ovh
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	ovhtypes "github.com/ovh/terraform-provider-ovh/v2/ovh/types"
+)
+
+var _ datasource.DataSourceWithConfigure = (*iploadbalancingFarmHealthDataSource)(nil)
+
+func NewIploadbalancingFarmHealthDataSource() datasource.DataSource {
+	return &iploadbalancingFarmHealthDataSource{}
+}
+
+type iploadbalancingFarmHealthDataSource struct {
+	config *Config
+}
+
+func (d *iploadbalancingFarmHealthDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_iploadbalancing_farm_health"
+}
+
+func (d *iploadbalancingFarmHealthDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.config = config
+}
+
+func (d *iploadbalancingFarmHealthDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Get the live health of the servers of an IP load balancing farm.",
+		MarkdownDescription: "Get the live health of the servers of an IP load balancing farm.",
+		Attributes: map[string]schema.Attribute{
+			"service_name": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Required:            true,
+				Description:         "The internal name of your IP load balancing",
+				MarkdownDescription: "The internal name of your IP load balancing",
+			},
+			"farm_type": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Required:            true,
+				Description:         "Protocol of the farm (http, tcp or udp)",
+				MarkdownDescription: "Protocol of the farm (`http`, `tcp` or `udp`)",
+				Validators: []validator.String{
+					stringvalidator.OneOf("http", "tcp", "udp"),
+				},
+			},
+			"farm_id": schema.Int64Attribute{
+				CustomType:          ovhtypes.TfInt64Type{},
+				Required:            true,
+				Description:         "Id of the farm",
+				MarkdownDescription: "Id of the farm",
+			},
+			"all_healthy": schema.BoolAttribute{
+				CustomType:          ovhtypes.TfBoolType{},
+				Computed:            true,
+				Description:         "Whether all the servers of the farm are healthy, null when no server is unhealthy but the health of some is unknown",
+				MarkdownDescription: "Whether all the servers of the farm are healthy, `null` when no server is unhealthy but the health of some is unknown",
+			},
+			"healthy_servers": schema.Int64Attribute{
+				CustomType:          ovhtypes.TfInt64Type{},
+				Computed:            true,
+				Description:         "Number of healthy servers in the farm",
+				MarkdownDescription: "Number of healthy servers in the farm",
+			},
+			"servers": schema.ListNestedAttribute{
+				Computed:            true,
+				Description:         "Servers of the farm, sorted by id",
+				MarkdownDescription: "Servers of the farm, sorted by id",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"server_id": schema.Int64Attribute{
+							CustomType:          ovhtypes.TfInt64Type{},
+							Computed:            true,
+							Description:         "Id of the server",
+							MarkdownDescription: "Id of the server",
+						},
+						"address": schema.StringAttribute{
+							CustomType:          ovhtypes.TfStringType{},
+							Computed:            true,
+							Description:         "Address of the server",
+							MarkdownDescription: "Address of the server",
+						},
+						"port": schema.Int64Attribute{
+							CustomType:          ovhtypes.TfInt64Type{},
+							Computed:            true,
+							Description:         "Port of the server",
+							MarkdownDescription: "Port of the server",
+						},
+						"display_name": schema.StringAttribute{
+							CustomType:          ovhtypes.TfStringType{},
+							Computed:            true,
+							Description:         "Human readable name of the server",
+							MarkdownDescription: "Human readable name of the server",
+						},
+						"status": schema.StringAttribute{
+							CustomType:          ovhtypes.TfStringType{},
+							Computed:            true,
+							Description:         "Administrative status of the server (active or inactive)",
+							MarkdownDescription: "Administrative status of the server (`active` or `inactive`)",
+						},
+						"probe": schema.BoolAttribute{
+							CustomType:          ovhtypes.TfBoolType{},
+							Computed:            true,
+							Description:         "Whether the server is checked by the probe of the farm",
+							MarkdownDescription: "Whether the server is checked by the probe of the farm",
+						},
+						"healthy": schema.BoolAttribute{
+							CustomType:          ovhtypes.TfBoolType{},
+							Computed:            true,
+							Description:         "Whether the server is active and UP in all the zones, null when unknown because the server is not checked by a probe or has no health check result",
+							MarkdownDescription: "Whether the server is active and `UP` in all the zones, `null` when unknown because the server is not checked by a probe or has no health check result",
+						},
+						"zones": schema.ListNestedAttribute{
+							Computed:            true,
+							Description:         "Health of the server in each zone of the load balancer",
+							MarkdownDescription: "Health of the server in each zone of the load balancer",
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"zone": schema.StringAttribute{
+										CustomType:          ovhtypes.TfStringType{},
+										Computed:            true,
+										Description:         "Zone of the load balancer",
+										MarkdownDescription: "Zone of the load balancer",
+									},
+									"status": schema.StringAttribute{
+										CustomType:          ovhtypes.TfStringType{},
+										Computed:            true,
+										Description:         "Result of the health checks of the server in the zone (e.g. UP, DOWN, NOLB, MAINT, DRAIN, no check)",
+										MarkdownDescription: "Result of the health checks of the server in the zone (e.g. `UP`, `DOWN`, `NOLB`, `MAINT`, `DRAIN`, `no check`)",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *iploadbalancingFarmHealthDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var (
+		data      iploadbalancingFarmHealthDataSourceModel
+		serverIds []int64
+		status    IpLoadbalancingStatus
+	)
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serviceName := data.ServiceName.ValueString()
+	farmType := data.FarmType.ValueString()
+	farmId := data.FarmId.ValueInt64()
+
+	farmEndpoint := fmt.Sprintf("/ipLoadbalancing/%s/%s/farm/%d", url.PathEscape(serviceName), url.PathEscape(farmType), farmId)
+	endpoint := farmEndpoint + "/server"
+	if err := d.config.OVHClient.GetWithContext(ctx, endpoint, &serverIds); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error calling Get %s", endpoint), err.Error())
+		return
+	}
+
+	endpoint = "/ipLoadbalancing/" + url.PathEscape(serviceName) + "/status"
+	if err := d.config.OVHClient.GetWithContext(ctx, endpoint, &status); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error calling Get %s", endpoint), err.Error())
+		return
+	}
+
+	// Index the health check results of the farm by server
+	zonesByServer := make(map[int64][]IpLoadbalancingServerStatus)
+	for _, serverStatus := range status.Servers {
+		if serverStatus.Type != farmType || serverStatus.FarmId != farmId {
+			continue
+		}
+		zonesByServer[serverStatus.ServerId] = append(zonesByServer[serverStatus.ServerId], serverStatus)
+	}
+
+	sort.Slice(serverIds, func(i, j int) bool { return serverIds[i] < serverIds[j] })
+
+	healthyServers, unknownServers := int64(0), int64(0)
+	data.Servers = make([]iploadbalancingFarmServerHealthModel, 0, len(serverIds))
+	for _, serverId := range serverIds {
+		var server IpLoadbalancingFarmServer
+
+		endpoint := fmt.Sprintf("%s/server/%d", farmEndpoint, serverId)
+		if err := d.config.OVHClient.GetWithContext(ctx, endpoint, &server); err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Error calling Get %s", endpoint), err.Error())
+			return
+		}
+
+		zones := zonesByServer[serverId]
+		sort.Slice(zones, func(i, j int) bool { return zones[i].Zone < zones[j].Zone })
+
+		serverModel := iploadbalancingFarmServerHealthModel{
+			ServerId:    ovhtypes.NewTfInt64Value(serverId),
+			Address:     ovhtypes.NewTfStringValue(server.Address),
+			Port:        ovhtypes.NewTfInt64ValueNull(),
+			DisplayName: ovhtypes.NewTfStringNull(),
+			Status:      ovhtypes.NewTfStringValue(server.Status),
+			Probe:       ovhtypes.NewTfBoolValue(server.Probe != nil && *server.Probe),
+			Zones:       make([]iploadbalancingZoneHealthModel, 0, len(zones)),
+		}
+		if server.Port != nil {
+			serverModel.Port = ovhtypes.NewTfInt64Value(int64(*server.Port))
+		}
+		if server.DisplayName != nil {
+			serverModel.DisplayName = ovhtypes.NewTfStringValue(*server.DisplayName)
+		}
+
+		for _, zone := range zones {
+			serverModel.Zones = append(serverModel.Zones, iploadbalancingZoneHealthModel{
+				Zone:   ovhtypes.NewTfStringValue(zone.Zone),
+				Status: ovhtypes.NewTfStringValue(zone.Status),
+			})
+		}
+
+		switch healthy := iploadbalancingServerHealth(server, zones); {
+		case healthy == nil:
+			serverModel.Healthy = ovhtypes.NewTfBoolValueNull()
+			unknownServers++
+		case *healthy:
+			serverModel.Healthy = ovhtypes.NewTfBoolValue(true)
+			healthyServers++
+		default:
+			serverModel.Healthy = ovhtypes.NewTfBoolValue(false)
+		}
+
+		data.Servers = append(data.Servers, serverModel)
+	}
+
+	data.HealthyServers = ovhtypes.NewTfInt64Value(healthyServers)
+	switch {
+	case healthyServers == int64(len(serverIds)):
+		data.AllHealthy = ovhtypes.NewTfBoolValue(true)
+	case healthyServers+unknownServers == int64(len(serverIds)):
+		// No server is known to be unhealthy
+		data.AllHealthy = ovhtypes.NewTfBoolValueNull()
+	default:
+		data.AllHealthy = ovhtypes.NewTfBoolValue(false)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,102 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestIploadbalancingServerHealth(t *testing.T) {
+	probe, noProbe := true, false
+	zones := func(statuses ...string) []IpLoadbalancingServerStatus {
+		res := make([]IpLoadbalancingServerStatus, 0, len(statuses))
+		for _, status := range statuses {
+			res = append(res, IpLoadbalancingServerStatus{Zone: "gra", Status: status})
+		}
+		return res
+	}
+
+	tests := []struct {
+		name   string
+		server IpLoadbalancingFarmServer
+		zones  []IpLoadbalancingServerStatus
+		want   string
+	}{
+		{name: "up", server: IpLoadbalancingFarmServer{Status: "active", Probe: &probe}, zones: zones("UP", "UP"), want: "true"},
+		{name: "going down", server: IpLoadbalancingFarmServer{Status: "active", Probe: &probe}, zones: zones("UP 1/3"), want: "true"},
+		{name: "down in a zone", server: IpLoadbalancingFarmServer{Status: "active", Probe: &probe}, zones: zones("UP", "DOWN"), want: "false"},
+		{name: "going up", server: IpLoadbalancingFarmServer{Status: "active", Probe: &probe}, zones: zones("DOWN 1/2"), want: "false"},
+		{name: "maintenance", server: IpLoadbalancingFarmServer{Status: "active", Probe: &probe}, zones: zones("MAINT"), want: "false"},
+		{name: "inactive", server: IpLoadbalancingFarmServer{Status: "inactive", Probe: &probe}, zones: zones("UP"), want: "false"},
+		{name: "no probe", server: IpLoadbalancingFarmServer{Status: "active", Probe: &noProbe}, zones: zones("no check"), want: "unknown"},
+		{name: "no status", server: IpLoadbalancingFarmServer{Status: "active", Probe: &probe}, want: "unknown"},
+		{name: "not checked yet", server: IpLoadbalancingFarmServer{Status: "active", Probe: &probe}, zones: zones("UP", "no check"), want: "unknown"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := "unknown"
+			if healthy := iploadbalancingServerHealth(tt.server, tt.zones); healthy != nil {
+				got = fmt.Sprint(*healthy)
+			}
+			if got != tt.want {
+				t.Errorf("iploadbalancingServerHealth() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAccIpLoadbalancingFarmHealthDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckIpLoadbalancing(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIpLoadbalancingFarmHealthDatasourceConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ovh_iploadbalancing_farm_health.health", "servers.#", "1"),
+					resource.TestCheckResourceAttr("data.ovh_iploadbalancing_farm_health.health", "servers.0.address", "10.0.0.11"),
+					resource.TestCheckResourceAttr("data.ovh_iploadbalancing_farm_health.health", "servers.0.status", "active"),
+					resource.TestCheckResourceAttrSet("data.ovh_iploadbalancing_farm_health.health", "healthy_servers"),
+					resource.TestCheckResourceAttrSet("data.ovh_iploadbalancing_farm_health.health", "all_healthy"),
+				),
+			},
+		},
+	})
+}
+
+var testAccIpLoadbalancingFarmHealthDatasourceConfig_basic = fmt.Sprintf(`
+data "ovh_iploadbalancing" "iplb" {
+  service_name = "%s"
+}
+
+resource "ovh_iploadbalancing_tcp_farm" "farm" {
+  service_name = data.ovh_iploadbalancing.iplb.service_name
+  display_name = "test-farm-health"
+  port         = 8080
+  zone         = "all"
+
+  probe {
+    type     = "tcp"
+    interval = 30
+  }
+}
+
+resource "ovh_iploadbalancing_tcp_farm_server" "server" {
+  service_name = data.ovh_iploadbalancing.iplb.service_name
+  farm_id      = ovh_iploadbalancing_tcp_farm.farm.id
+  address      = "10.0.0.11"
+  status       = "active"
+  probe        = true
+}
+
+data "ovh_iploadbalancing_farm_health" "health" {
+  service_name = data.ovh_iploadbalancing.iplb.service_name
+  farm_type    = "tcp"
+  farm_id      = ovh_iploadbalancing_tcp_farm.farm.id
+
+  depends_on = [ovh_iploadbalancing_tcp_farm_server.server]
+}
+`, os.Getenv("OVH_IPLB_SERVICE_TEST"))
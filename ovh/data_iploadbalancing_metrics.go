@@ -0,0 +1,78 @@
+package ovh
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceIpLoadbalancingMetrics() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIpLoadbalancingMetricsRead,
+		Schema: map[string]*schema.Schema{
+			"service_name": {
+				Type:        schema.TypeString,
+				Description: "The internal name of your IP load balancing",
+				Required:    true,
+			},
+
+			//computed
+			"region": {
+				Type:        schema.TypeString,
+				Description: "Region of the Metrics Data Platform storing the metrics of the IP load balancing",
+				Computed:    true,
+			},
+			"endpoint": {
+				Type:        schema.TypeString,
+				Description: "URL of the Prometheus endpoint serving the metrics of the IP load balancing",
+				Computed:    true,
+			},
+			"metrics_token": {
+				Type:        schema.TypeString,
+				Description: "Metrics token of the IP load balancing",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"token": {
+				Type:        schema.TypeString,
+				Description: "Read only token to query the metrics of the IP load balancing",
+				Computed:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+}
+
+func dataSourceIpLoadbalancingMetricsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+
+	iplb := &IpLoadbalancing{}
+	endpoint := fmt.Sprintf("/ipLoadbalancing/%s", url.PathEscape(serviceName))
+	if err := config.OVHClient.GetWithContext(ctx, endpoint, iplb); err != nil {
+		return diag.Errorf("calling GET %s:\n\t %s", endpoint, err.Error())
+	}
+
+	if iplb.Region == nil || *iplb.Region == "" {
+		return diag.Errorf("no metrics region is available for IP load balancing %s", serviceName)
+	}
+
+	token := &IpLoadbalancingMetricsToken{}
+	endpoint = fmt.Sprintf("/ipLoadbalancing/%s/metricsToken", url.PathEscape(serviceName))
+	if err := config.OVHClient.GetWithContext(ctx, endpoint, token); err != nil {
+		return diag.Errorf("calling GET %s:\n\t %s", endpoint, err.Error())
+	}
+
+	d.SetId(serviceName)
+	d.Set("region", *iplb.Region)
+	d.Set("endpoint", fmt.Sprintf("https://prometheus.%s.metrics.ovh.net", *iplb.Region))
+	if iplb.MetricsToken != nil {
+		d.Set("metrics_token", *iplb.MetricsToken)
+	}
+	d.Set("token", token.Token)
+
+	return nil
+}
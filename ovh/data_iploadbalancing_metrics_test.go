@@ -0,0 +1,37 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccIpLoadbalancingMetricsDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckIpLoadbalancing(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccIpLoadbalancingMetricsDatasourceConfig_basic, os.Getenv("OVH_IPLB_SERVICE_TEST")),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ovh_iploadbalancing_metrics.metrics", "region"),
+					resource.TestCheckResourceAttrSet("data.ovh_iploadbalancing_metrics.metrics", "endpoint"),
+					resource.TestCheckResourceAttrSet("data.ovh_iploadbalancing_metrics.metrics", "token"),
+					resource.TestCheckResourceAttrPair("data.ovh_iploadbalancing_metrics.metrics", "metrics_token", "data.ovh_iploadbalancing.iplb", "metrics_token"),
+				),
+			},
+		},
+	})
+}
+
+const testAccIpLoadbalancingMetricsDatasourceConfig_basic = `
+data "ovh_iploadbalancing" "iplb" {
+  service_name = "%s"
+}
+
+data "ovh_iploadbalancing_metrics" "metrics" {
+  service_name = data.ovh_iploadbalancing.iplb.service_name
+}
+`
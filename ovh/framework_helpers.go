@@ -0,0 +1,47 @@
+package ovh
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/ovh/go-ovh/ovh"
+	ovhtypes "github.com/ovh/terraform-provider-ovh/v2/ovh/types"
+)
+
+// isOvhApiNotFound returns true if the given error is, or wraps, an API 404 error.
+func isOvhApiNotFound(err error) bool {
+	var errOvh *ovh.APIError
+	return errors.As(err, &errOvh) && errOvh.Code == http.StatusNotFound
+}
+
+// splitImportID splits an import ID made of the given number of non-empty parts separated by "/".
+func splitImportID(id string, parts int) ([]string, bool) {
+	splits := strings.SplitN(id, "/", parts)
+	if len(splits) != parts {
+		return nil, false
+	}
+	for _, split := range splits {
+		if split == "" {
+			return nil, false
+		}
+	}
+	return splits, true
+}
+
+// tfStringOrNull returns a null value for an empty string returned by the API.
+func tfStringOrNull(v string) ovhtypes.TfStringValue {
+	if v == "" {
+		return ovhtypes.NewTfStringNull()
+	}
+	return ovhtypes.NewTfStringValue(v)
+}
+
+// tfStringPtr returns the value to send to the API, nil when it is not set.
+func tfStringPtr(v ovhtypes.TfStringValue) *string {
+	if v.IsNull() || v.IsUnknown() {
+		return nil
+	}
+	s := v.ValueString()
+	return &s
+}
@@ -0,0 +1,162 @@
+package ovh
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/ovh/terraform-provider-ovh/v2/ovh/helpers"
+)
+
+// IpLoadbalancingFarmAvailableProbe describes the arguments taken by a type of probe
+type IpLoadbalancingFarmAvailableProbe struct {
+	Type      string   `json:"type"`      // Type of the probe
+	Port      bool     `json:"port"`      // Whether the probe takes a port
+	URL       bool     `json:"url"`       // Whether the probe takes an URL
+	Method    bool     `json:"method"`    // Whether the probe takes an HTTP method
+	Negatable bool     `json:"negatable"` // Whether the match of the probe can be negated
+	Matches   []string `json:"matches"`   // Matching operators available for the probe
+}
+
+// Probe matches comparing the response of the backend to the pattern
+var iploadbalancingProbeMatchesWithPattern = []string{"contains", "matches", "status"}
+
+// Validate checks the probe against the probe types available on IP load balancers
+func (v *IpLoadbalancingFarmBackendProbe) Validate(available []IpLoadbalancingFarmAvailableProbe) error {
+	if v.Type == nil {
+		return fmt.Errorf("probe type is required")
+	}
+	probeType := *v.Type
+
+	var spec *IpLoadbalancingFarmAvailableProbe
+	types := make([]string, 0, len(available))
+	for i := range available {
+		types = append(types, available[i].Type)
+		if available[i].Type == probeType {
+			spec = &available[i]
+		}
+	}
+	if spec == nil {
+		return fmt.Errorf("probe type %q is not available, available types are %v", probeType, types)
+	}
+
+	if v.Port != nil && !spec.Port {
+		return fmt.Errorf("probe type %q doesn't take a port", probeType)
+	}
+	if v.URL != nil && *v.URL != "" && !spec.URL {
+		return fmt.Errorf("probe type %q doesn't take an url", probeType)
+	}
+	if v.Method != nil && *v.Method != "" && !spec.Method {
+		return fmt.Errorf("probe type %q doesn't take a method", probeType)
+	}
+	if v.ForceSsl != nil && *v.ForceSsl && probeType != "http" {
+		return fmt.Errorf("force_ssl is only available for http probes")
+	}
+
+	match := ""
+	if v.Match != nil {
+		match = *v.Match
+	}
+
+	if match != "" {
+		if len(spec.Matches) == 0 {
+			return fmt.Errorf("probe type %q doesn't take a match", probeType)
+		}
+		if err := helpers.ValidateStringEnum(match, spec.Matches); err != nil {
+			return fmt.Errorf("invalid match for probe type %q: %w", probeType, err)
+		}
+	}
+
+	if v.Negate != nil && *v.Negate && !spec.Negatable {
+		return fmt.Errorf("probe type %q can't be negated", probeType)
+	}
+
+	pattern := ""
+	if v.Pattern != nil {
+		pattern = *v.Pattern
+	}
+
+	if helpers.ValidateStringEnum(match, iploadbalancingProbeMatchesWithPattern) != nil {
+		if pattern != "" {
+			return fmt.Errorf("a pattern can only be used with the %v matches", iploadbalancingProbeMatchesWithPattern)
+		}
+		return nil
+	}
+
+	if pattern == "" {
+		return fmt.Errorf("match %q requires a pattern", match)
+	}
+
+	if match == "status" {
+		for _, code := range strings.Split(pattern, ",") {
+			status, err := strconv.Atoi(strings.TrimSpace(code))
+			if err != nil || status < 100 || status > 599 {
+				return fmt.Errorf("match %q requires a comma-separated list of HTTP status codes as pattern, got %q", match, pattern)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (v *IpLoadbalancingFarmBackendProbe) FromMap(probe map[string]interface{}) *IpLoadbalancingFarmBackendProbe {
+	stringPtr := func(key string) *string {
+		if value, ok := probe[key].(string); ok && value != "" {
+			return &value
+		}
+		return nil
+	}
+
+	v.Type = stringPtr("type")
+	v.Match = stringPtr("match")
+	v.Method = stringPtr("method")
+	v.Pattern = stringPtr("pattern")
+	v.URL = stringPtr("url")
+
+	if port, ok := probe["port"].(int); ok && port != 0 {
+		v.Port = &port
+	}
+	if negate, ok := probe["negate"].(bool); ok {
+		v.Negate = &negate
+	}
+	if forceSsl, ok := probe["force_ssl"].(bool); ok {
+		v.ForceSsl = &forceSsl
+	}
+
+	return v
+}
+
+// resourceIpLoadbalancingFarmCustomizeDiff validates the probe of a farm against
+// /ipLoadbalancing/availableFarmProbes when planning
+func resourceIpLoadbalancingFarmCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.HasChange("probe") {
+		return nil
+	}
+
+	probes := d.Get("probe").([]interface{})
+	if len(probes) != 1 || probes[0] == nil {
+		return nil
+	}
+
+	for _, key := range []string{"type", "match", "method", "pattern", "url", "port"} {
+		if !d.NewValueKnown("probe.0." + key) {
+			return nil
+		}
+	}
+
+	config := meta.(*Config)
+	available := []IpLoadbalancingFarmAvailableProbe{}
+	endpoint := "/ipLoadbalancing/availableFarmProbes"
+	if err := config.OVHClient.GetWithContext(ctx, endpoint, &available); err != nil {
+		return fmt.Errorf("calling GET %s:\n\t %s", endpoint, err.Error())
+	}
+
+	probe := (&IpLoadbalancingFarmBackendProbe{}).FromMap(probes[0].(map[string]interface{}))
+	if err := probe.Validate(available); err != nil {
+		return fmt.Errorf("invalid probe: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,50 @@
+package ovh
+
+import (
+	"testing"
+)
+
+func TestIpLoadbalancingFarmBackendProbeValidate(t *testing.T) {
+	available := []IpLoadbalancingFarmAvailableProbe{
+		{Type: "http", Port: true, URL: true, Method: true, Negatable: true, Matches: []string{"default", "contains", "matches", "status"}},
+		{Type: "tcp", Port: true, Negatable: true, Matches: []string{"default", "contains", "matches"}},
+		{Type: "smtp", Port: true},
+		{Type: "mysql", Port: true},
+		{Type: "pgsql", Port: true, Matches: []string{"default"}},
+		{Type: "oco"},
+	}
+
+	probe := func(values map[string]interface{}) IpLoadbalancingFarmBackendProbe {
+		return *(&IpLoadbalancingFarmBackendProbe{}).FromMap(values)
+	}
+
+	tests := []struct {
+		name    string
+		probe   IpLoadbalancingFarmBackendProbe
+		wantErr bool
+	}{
+		{name: "http status", probe: probe(map[string]interface{}{"type": "http", "url": "/health", "method": "GET", "match": "status", "pattern": "200,204"})},
+		{name: "http invalid status", probe: probe(map[string]interface{}{"type": "http", "match": "status", "pattern": "2xx"}), wantErr: true},
+		{name: "tcp contains", probe: probe(map[string]interface{}{"type": "tcp", "match": "contains", "pattern": "+OK", "negate": true})},
+		{name: "tcp missing pattern", probe: probe(map[string]interface{}{"type": "tcp", "match": "contains"}), wantErr: true},
+		{name: "tcp with url", probe: probe(map[string]interface{}{"type": "tcp", "url": "/health"}), wantErr: true},
+		{name: "tcp with status match", probe: probe(map[string]interface{}{"type": "tcp", "match": "status", "pattern": "200"}), wantErr: true},
+		{name: "smtp", probe: probe(map[string]interface{}{"type": "smtp", "port": 25})},
+		{name: "smtp with match", probe: probe(map[string]interface{}{"type": "smtp", "match": "contains", "pattern": "220"}), wantErr: true},
+		{name: "mysql with force ssl", probe: probe(map[string]interface{}{"type": "mysql", "force_ssl": true}), wantErr: true},
+		{name: "oco with port", probe: probe(map[string]interface{}{"type": "oco", "port": 80}), wantErr: true},
+		{name: "default with pattern", probe: probe(map[string]interface{}{"type": "http", "match": "default", "pattern": "ok"}), wantErr: true},
+		{name: "pgsql default match", probe: probe(map[string]interface{}{"type": "pgsql", "port": 5432, "match": "default"})},
+		{name: "pgsql with url", probe: probe(map[string]interface{}{"type": "pgsql", "url": "/"}), wantErr: true},
+		{name: "unavailable type", probe: probe(map[string]interface{}{"type": "ldap"}), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.probe.Validate(available)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
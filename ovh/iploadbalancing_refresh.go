@@ -0,0 +1,290 @@
+package ovh
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+)
+
+// Modes of the provider iploadbalancing_refresh option
+const (
+	// Changes made on a service during the apply are applied by a single refresh
+	// per zone, once no operation is left queued on the service
+	IpLoadbalancingRefreshModeBatch = "batch"
+	// Changes are applied right after each resource operation
+	IpLoadbalancingRefreshModeResource = "resource"
+	// Changes are only applied by ovh_iploadbalancing_refresh resources
+	IpLoadbalancingRefreshModeDisabled = "disabled"
+)
+
+var IpLoadbalancingRefreshModes = []string{
+	IpLoadbalancingRefreshModeBatch,
+	IpLoadbalancingRefreshModeResource,
+	IpLoadbalancingRefreshModeDisabled,
+}
+
+// iploadbalancingRefreshModeFromEnv returns the refresh mode set by the
+// OVH_IPLOADBALANCING_REFRESH environment variable, batch if it is not set
+func iploadbalancingRefreshModeFromEnv() (string, error) {
+	v := os.Getenv("OVH_IPLOADBALANCING_REFRESH")
+	if v == "" {
+		return IpLoadbalancingRefreshModeBatch, nil
+	}
+
+	for _, mode := range IpLoadbalancingRefreshModes {
+		if v == mode {
+			return v, nil
+		}
+	}
+
+	return "", fmt.Errorf("invalid OVH_IPLOADBALANCING_REFRESH value %q, expected one of %v", v, IpLoadbalancingRefreshModes)
+}
+
+// iploadbalancingRefreshSettleDelay is how long the last operation queued on a service
+// waits for other operations, such as the resources depending on the ones it was
+// queued with, to be queued before refreshing the service.
+const iploadbalancingRefreshSettleDelay = 5 * time.Second
+
+// iploadbalancingRefresher tracks the operations changing the configuration of each
+// service during the apply. An operation done while others are still queued on the
+// same service returns right away, leaving its changes to the last one, which refreshes
+// the pending zones of the service once nothing is left queued.
+type iploadbalancingRefresher struct {
+	sync.Mutex
+	delay    time.Duration
+	refresh  func(ctx context.Context, service string) error
+	services map[string]*iploadbalancingRefreshQueue
+}
+
+// iploadbalancingRefreshQueue holds the operations queued on a service
+type iploadbalancingRefreshQueue struct {
+	queued  int
+	pending bool
+	// joined is closed when an operation is queued while the last one settles
+	joined chan struct{}
+}
+
+func newIpLoadbalancingRefresher(delay time.Duration, refresh func(ctx context.Context, service string) error) *iploadbalancingRefresher {
+	return &iploadbalancingRefresher{
+		delay:    delay,
+		refresh:  refresh,
+		services: make(map[string]*iploadbalancingRefreshQueue),
+	}
+}
+
+// iploadbalancingConfigRefresher returns the refresher of a provider, refreshing the
+// pending zones of the services with its API client
+func iploadbalancingConfigRefresher(config *Config) *iploadbalancingRefresher {
+	return newIpLoadbalancingRefresher(iploadbalancingRefreshSettleDelay, func(ctx context.Context, service string) error {
+		return iploadbalancingRefreshPendingZones(ctx, config, service)
+	})
+}
+
+// Queue registers an operation about to change the configuration of the service
+func (r *iploadbalancingRefresher) Queue(service string) {
+	r.Lock()
+	defer r.Unlock()
+
+	queue, ok := r.services[service]
+	if !ok {
+		queue = &iploadbalancingRefreshQueue{}
+		r.services[service] = queue
+	}
+	queue.queued++
+	if queue.joined != nil {
+		close(queue.joined)
+		queue.joined = nil
+	}
+}
+
+// Done unregisters an operation of the service, changed telling whether it left pending
+// changes. The last operation waits for the settle delay and refreshes the service if
+// no other operation was queued meanwhile.
+func (r *iploadbalancingRefresher) Done(ctx context.Context, service string, changed bool) error {
+	r.Lock()
+	queue := r.services[service]
+	queue.queued--
+	queue.pending = queue.pending || changed
+	if queue.queued > 0 || !queue.pending {
+		if queue.queued > 0 {
+			log.Printf("[DEBUG] Leaving the changes of IP load balancer %s to the operations still queued", service)
+		}
+		r.Unlock()
+		return nil
+	}
+	joined := make(chan struct{})
+	queue.joined = joined
+	r.Unlock()
+
+	select {
+	case <-joined:
+		log.Printf("[DEBUG] Leaving the changes of IP load balancer %s to the operations queued meanwhile", service)
+		return nil
+	case <-ctx.Done():
+		r.Lock()
+		if queue.joined == joined {
+			queue.joined = nil
+		}
+		r.Unlock()
+		return ctx.Err()
+	case <-time.After(r.delay):
+	}
+
+	r.Lock()
+	if queue.joined != joined {
+		// An operation was queued right as the delay elapsed
+		r.Unlock()
+		return nil
+	}
+	queue.joined = nil
+	queue.pending = false
+	r.Unlock()
+
+	log.Printf("[DEBUG] Refreshing IP load balancer %s, no operation is left queued on it", service)
+	return r.refresh(ctx, service)
+}
+
+// iploadbalancingChanges is an operation changing the configuration of a load balancer
+type iploadbalancingChanges struct {
+	config  *Config
+	service string
+	batched bool
+	done    bool
+}
+
+// iploadbalancingQueueChanges must be called before changing the configuration of a
+// load balancer. Apply must then be called once the changes are made, and Release
+// deferred to leave the queue of the service if the operation fails before.
+func iploadbalancingQueueChanges(config *Config, service string) *iploadbalancingChanges {
+	c := &iploadbalancingChanges{config: config, service: service}
+	if config.IpLoadbalancingRefreshMode == IpLoadbalancingRefreshModeBatch && config.ipLoadbalancingRefresher != nil {
+		config.ipLoadbalancingRefresher.Queue(service)
+		c.batched = true
+	}
+	return c
+}
+
+// Apply applies the changes according to the refresh mode of the provider. In batch
+// mode, it returns right away when other operations are queued on the service, they
+// apply the changes of this one.
+func (c *iploadbalancingChanges) Apply(ctx context.Context) error {
+	c.done = true
+	switch {
+	case c.config.IpLoadbalancingRefreshMode == IpLoadbalancingRefreshModeDisabled:
+		return nil
+	case c.batched:
+		return c.config.ipLoadbalancingRefresher.Done(ctx, c.service, true)
+	default:
+		return iploadbalancingRefreshPendingZones(ctx, c.config, c.service)
+	}
+}
+
+// Release leaves the queue of the service when the operation failed before Apply.
+// The changes left by the other operations are still applied if it was the last one.
+func (c *iploadbalancingChanges) Release(ctx context.Context) {
+	if c.done || !c.batched {
+		return
+	}
+	c.done = true
+	if err := c.config.ipLoadbalancingRefresher.Done(ctx, c.service, false); err != nil {
+		log.Printf("[WARN] Unable to apply the pending changes of IP load balancer %s: %s", c.service, err)
+	}
+}
+
+// iploadbalancingRefreshPendingZones triggers a refresh of each zone of the service
+// having pending changes, and waits for the refresh tasks to complete.
+func iploadbalancingRefreshPendingZones(ctx context.Context, config *Config, service string) error {
+	if err := iploadbalancingWaitRefreshTasks(ctx, config, service); err != nil {
+		return err
+	}
+
+	pendings := IPLoadbalancingRefreshPendings{}
+	endpoint := fmt.Sprintf("/ipLoadbalancing/%s/pendingChanges", url.PathEscape(service))
+	if err := config.OVHClient.GetWithContext(ctx, endpoint, &pendings); err != nil {
+		return fmt.Errorf("calling GET %s:\n\t %s", endpoint, err.Error())
+	}
+
+	tasks := make([]int, 0, len(pendings))
+	for _, pending := range pendings {
+		if pending.Number == 0 {
+			continue
+		}
+
+		log.Printf("[DEBUG] Refreshing zone %s of IP load balancer %s (%d pending changes)", pending.Zone, service, pending.Number)
+
+		task := &IPLoadbalancingRefreshTask{}
+		endpoint := fmt.Sprintf("/ipLoadbalancing/%s/refresh", url.PathEscape(service))
+		if err := config.OVHClient.PostWithContext(ctx, endpoint, map[string]string{"zone": pending.Zone}, task); err != nil {
+			return fmt.Errorf("calling POST %s :\n\t %s", endpoint, err.Error())
+		}
+		tasks = append(tasks, task.ID)
+	}
+
+	for _, taskID := range tasks {
+		if err := iploadbalancingWaitTask(ctx, config, service, taskID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// iploadbalancingWaitRefreshTasks waits until no refresh task is running on the service.
+func iploadbalancingWaitRefreshTasks(ctx context.Context, config *Config, service string) error {
+	stateConf := &retry.StateChangeConf{
+		Target: []string{"empty"},
+		Refresh: func() (interface{}, string, error) {
+			for _, state := range []string{"todo", "doing"} {
+				taskResp := []int{}
+				endpoint := fmt.Sprintf("/ipLoadbalancing/%s/task?action=refreshIplb&status=%s", url.PathEscape(service), state)
+				if err := config.OVHClient.GetWithContext(ctx, endpoint, &taskResp); err != nil {
+					return nil, "error", fmt.Errorf("calling GET %s:\n\t %s", endpoint, err.Error())
+				}
+				if len(taskResp) > 0 {
+					return taskResp, "exists", nil
+				}
+			}
+			return service, "empty", nil
+		},
+		Timeout:    10 * time.Minute,
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("Error waiting for IPLoadbalancer tasks to finish: %s", err)
+	}
+
+	return nil
+}
+
+// iploadbalancingWaitTask waits for a task of the service to be done.
+func iploadbalancingWaitTask(ctx context.Context, config *Config, service string, taskID int) error {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{"todo", "doing", "blocked"},
+		Target:  []string{"done"},
+		Refresh: func() (interface{}, string, error) {
+			endpoint := fmt.Sprintf("/ipLoadbalancing/%s/task/%d", url.PathEscape(service), taskID)
+			task := &IPLoadbalancingRefreshTask{}
+			if err := config.OVHClient.GetWithContext(ctx, endpoint, task); err != nil {
+				return nil, "", err
+			}
+			return task, task.Status, nil
+		},
+		Timeout:    10 * time.Minute,
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("Error waiting for IPLoadbalancer task %d: %s", taskID, err)
+	}
+
+	return nil
+}
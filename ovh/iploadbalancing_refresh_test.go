@@ -0,0 +1,224 @@
+package ovh
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+const testIpLoadbalancingRefreshDelay = 50 * time.Millisecond
+
+// testIpLoadbalancingRefreshCalls counts the refreshes of each service
+type testIpLoadbalancingRefreshCalls struct {
+	sync.Mutex
+	calls map[string]int
+	err   error
+}
+
+func (c *testIpLoadbalancingRefreshCalls) refresh(_ context.Context, service string) error {
+	c.Lock()
+	defer c.Unlock()
+	c.calls[service]++
+	return c.err
+}
+
+func (c *testIpLoadbalancingRefreshCalls) count(service string) int {
+	c.Lock()
+	defer c.Unlock()
+	return c.calls[service]
+}
+
+func newTestIpLoadbalancingRefresher() (*iploadbalancingRefresher, *testIpLoadbalancingRefreshCalls) {
+	calls := &testIpLoadbalancingRefreshCalls{calls: map[string]int{}}
+	return newIpLoadbalancingRefresher(testIpLoadbalancingRefreshDelay, calls.refresh), calls
+}
+
+func TestIpLoadbalancingRefresher_batch(t *testing.T) {
+	refresher, calls := newTestIpLoadbalancingRefresher()
+
+	for i := 0; i < 10; i++ {
+		refresher.Queue("loadbalancer-1")
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := refresher.Done(context.Background(), "loadbalancer-1", true); err != nil {
+				t.Errorf("unexpected error: %s", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got := calls.count("loadbalancer-1"); got != 1 {
+		t.Errorf("expected the queued operations to share a single refresh, got %d", got)
+	}
+
+	// Operations queued once the service is refreshed get a new refresh
+	refresher.Queue("loadbalancer-1")
+	if err := refresher.Done(context.Background(), "loadbalancer-1", true); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got := calls.count("loadbalancer-1"); got != 2 {
+		t.Errorf("expected a new refresh after the first batch, got %d refreshes", got)
+	}
+}
+
+func TestIpLoadbalancingRefresher_queued(t *testing.T) {
+	refresher, calls := newTestIpLoadbalancingRefresher()
+
+	refresher.Queue("loadbalancer-1")
+	refresher.Queue("loadbalancer-1")
+
+	// The first operation leaves its changes to the one still queued
+	start := time.Now()
+	if err := refresher.Done(context.Background(), "loadbalancer-1", true); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if time.Since(start) >= testIpLoadbalancingRefreshDelay {
+		t.Error("expected an operation to return right away while others are queued")
+	}
+	if got := calls.count("loadbalancer-1"); got != 0 {
+		t.Fatalf("expected no refresh while operations are queued, got %d", got)
+	}
+
+	// The last one refreshes the service even if it didn't change anything itself
+	if err := refresher.Done(context.Background(), "loadbalancer-1", false); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got := calls.count("loadbalancer-1"); got != 1 {
+		t.Errorf("expected the last operation to refresh the service, got %d refreshes", got)
+	}
+}
+
+func TestIpLoadbalancingRefresher_noChanges(t *testing.T) {
+	refresher, calls := newTestIpLoadbalancingRefresher()
+
+	refresher.Queue("loadbalancer-1")
+	if err := refresher.Done(context.Background(), "loadbalancer-1", false); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got := calls.count("loadbalancer-1"); got != 0 {
+		t.Errorf("expected no refresh without pending changes, got %d", got)
+	}
+}
+
+func TestIpLoadbalancingRefresher_handOff(t *testing.T) {
+	refresher, calls := newTestIpLoadbalancingRefresher()
+
+	refresher.Queue("loadbalancer-1")
+	errs := make(chan error, 1)
+	go func() {
+		errs <- refresher.Done(context.Background(), "loadbalancer-1", true)
+	}()
+
+	// A dependent resource is queued while the first operation settles
+	time.Sleep(testIpLoadbalancingRefreshDelay / 5)
+	refresher.Queue("loadbalancer-1")
+
+	if err := <-errs; err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got := calls.count("loadbalancer-1"); got != 0 {
+		t.Fatalf("expected the first operation to hand its changes off, got %d refreshes", got)
+	}
+
+	if err := refresher.Done(context.Background(), "loadbalancer-1", true); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got := calls.count("loadbalancer-1"); got != 1 {
+		t.Errorf("expected a single refresh for both operations, got %d", got)
+	}
+}
+
+func TestIpLoadbalancingRefresher_services(t *testing.T) {
+	refresher, calls := newTestIpLoadbalancingRefresher()
+
+	services := []string{"loadbalancer-1", "loadbalancer-2", "loadbalancer-1", "loadbalancer-2"}
+	for _, service := range services {
+		refresher.Queue(service)
+	}
+
+	var wg sync.WaitGroup
+	for _, service := range services {
+		service := service
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := refresher.Done(context.Background(), service, true); err != nil {
+				t.Errorf("unexpected error: %s", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if calls.count("loadbalancer-1") != 1 || calls.count("loadbalancer-2") != 1 {
+		t.Errorf("expected a single refresh per service, got %v", calls.calls)
+	}
+}
+
+func TestIpLoadbalancingRefresher_error(t *testing.T) {
+	refresher, calls := newTestIpLoadbalancingRefresher()
+	calls.err = errors.New("refresh failed")
+
+	refresher.Queue("loadbalancer-1")
+	if err := refresher.Done(context.Background(), "loadbalancer-1", true); !errors.Is(err, calls.err) {
+		t.Errorf("expected the refresh error, got %v", err)
+	}
+}
+
+func TestIpLoadbalancingRefresher_cancel(t *testing.T) {
+	refresher, calls := newTestIpLoadbalancingRefresher()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	refresher.Queue("loadbalancer-1")
+	if err := refresher.Done(ctx, "loadbalancer-1", true); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected the operation to be cancelled, got %v", err)
+	}
+	if got := calls.count("loadbalancer-1"); got != 0 {
+		t.Errorf("expected no refresh once the operation is cancelled, got %d", got)
+	}
+
+	// The changes left pending are applied by the next operation
+	refresher.Queue("loadbalancer-1")
+	if err := refresher.Done(context.Background(), "loadbalancer-1", false); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got := calls.count("loadbalancer-1"); got != 1 {
+		t.Errorf("expected the pending changes to be refreshed, got %d refreshes", got)
+	}
+}
+
+func TestIpLoadbalancingRefreshModeFromEnv(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    string
+		wantErr bool
+	}{
+		{value: "", want: IpLoadbalancingRefreshModeBatch},
+		{value: "resource", want: IpLoadbalancingRefreshModeResource},
+		{value: "disabled", want: IpLoadbalancingRefreshModeDisabled},
+		{value: "Batch", wantErr: true},
+		{value: "always", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			t.Setenv("OVH_IPLOADBALANCING_REFRESH", tt.value)
+
+			got, err := iploadbalancingRefreshModeFromEnv()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("iploadbalancingRefreshModeFromEnv() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("iploadbalancingRefreshModeFromEnv() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,159 @@
+package ovh
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// jsonApiClient is a minimal client of a JSON API served by a product rather
+// than by the OVHcloud API, such as the Kubernetes API of a cluster, the API of
+// a managed Rancher or the Harbor API of a registry
+type jsonApiClient struct {
+	// name of the API, used in errors
+	name   string
+	server string
+	// header is sent with every request, it carries the credentials of the client
+	header http.Header
+	client *http.Client
+}
+
+// jsonApiError is returned when a JSON API answers with an error status
+type jsonApiError struct {
+	Api     string
+	Code    int
+	Message string
+}
+
+func (e *jsonApiError) Error() string {
+	return fmt.Sprintf("%s API error %d: %s", e.Api, e.Code, e.Message)
+}
+
+// isJsonApiNotFound returns whether the error is a 404 of a JSON API
+func isJsonApiNotFound(err error) bool {
+	var apiErr *jsonApiError
+	return errors.As(err, &apiErr) && apiErr.Code == http.StatusNotFound
+}
+
+// newJsonApiClient builds a client for the API served at server, the default
+// transport is used when transport is nil
+func newJsonApiClient(name, server string, transport http.RoundTripper) *jsonApiClient {
+	return &jsonApiClient{
+		name:   name,
+		server: server,
+		header: http.Header{},
+		client: &http.Client{
+			Timeout:   30 * time.Second,
+			Transport: transport,
+		},
+	}
+}
+
+// do calls the API and decodes the JSON response in out when not nil.
+// in is sent encoded as JSON, with the given content type or application/json.
+func (c *jsonApiClient) do(ctx context.Context, method, path, contentType string, in, out interface{}) error {
+	_, err := c.send(ctx, method, path, contentType, in, out)
+	return err
+}
+
+// send is the same as do but also returns the headers of the response
+func (c *jsonApiClient) send(ctx context.Context, method, path, contentType string, in, out interface{}) (http.Header, error) {
+	var body io.Reader
+	if in != nil {
+		payload, err := json.Marshal(in)
+		if err != nil {
+			return nil, err
+		}
+		body = bytes.NewReader(payload)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.server+path, body)
+	if err != nil {
+		return nil, err
+	}
+	for key, values := range c.header {
+		req.Header[key] = values
+	}
+	req.Header.Set("Accept", "application/json")
+	if in != nil {
+		if contentType == "" {
+			contentType = "application/json"
+		}
+		req.Header.Set("Content-Type", contentType)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode >= 300 {
+		return nil, &jsonApiError{Api: c.name, Code: resp.StatusCode, Message: jsonApiErrorMessage(respBody)}
+	}
+
+	if out == nil || len(respBody) == 0 {
+		return resp.Header, nil
+	}
+
+	return resp.Header, json.Unmarshal(respBody, out)
+}
+
+// download fetches a file at an absolute URL, such as a manifest served by the
+// product. The credentials of the client are not sent.
+func (c *jsonApiClient) download(ctx context.Context, fileURL string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fileURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	content, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode >= 300 {
+		return nil, &jsonApiError{Api: c.name, Code: resp.StatusCode, Message: string(content)}
+	}
+
+	return content, nil
+}
+
+// jsonApiErrorMessage extracts the message of an error response, either from
+// its message field (Kubernetes, Rancher) or from its first error (Harbor),
+// falling back on the raw body
+func jsonApiErrorMessage(body []byte) string {
+	status := struct {
+		Message string `json:"message"`
+		Errors  []struct {
+			Message string `json:"message"`
+		} `json:"errors"`
+	}{}
+
+	if json.Unmarshal(body, &status) == nil {
+		if status.Message != "" {
+			return status.Message
+		}
+		if len(status.Errors) > 0 && status.Errors[0].Message != "" {
+			return status.Errors[0].Message
+		}
+	}
+
+	return string(body)
+}
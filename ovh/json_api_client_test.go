@@ -0,0 +1,86 @@
+package ovh
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestJsonApiClient(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		switch r.URL.Path {
+		case "/objects":
+			if r.Header.Get("Content-Type") != "application/json" {
+				w.WriteHeader(http.StatusUnsupportedMediaType)
+				return
+			}
+			w.Header().Set("Location", "/objects/42")
+			w.WriteHeader(http.StatusCreated)
+		case "/objects/42":
+			w.Write([]byte(`{"name":"object"}`))
+		case "/kube":
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"kind":"Status","message":"nodes \"node-1\" not found"}`))
+		case "/harbor":
+			w.WriteHeader(http.StatusConflict)
+			w.Write([]byte(`{"errors":[{"code":"CONFLICT","message":"project already exists"}]}`))
+		default:
+			w.WriteHeader(http.StatusBadGateway)
+			w.Write([]byte("bad gateway"))
+		}
+	}))
+	defer server.Close()
+
+	client := newJsonApiClient("test", server.URL, nil)
+	client.header.Set("Authorization", "Bearer secret")
+	ctx := context.Background()
+
+	header, err := client.send(ctx, http.MethodPost, "/objects", "", map[string]string{"name": "object"}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error creating object: %s", err)
+	}
+	if header.Get("Location") != "/objects/42" {
+		t.Errorf("Location = %q, want /objects/42", header.Get("Location"))
+	}
+
+	object := struct {
+		Name string `json:"name"`
+	}{}
+	if err := client.do(ctx, http.MethodGet, "/objects/42", "", nil, &object); err != nil {
+		t.Fatalf("unexpected error getting object: %s", err)
+	}
+	if object.Name != "object" {
+		t.Errorf("name = %q, want object", object.Name)
+	}
+
+	tests := []struct {
+		path     string
+		notFound bool
+		message  string
+	}{
+		{path: "/kube", notFound: true, message: `test API error 404: nodes "node-1" not found`},
+		{path: "/harbor", message: "test API error 409: project already exists"},
+		{path: "/unknown", message: "test API error 502: bad gateway"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			err := client.do(ctx, http.MethodGet, tt.path, "", nil, nil)
+			if err == nil {
+				t.Fatal("expected an error")
+			}
+			if err.Error() != tt.message {
+				t.Errorf("error = %q, want %q", err, tt.message)
+			}
+			if isJsonApiNotFound(err) != tt.notFound {
+				t.Errorf("isJsonApiNotFound() = %v, want %v", isJsonApiNotFound(err), tt.notFound)
+			}
+		})
+	}
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/ovh/terraform-provider-ovh/v2/ovh/helpers"
 	"go.uber.org/ratelimit"
 )
 
@@ -43,6 +44,11 @@ var (
 
 		// OVH API Rate Limit
 		"api_rate_limit": "Specify the API request rate limit, X operations by seconds (default: unlimited)",
+
+		// IP load balancers refresh
+		"iploadbalancing_refresh": "How changes made on IP load balancers are applied: " +
+			"`batch` refreshes each zone once no operation is left queued on the service, `resource` refreshes after each change, " +
+			"`disabled` leaves it to `ovh_iploadbalancing_refresh` resources (default: batch)",
 	}
 )
 
@@ -107,6 +113,12 @@ func Provider() *schema.Provider {
 				Optional:    true,
 				Description: descriptions["api_rate_limit"],
 			},
+			"iploadbalancing_refresh": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  descriptions["iploadbalancing_refresh"],
+				ValidateFunc: helpers.ValidateEnum(IpLoadbalancingRefreshModes),
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
@@ -152,6 +164,8 @@ func Provider() *schema.Provider {
 			"ovh_cloud_project_instance":                                     dataSourceCloudProjectInstance(),
 			"ovh_cloud_project_instances":                                    dataSourceCloudProjectInstances(),
 			"ovh_cloud_project_kube":                                         dataSourceCloudProjectKube(),
+			"ovh_cloud_project_kube_etcd_usage":                              dataSourceCloudProjectKubeEtcdUsage(),
+			"ovh_cloud_project_kubes":                                        dataSourceCloudProjectKubes(),
 			"ovh_cloud_project_kube_iprestrictions":                          dataSourceCloudProjectKubeIPRestrictions(),
 			"ovh_cloud_project_kube_nodepool_nodes":                          dataSourceCloudProjectKubeNodepoolNodes(),
 			"ovh_cloud_project_kube_oidc":                                    dataSourceCloudProjectKubeOIDC(),
@@ -200,6 +214,7 @@ func Provider() *schema.Provider {
 			"ovh_iam_resource_groups":                                        dataSourceIamResourceGroups(),
 			"ovh_ip_service":                                                 dataSourceIpService(),
 			"ovh_iploadbalancing":                                            dataSourceIpLoadbalancing(),
+			"ovh_iploadbalancing_metrics":                                    dataSourceIpLoadbalancingMetrics(),
 			"ovh_iploadbalancing_vrack_network":                              dataSourceIpLoadbalancingVrackNetwork(),
 			"ovh_iploadbalancing_vrack_networks":                             dataSourceIpLoadbalancingVrackNetworks(),
 			"ovh_me":                                                         dataSourceMe(),
@@ -228,7 +243,12 @@ func Provider() *schema.Provider {
 			"ovh_cloud_project_containerregistry_iam":                        resourceCloudProjectContainerRegistryIAM(),
 			"ovh_cloud_project_containerregistry_user":                       resourceCloudProjectContainerRegistryUser(),
 			"ovh_cloud_project_containerregistry_ip_restrictions_management": resourceCloudProjectContainerRegistryIPRestrictionsManagement(),
+			"ovh_cloud_project_containerregistry_gc":                         resourceCloudProjectContainerRegistryGC(),
 			"ovh_cloud_project_containerregistry_ip_restrictions_registry":   resourceCloudProjectContainerRegistryIPRestrictionsRegistry(),
+			"ovh_cloud_project_containerregistry_project":                    resourceCloudProjectContainerRegistryProject(),
+			"ovh_cloud_project_containerregistry_replication":                resourceCloudProjectContainerRegistryReplication(),
+			"ovh_cloud_project_containerregistry_retention":                  resourceCloudProjectContainerRegistryRetention(),
+			"ovh_cloud_project_containerregistry_robot":                      resourceCloudProjectContainerRegistryRobot(),
 			"ovh_cloud_project_database":                                     resourceCloudProjectDatabase(),
 			"ovh_cloud_project_database_clickhouse_user":                     resourceCloudProjectDatabaseClickhouseUser(),
 			"ovh_cloud_project_database_database":                            resourceCloudProjectDatabaseDatabase(),
@@ -251,9 +271,11 @@ func Provider() *schema.Provider {
 			"ovh_cloud_project_instance":                                     resourceCloudProjectInstance(),
 			"ovh_cloud_project_kube":                                         resourceCloudProjectKube(),
 			"ovh_cloud_project_kube_nodepool":                                resourceCloudProjectKubeNodePool(),
+			"ovh_cloud_project_kube_node_action":                             resourceCloudProjectKubeNodeAction(),
 			"ovh_cloud_project_kube_oidc":                                    resourceCloudProjectKubeOIDC(),
 			"ovh_cloud_project_kube_iprestrictions":                          resourceCloudProjectKubeIpRestrictions(),
 			"ovh_cloud_project_kube_log_subscription":                        resourceCloudProjectKubeLogSubscription(),
+			"ovh_cloud_project_kube_backup_target":                           resourceCloudProjectKubeBackupTarget(),
 			"ovh_cloud_project_network_private":                              resourceCloudProjectNetworkPrivate(),
 			"ovh_cloud_project_network_private_subnet":                       resourceCloudProjectNetworkPrivateSubnet(),
 			"ovh_cloud_project_network_private_subnet_v2":                    resourceCloudProjectNetworkPrivateSubnetV2(),
@@ -293,6 +315,8 @@ func Provider() *schema.Provider {
 			"ovh_iam_resource_tags":                                          resourceIamResourceTags(),
 			"ovh_iam_resource_group":                                         resourceIamResourceGroup(),
 			"ovh_ip_reverse":                                                 resourceIpReverse(),
+			"ovh_ip_reverse_block":                                           resourceIpReverseBlock(),
+			"ovh_ip_routing":                                                 resourceIpRouting(),
 			"ovh_ip_service":                                                 resourceIpService(),
 			"ovh_ip_move":                                                    resourceIpServiceMove(),
 			"ovh_iploadbalancing":                                            resourceIpLoadbalancing(),
@@ -301,6 +325,7 @@ func Provider() *schema.Provider {
 			"ovh_iploadbalancing_http_frontend":                              resourceIpLoadbalancingHttpFrontend(),
 			"ovh_iploadbalancing_http_route":                                 resourceIPLoadbalancingHttpRoute(),
 			"ovh_iploadbalancing_http_route_rule":                            resourceIPLoadbalancingHttpRouteRule(),
+			"ovh_iploadbalancing_log_subscription":                           resourceIpLoadbalancingLogSubscription(),
 			"ovh_iploadbalancing_refresh":                                    resourceIPLoadbalancingRefresh(),
 			"ovh_iploadbalancing_tcp_farm":                                   resourceIpLoadbalancingTcpFarm(),
 			"ovh_iploadbalancing_tcp_farm_server":                            resourceIpLoadbalancingTcpFarmServer(),
@@ -372,6 +397,14 @@ func ConfigureContextFunc(context context.Context, d *schema.ResourceData) (inte
 	} else {
 		config.ApiRateLimit = ratelimit.NewUnlimited()
 	}
+	if v, ok := d.GetOk("iploadbalancing_refresh"); ok {
+		config.IpLoadbalancingRefreshMode = v.(string)
+	} else if v, err := iploadbalancingRefreshModeFromEnv(); err != nil {
+		return nil, diag.FromErr(err)
+	} else {
+		config.IpLoadbalancingRefreshMode = v
+	}
+	config.ipLoadbalancingRefresher = iploadbalancingConfigRefresher(&config)
 
 	if err := config.loadAndValidate(); err != nil {
 		return nil, diag.FromErr(err)
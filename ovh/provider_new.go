@@ -6,11 +6,13 @@ import (
 	"strconv"
 	"sync"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"go.uber.org/ratelimit"
@@ -84,6 +86,13 @@ func (p *OvhProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp *
 				Optional:    true,
 				Description: descriptions["api_rate_limit"],
 			},
+			"iploadbalancing_refresh": schema.StringAttribute{
+				Optional:    true,
+				Description: descriptions["iploadbalancing_refresh"],
+				Validators: []validator.String{
+					stringvalidator.OneOf(IpLoadbalancingRefreshModes...),
+				},
+			},
 		},
 	}
 }
@@ -221,6 +230,15 @@ func (p *OvhProvider) Configure(ctx context.Context, req provider.ConfigureReque
 	} else {
 		clientConfig.ApiRateLimit = ratelimit.NewUnlimited()
 	}
+	if !config.IpLoadbalancingRefresh.IsNull() {
+		clientConfig.IpLoadbalancingRefreshMode = config.IpLoadbalancingRefresh.ValueString()
+	} else if v, err := iploadbalancingRefreshModeFromEnv(); err != nil {
+		resp.Diagnostics.AddError("Invalid OVH_IPLOADBALANCING_REFRESH", err.Error())
+		return
+	} else {
+		clientConfig.IpLoadbalancingRefreshMode = v
+	}
+	clientConfig.ipLoadbalancingRefresher = iploadbalancingConfigRefresher(&clientConfig)
 
 	if err := clientConfig.loadAndValidate(); err != nil {
 		if !clientConfig.IgnoreInitError {
@@ -304,6 +322,7 @@ func (p *OvhProvider) DataSources(_ context.Context) []func() datasource.DataSou
 		NewDedicatedServerSpecificationsHardwareDataSource,
 		NewDedicatedServerSpecificationsNetworkDataSource,
 		NewDomainZoneDnssecDataSource,
+		NewDomainZoneHistoryDataSource,
 		NewDomainZoneRecordDataSource,
 		NewDomainZoneRecordsDataSource,
 		NewEmailDomainAccountDataSource,
@@ -312,7 +331,10 @@ func (p *OvhProvider) DataSources(_ context.Context) []func() datasource.DataSou
 		NewIpFirewallRuleDataSource,
 		NewIploadbalancingsDataSource,
 		NewIploadbalancingNatIpsDataSource,
+		NewIploadbalancingFarmHealthDataSource,
+		NewIpAntihackDataSource,
 		NewIpMitigationDataSource,
+		NewIpSpamDataSource,
 		NewLocationDataSource,
 		NewOkmsCredentialDataSource,
 		NewOkmsResourceDataSource,
@@ -379,6 +401,9 @@ func (p *OvhProvider) Resources(_ context.Context) []func() resource.Resource {
 		NewCloudProjectGatewayInterfaceResource,
 		NewCloudProjectInstanceSnapshotResource,
 		NewCloudProjectLoadbalancerResource,
+		NewCloudProjectRancherAdminCredentialsResource,
+		NewCloudProjectRancherClusterResource,
+		NewCloudProjectRancherIamBindingResource,
 		NewCloudProjectRancherResource,
 		NewCloudProjectRegionNetworkResource,
 		NewCloudProjectRegionResource,
@@ -405,17 +430,41 @@ func (p *OvhProvider) Resources(_ context.Context) []func() resource.Resource {
 		NewDedicatedServerResource,
 		NewDomainNameResource,
 		NewDomainZoneDnssecResource,
+		NewDomainZoneHistoryRestoreResource,
 		NewDomainZoneImportResource,
+		NewDomainZoneSoaResource,
 		NewEmailDomainAccountResource,
+		NewEmailDomainAccountDelegationResource,
+		NewEmailDomainAccountFilterResource,
+		NewEmailDomainMailingListResource,
+		NewEmailDomainMailingListModeratorResource,
+		NewEmailDomainMailingListSubscriberResource,
+		NewEmailDomainRedirectionResource,
+		NewEmailDomainResponderResource,
+		NewEmailExchangeAccountResource,
+		NewEmailExchangeDomainResource,
+		NewEmailExchangeExternalContactResource,
+		NewEmailExchangeMailingListResource,
+		NewEmailExchangeSharedAccountResource,
+		NewEmailProAccountResource,
+		NewEmailProDomainResource,
+		NewEmailProExternalContactResource,
+		NewEmailProMailingListResource,
+		NewEmailProSharedAccountResource,
 		NewDomainZoneDynhostLoginResource,
 		NewDomainZoneDynhostRecordResource,
 		NewIpFirewallResource,
 		NewIpFirewallRuleResource,
+		NewIpFirewallRulesetResource,
+		NewIpGameMitigationResource,
+		NewIpGameRuleResource,
+		NewIploadbalancingFreeCertificateResource,
 		NewIploadbalancingSslResource,
 		NewIploadbalancingUdpFrontendResource,
 		NewIploadbalancingUdpFarmResource,
 		NewIploadbalancingUdpFarmServerResource,
 		NewIpMitigationResource,
+		NewIpUnblockResource,
 		NewMeIdentityUserTokenResource,
 		NewOkmsResource,
 		NewOkmsCredentialResource,
@@ -440,15 +489,16 @@ func (p *OvhProvider) Resources(_ context.Context) []func() resource.Resource {
 }
 
 type ovhProviderModel struct {
-	Endpoint          types.String `tfsdk:"endpoint"`
-	AccessToken       types.String `tfsdk:"access_token"`
-	ApplicationKey    types.String `tfsdk:"application_key"`
-	ApplicationSecret types.String `tfsdk:"application_secret"`
-	ConsumerKey       types.String `tfsdk:"consumer_key"`
-	ClientID          types.String `tfsdk:"client_id"`
-	ClientSecret      types.String `tfsdk:"client_secret"`
-	UserAgentExtra    types.String `tfsdk:"user_agent_extra"`
-	HttpHeaders       types.Map    `tfsdk:"http_headers"`
-	IgnoreInitError   types.Bool   `tfsdk:"ignore_init_error"`
-	ApiRateLimit      types.Int32  `tfsdk:"api_rate_limit"`
+	Endpoint               types.String `tfsdk:"endpoint"`
+	AccessToken            types.String `tfsdk:"access_token"`
+	ApplicationKey         types.String `tfsdk:"application_key"`
+	ApplicationSecret      types.String `tfsdk:"application_secret"`
+	ConsumerKey            types.String `tfsdk:"consumer_key"`
+	ClientID               types.String `tfsdk:"client_id"`
+	ClientSecret           types.String `tfsdk:"client_secret"`
+	UserAgentExtra         types.String `tfsdk:"user_agent_extra"`
+	HttpHeaders            types.Map    `tfsdk:"http_headers"`
+	IgnoreInitError        types.Bool   `tfsdk:"ignore_init_error"`
+	ApiRateLimit           types.Int32  `tfsdk:"api_rate_limit"`
+	IpLoadbalancingRefresh types.String `tfsdk:"iploadbalancing_refresh"`
 }
@@ -111,6 +111,13 @@ func testAccPreCheckIp(t *testing.T) {
 	checkEnvOrSkip(t, "OVH_IP_REVERSE_TEST")
 }
 
+// Checks that the environment variables needed for the /ip/{ip}/game acceptance tests
+// are set.
+func testAccPreCheckIpGame(t *testing.T) {
+	testAccPreCheckCredentials(t)
+	checkEnvOrSkip(t, "OVH_IP_GAME_TEST")
+}
+
 // Checks that the environment variables needed for the /ip/move acceptance tests
 // are set.
 func testAccPreCheckIpMove(t *testing.T) {
@@ -651,6 +658,19 @@ func testAccPreCheckEmailDomain(t *testing.T) {
 	checkEnvOrSkip(t, "OVH_EMAIL_DOMAIN_TEST")
 }
 
+func testAccPreCheckEmailExchange(t *testing.T) {
+	testAccPreCheckCredentials(t)
+	checkEnvOrSkip(t, "OVH_EMAIL_EXCHANGE_ORGANIZATION_TEST")
+	checkEnvOrSkip(t, "OVH_EMAIL_EXCHANGE_SERVICE_TEST")
+	checkEnvOrSkip(t, "OVH_EMAIL_EXCHANGE_DOMAIN_TEST")
+}
+
+func testAccPreCheckEmailPro(t *testing.T) {
+	testAccPreCheckCredentials(t)
+	checkEnvOrSkip(t, "OVH_EMAIL_PRO_SERVICE_TEST")
+	checkEnvOrSkip(t, "OVH_EMAIL_PRO_DOMAIN_TEST")
+}
+
 func testAccCheckStorageEfsExists(t *testing.T) {
 	type efsServiceResponse struct {
 		ID     string `json:"id"`
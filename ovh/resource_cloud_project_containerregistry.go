@@ -25,6 +25,7 @@ func resourceCloudProjectContainerRegistry() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: resourceCloudProjectContainerRegistryImportState,
 		},
+		CustomizeDiff: resourceCloudProjectContainerRegistryCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"service_name": {
@@ -44,7 +45,7 @@ func resourceCloudProjectContainerRegistry() *schema.Resource {
 			},
 			"plan_id": {
 				Type:        schema.TypeString,
-				Description: "Plan ID of the registry.",
+				Description: "Plan ID of the registry. It can be changed in place to upgrade the registry to a higher plan.",
 				Optional:    true,
 				Computed:    true,
 			},
@@ -177,6 +178,47 @@ func resourceCloudProjectContainerRegistryImportState(d *schema.ResourceData, me
 	return []*schema.ResourceData{d}, nil
 }
 
+// resourceCloudProjectContainerRegistryCustomizeDiff checks at plan time that a
+// new plan of an existing registry is one it can be upgraded to, as the API
+// refuses downgrades
+func resourceCloudProjectContainerRegistryCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || !d.HasChange("plan_id") || !d.NewValueKnown("plan_id") {
+		return nil
+	}
+
+	planID := d.Get("plan_id").(string)
+	if planID == "" {
+		return nil
+	}
+
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+
+	endpoint := fmt.Sprintf(
+		"/cloud/project/%s/containerRegistry/%s/capabilities/plan",
+		url.PathEscape(serviceName),
+		url.PathEscape(d.Id()),
+	)
+
+	plans := []CloudProjectCapabilitiesContainerRegistryPlan{}
+	if err := config.OVHClient.GetWithContext(ctx, endpoint, &plans); err != nil {
+		return fmt.Errorf("Error calling get %s:\n\t %q", endpoint, err)
+	}
+
+	available := make([]string, 0, len(plans))
+	for _, plan := range plans {
+		if plan.Id == planID {
+			return d.SetNewComputed("plan")
+		}
+		available = append(available, fmt.Sprintf("%s (%s)", plan.Name, plan.Id))
+	}
+
+	return fmt.Errorf(
+		"plan %s is not an available upgrade for registry %s, a registry can only be upgraded to a higher plan. Available plans: %s",
+		planID, d.Id(), strings.Join(available, ", "),
+	)
+}
+
 func resourceCloudProjectContainerRegistryCreate(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 	serviceName := d.Get("service_name").(string)
@@ -0,0 +1,202 @@
+package ovh
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/ovh/terraform-provider-ovh/v2/ovh/helpers"
+)
+
+func resourceCloudProjectContainerRegistryGC() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCloudProjectContainerRegistryGCPut,
+		ReadContext:   resourceCloudProjectContainerRegistryGCRead,
+		UpdateContext: resourceCloudProjectContainerRegistryGCPut,
+		DeleteContext: resourceCloudProjectContainerRegistryGCDelete,
+		CustomizeDiff: func(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+			if d.Get("schedule_type").(string) == "Custom" && d.NewValueKnown("cron") && d.Get("cron").(string) == "" {
+				return fmt.Errorf("cron is required when schedule_type is Custom")
+			}
+			return nil
+		},
+
+		Schema: cloudProjectContainerRegistryHarborSchema(map[string]*schema.Schema{
+			"schedule_type": {
+				Type:         schema.TypeString,
+				Description:  "Frequency of the garbage collection",
+				Required:     true,
+				ValidateFunc: helpers.ValidateEnum([]string{"Hourly", "Daily", "Weekly", "Custom"}),
+			},
+			"cron": {
+				Type:        schema.TypeString,
+				Description: "Cron expression, with seconds, of the garbage collection when schedule_type is Custom",
+				Optional:    true,
+				Computed:    true,
+			},
+			"delete_untagged": {
+				Type:        schema.TypeBool,
+				Description: "Whether untagged artifacts are deleted too",
+				Optional:    true,
+				Default:     false,
+			},
+			"workers": {
+				Type:         schema.TypeInt,
+				Description:  "Number of workers running the garbage collection",
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntBetween(1, 5),
+			},
+
+			// Computed
+			"storage_used": {
+				Type:        schema.TypeInt,
+				Description: "Storage used by the registry in bytes",
+				Computed:    true,
+			},
+			"storage_quota": {
+				Type:        schema.TypeInt,
+				Description: "Storage quota of the plan of the registry in bytes",
+				Computed:    true,
+			},
+			"storage_usage_percent": {
+				Type:        schema.TypeFloat,
+				Description: "Percentage of the storage quota used by the registry",
+				Computed:    true,
+			},
+			"last_run_status": {
+				Type:        schema.TypeString,
+				Description: "Status of the last garbage collection",
+				Computed:    true,
+			},
+			"last_run_at": {
+				Type:        schema.TypeString,
+				Description: "Date of the last garbage collection",
+				Computed:    true,
+			},
+		}),
+	}
+}
+
+func resourceCloudProjectContainerRegistryGCPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := newHarborApiClient(ctx, d, meta.(*Config))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	current := &HarborGCSchedule{}
+	if _, err := client.do(ctx, http.MethodGet, "/system/gc/schedule", nil, current); err != nil && !isJsonApiNotFound(err) {
+		return diag.Errorf("reading garbage collection schedule: %s", err)
+	}
+
+	// The schedule has to be created the first time, then updated
+	method := http.MethodPut
+	if current.Schedule == nil || current.Schedule.Type == "" || current.Schedule.Type == "None" {
+		method = http.MethodPost
+	}
+
+	schedule := (&HarborGCSchedule{}).FromResource(d)
+
+	log.Printf("[DEBUG] Will set garbage collection schedule of registry %s: %+v", d.Get("registry_id"), schedule.Schedule)
+	if _, err := client.do(ctx, method, "/system/gc/schedule", schedule, nil); err != nil {
+		return diag.Errorf("setting garbage collection schedule: %s", err)
+	}
+
+	d.SetId(d.Get("registry_id").(string))
+
+	return resourceCloudProjectContainerRegistryGCRead(ctx, d, meta)
+}
+
+func resourceCloudProjectContainerRegistryGCRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	client, err := newHarborApiClient(ctx, d, config)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	schedule := &HarborGCSchedule{}
+	if _, err := client.do(ctx, http.MethodGet, "/system/gc/schedule", nil, schedule); err != nil && !isJsonApiNotFound(err) {
+		return diag.Errorf("reading garbage collection schedule: %s", err)
+	}
+
+	if schedule.Schedule == nil || schedule.Schedule.Type == "" || schedule.Schedule.Type == "None" {
+		log.Printf("[DEBUG] Garbage collection of registry %s is not scheduled, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	for k, v := range schedule.ToMap() {
+		d.Set(k, v)
+	}
+
+	history := []HarborGCHistory{}
+	if _, err := client.do(ctx, http.MethodGet, "/system/gc?page=1&page_size=1&sort=-creation_time", nil, &history); err != nil {
+		return diag.Errorf("reading garbage collection history: %s", err)
+	}
+	if len(history) > 0 {
+		d.Set("last_run_status", history[0].JobStatus)
+		d.Set("last_run_at", history[0].CreationTime)
+	}
+
+	return cloudProjectContainerRegistryStorageRead(d, config)
+}
+
+// cloudProjectContainerRegistryStorageRead sets the storage used by the
+// registry against the quota of its plan
+func cloudProjectContainerRegistryStorageRead(d *schema.ResourceData, config *Config) diag.Diagnostics {
+	serviceName := d.Get("service_name").(string)
+	registryID := d.Get("registry_id").(string)
+
+	registry := &CloudProjectContainerRegistry{}
+	endpoint := fmt.Sprintf(
+		"/cloud/project/%s/containerRegistry/%s",
+		url.PathEscape(serviceName),
+		url.PathEscape(registryID),
+	)
+	if err := config.OVHClient.Get(endpoint, registry); err != nil {
+		return diag.Errorf("calling Get %s:\n\t %q", endpoint, err)
+	}
+
+	plan := &CloudProjectCapabilitiesContainerRegistryPlan{}
+	endpoint += "/plan"
+	if err := config.OVHClient.Get(endpoint, plan); err != nil {
+		return diag.Errorf("calling Get %s:\n\t %q", endpoint, err)
+	}
+
+	d.Set("storage_used", registry.Size)
+	d.Set("storage_quota", plan.RegistryLimits.ImageStorage)
+
+	usagePercent := 0.0
+	if plan.RegistryLimits.ImageStorage > 0 {
+		usagePercent = float64(registry.Size) * 100 / float64(plan.RegistryLimits.ImageStorage)
+	}
+	d.Set("storage_usage_percent", usagePercent)
+
+	return nil
+}
+
+func resourceCloudProjectContainerRegistryGCDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := newHarborApiClient(ctx, d, meta.(*Config))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	schedule := &HarborGCSchedule{
+		Schedule: &HarborScheduleObj{Type: "None"},
+	}
+
+	log.Printf("[DEBUG] Will unschedule garbage collection of registry %s", d.Id())
+	if _, err := client.do(ctx, http.MethodPut, "/system/gc/schedule", schedule, nil); err != nil {
+		return diag.Errorf("unscheduling garbage collection: %s", err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
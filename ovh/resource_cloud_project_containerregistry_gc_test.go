@@ -0,0 +1,45 @@
+package ovh
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const testAccCloudProjectContainerRegistryGCConfig = `
+resource "ovh_cloud_project_containerregistry_gc" "gc" {
+	service_name    = ovh_cloud_project_containerregistry.reg.service_name
+	registry_id     = ovh_cloud_project_containerregistry.reg.id
+	username        = ovh_cloud_project_containerregistry_user.user.user
+	password        = ovh_cloud_project_containerregistry_user.user.password
+	schedule_type   = "%s"
+	cron            = "%s"
+	delete_untagged = true
+}
+`
+
+func TestAccCloudProjectContainerRegistryGC_basic(t *testing.T) {
+	base := testAccCloudProjectContainerRegistryHarborBaseConfig()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckContainerRegistry(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: base + fmt.Sprintf(testAccCloudProjectContainerRegistryGCConfig, "Custom", "0 0 3 * * 6"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ovh_cloud_project_containerregistry_gc.gc", "schedule_type", "Custom"),
+					resource.TestCheckResourceAttr("ovh_cloud_project_containerregistry_gc.gc", "cron", "0 0 3 * * 6"),
+					resource.TestCheckResourceAttr("ovh_cloud_project_containerregistry_gc.gc", "delete_untagged", "true"),
+					resource.TestCheckResourceAttrSet("ovh_cloud_project_containerregistry_gc.gc", "storage_quota"),
+					resource.TestCheckResourceAttrSet("ovh_cloud_project_containerregistry_gc.gc", "storage_usage_percent"),
+				),
+			},
+			{
+				Config: base + fmt.Sprintf(testAccCloudProjectContainerRegistryGCConfig, "Custom", "0 0 4 * * *"),
+				Check:  resource.TestCheckResourceAttr("ovh_cloud_project_containerregistry_gc.gc", "cron", "0 0 4 * * *"),
+			},
+		},
+	})
+}
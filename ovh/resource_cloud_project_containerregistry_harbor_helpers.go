@@ -0,0 +1,130 @@
+package ovh
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"log"
+	"net/url"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// harborApiClient is a minimal client of the Harbor API of a managed private
+// registry, authenticated with the credentials of one of its users
+type harborApiClient struct {
+	*jsonApiClient
+}
+
+// cloudProjectContainerRegistryHarborSchema returns the arguments shared by the
+// resources managed through the Harbor API of a registry
+func cloudProjectContainerRegistryHarborSchema(s map[string]*schema.Schema) map[string]*schema.Schema {
+	s["service_name"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "Service name",
+		ForceNew:    true,
+		Required:    true,
+		DefaultFunc: schema.EnvDefaultFunc("OVH_CLOUD_PROJECT_SERVICE", nil),
+	}
+	s["registry_id"] = &schema.Schema{
+		Type:        schema.TypeString,
+		ForceNew:    true,
+		Description: "RegistryID",
+		Required:    true,
+	}
+	s["username"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "Login of the registry user used to call the Harbor API",
+		Required:    true,
+		DefaultFunc: schema.EnvDefaultFunc("OVH_CLOUD_PROJECT_CONTAINERREGISTRY_USERNAME", nil),
+	}
+	s["password"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "Password of the registry user used to call the Harbor API",
+		Required:    true,
+		Sensitive:   true,
+		DefaultFunc: schema.EnvDefaultFunc("OVH_CLOUD_PROJECT_CONTAINERREGISTRY_PASSWORD", nil),
+	}
+
+	return s
+}
+
+// cloudProjectContainerRegistryHarborImportState imports a resource of the Harbor API
+// of a registry from an ID formatted as service_name/registry_id/id. The credentials
+// of the registry user can't be imported, they are read from the environment.
+func cloudProjectContainerRegistryHarborImportState(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	givenId := d.Id()
+	log.Printf("[DEBUG] Importing registry Harbor resource %s", givenId)
+
+	splitId := strings.SplitN(givenId, "/", 3)
+	if len(splitId) != 3 || splitId[0] == "" || splitId[1] == "" || splitId[2] == "" {
+		return nil, fmt.Errorf("import Id is not service_name/registry_id/id formatted")
+	}
+
+	username := os.Getenv("OVH_CLOUD_PROJECT_CONTAINERREGISTRY_USERNAME")
+	password := os.Getenv("OVH_CLOUD_PROJECT_CONTAINERREGISTRY_PASSWORD")
+	if username == "" || password == "" {
+		return nil, fmt.Errorf("OVH_CLOUD_PROJECT_CONTAINERREGISTRY_USERNAME and OVH_CLOUD_PROJECT_CONTAINERREGISTRY_PASSWORD must be set to import a registry Harbor resource")
+	}
+
+	d.SetId(splitId[2])
+	d.Set("service_name", splitId[0])
+	d.Set("registry_id", splitId[1])
+	d.Set("username", username)
+	d.Set("password", password)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// newHarborApiClient builds a client for the Harbor API of the registry of the resource
+func newHarborApiClient(ctx context.Context, d *schema.ResourceData, config *Config) (*harborApiClient, error) {
+	serviceName := d.Get("service_name").(string)
+	registryID := d.Get("registry_id").(string)
+
+	registry := &CloudProjectContainerRegistry{}
+	endpoint := fmt.Sprintf(
+		"/cloud/project/%s/containerRegistry/%s",
+		url.PathEscape(serviceName),
+		url.PathEscape(registryID),
+	)
+
+	if err := config.OVHClient.GetWithContext(ctx, endpoint, registry); err != nil {
+		return nil, fmt.Errorf("calling Get %s:\n\t %q", endpoint, err)
+	}
+	if registry.Url == "" {
+		return nil, fmt.Errorf("registry %s has no URL yet", registryID)
+	}
+
+	c := newJsonApiClient("harbor", strings.TrimSuffix(registry.Url, "/")+"/api/v2.0", nil)
+	credentials := d.Get("username").(string) + ":" + d.Get("password").(string)
+	c.header.Set("Authorization", "Basic "+base64.StdEncoding.EncodeToString([]byte(credentials)))
+	// Project names are given instead of their numeric IDs
+	c.header.Set("X-Is-Resource-Name", "true")
+
+	return &harborApiClient{c}, nil
+}
+
+// do calls the Harbor API and decodes the JSON response in out when not nil.
+// It returns the ID of the created object found in the Location header, if any.
+func (c *harborApiClient) do(ctx context.Context, method, endpoint string, in, out interface{}) (int64, error) {
+	header, err := c.send(ctx, method, endpoint, "", in, out)
+	if err != nil {
+		return 0, err
+	}
+
+	var id int64
+	if location := header.Get("Location"); location != "" {
+		id, _ = strconv.ParseInt(path.Base(location), 10, 64)
+	}
+
+	return id, nil
+}
+
+// harborProjectPath returns the API path of a project
+func harborProjectPath(project string) string {
+	return "/projects/" + url.PathEscape(project)
+}
@@ -0,0 +1,198 @@
+package ovh
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/ovh/terraform-provider-ovh/v2/ovh/helpers"
+)
+
+func resourceCloudProjectContainerRegistryProject() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCloudProjectContainerRegistryProjectCreate,
+		ReadContext:   resourceCloudProjectContainerRegistryProjectRead,
+		UpdateContext: resourceCloudProjectContainerRegistryProjectUpdate,
+		DeleteContext: resourceCloudProjectContainerRegistryProjectDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: cloudProjectContainerRegistryHarborImportState,
+		},
+
+		Schema: cloudProjectContainerRegistryHarborSchema(map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Description: "Project name",
+				ForceNew:    true,
+				Required:    true,
+			},
+			"public": {
+				Type:        schema.TypeBool,
+				Description: "Whether anyone can pull the images of the project",
+				Optional:    true,
+				Default:     false,
+			},
+			"storage_limit": {
+				Type:        schema.TypeInt,
+				Description: "Storage quota of the project in bytes, -1 for unlimited",
+				Optional:    true,
+				Default:     -1,
+			},
+			"auto_scan": {
+				Type:        schema.TypeBool,
+				Description: "Whether images are scanned for vulnerabilities when pushed",
+				Optional:    true,
+				Default:     false,
+			},
+			"prevent_vulnerable": {
+				Type:        schema.TypeBool,
+				Description: "Whether images with vulnerabilities of at least the given severity can't be pulled",
+				Optional:    true,
+				Default:     false,
+			},
+			"severity": {
+				Type:         schema.TypeString,
+				Description:  "Severity from which vulnerable images can't be pulled when prevent_vulnerable is set",
+				Optional:     true,
+				Default:      "low",
+				ValidateFunc: helpers.ValidateEnum([]string{"none", "low", "medium", "high", "critical"}),
+			},
+
+			// Computed
+			"project_id": {
+				Type:        schema.TypeInt,
+				Description: "ID of the project in Harbor",
+				Computed:    true,
+			},
+			"repo_count": {
+				Type:        schema.TypeInt,
+				Description: "Number of repositories in the project",
+				Computed:    true,
+			},
+			"storage_used": {
+				Type:        schema.TypeInt,
+				Description: "Storage used by the project in bytes",
+				Computed:    true,
+			},
+		}),
+	}
+}
+
+func resourceCloudProjectContainerRegistryProjectCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := newHarborApiClient(ctx, d, meta.(*Config))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	opts := (&HarborProjectCreateOpts{}).FromResource(d)
+
+	log.Printf("[DEBUG] Will create registry project: %+v", opts)
+	if _, err := client.do(ctx, http.MethodPost, "/projects", opts, nil); err != nil {
+		return diag.Errorf("creating registry project %s: %s", opts.ProjectName, err)
+	}
+
+	d.SetId(opts.ProjectName)
+
+	return resourceCloudProjectContainerRegistryProjectRead(ctx, d, meta)
+}
+
+func resourceCloudProjectContainerRegistryProjectRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := newHarborApiClient(ctx, d, meta.(*Config))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	project := &HarborProject{}
+	if _, err := client.do(ctx, http.MethodGet, harborProjectPath(d.Id()), nil, project); err != nil {
+		if isJsonApiNotFound(err) {
+			log.Printf("[DEBUG] Registry project %s does not exist, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("reading registry project %s: %s", d.Id(), err)
+	}
+
+	for k, v := range project.ToMap() {
+		d.Set(k, v)
+	}
+
+	quota, err := harborProjectQuota(ctx, client, project.ProjectId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set("storage_limit", quota.Hard["storage"])
+	d.Set("storage_used", quota.Used["storage"])
+
+	return nil
+}
+
+func resourceCloudProjectContainerRegistryProjectUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := newHarborApiClient(ctx, d, meta.(*Config))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChanges("public", "auto_scan", "prevent_vulnerable", "severity") {
+		opts := (&HarborProjectUpdateOpts{}).FromResource(d)
+
+		log.Printf("[DEBUG] Will update registry project %s: %+v", d.Id(), opts)
+		if _, err := client.do(ctx, http.MethodPut, harborProjectPath(d.Id()), opts, nil); err != nil {
+			return diag.Errorf("updating registry project %s: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("storage_limit") {
+		quota, err := harborProjectQuota(ctx, client, int64(d.Get("project_id").(int)))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		opts := map[string]interface{}{
+			"hard": map[string]int64{"storage": int64(d.Get("storage_limit").(int))},
+		}
+
+		log.Printf("[DEBUG] Will update quota of registry project %s: %+v", d.Id(), opts)
+		if _, err := client.do(ctx, http.MethodPut, "/quotas/"+strconv.FormatInt(quota.Id, 10), opts, nil); err != nil {
+			return diag.Errorf("updating quota of registry project %s: %s", d.Id(), err)
+		}
+	}
+
+	return resourceCloudProjectContainerRegistryProjectRead(ctx, d, meta)
+}
+
+func resourceCloudProjectContainerRegistryProjectDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := newHarborApiClient(ctx, d, meta.(*Config))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Harbor refuses to delete a project that still has repositories
+	log.Printf("[DEBUG] Will delete registry project %s", d.Id())
+	if _, err := client.do(ctx, http.MethodDelete, harborProjectPath(d.Id()), nil, nil); err != nil && !isJsonApiNotFound(err) {
+		return diag.Errorf("deleting registry project %s: %s", d.Id(), err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+// harborProjectQuota returns the storage quota of a project
+func harborProjectQuota(ctx context.Context, client *harborApiClient, projectId int64) (*HarborQuota, error) {
+	quotas := []HarborQuota{}
+	endpoint := "/quotas?reference=project&reference_id=" + url.QueryEscape(strconv.FormatInt(projectId, 10))
+
+	if _, err := client.do(ctx, http.MethodGet, endpoint, nil, &quotas); err != nil {
+		return nil, fmt.Errorf("reading quota of registry project %d: %w", projectId, err)
+	}
+	if len(quotas) == 0 {
+		return nil, fmt.Errorf("no quota found for registry project %d", projectId)
+	}
+
+	return &quotas[0], nil
+}
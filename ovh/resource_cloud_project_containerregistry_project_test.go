@@ -0,0 +1,119 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+// testAccCloudProjectContainerRegistryHarborConfig creates a registry and the
+// user used to call its Harbor API
+const testAccCloudProjectContainerRegistryHarborConfig = `
+data "ovh_cloud_project_capabilities_containerregistry_filter" "regcap" {
+	service_name = "%s"
+	plan_name    = "SMALL"
+	region       = "%s"
+}
+
+resource "ovh_cloud_project_containerregistry" "reg" {
+	service_name = data.ovh_cloud_project_capabilities_containerregistry_filter.regcap.service_name
+	plan_id      = data.ovh_cloud_project_capabilities_containerregistry_filter.regcap.id
+	name         = "%s"
+	region       = data.ovh_cloud_project_capabilities_containerregistry_filter.regcap.region
+}
+
+resource "ovh_cloud_project_containerregistry_user" "user" {
+	service_name = ovh_cloud_project_containerregistry.reg.service_name
+	registry_id  = ovh_cloud_project_containerregistry.reg.id
+	email        = "foo@bar.com"
+	login        = "foobar"
+}
+`
+
+func testAccCloudProjectContainerRegistryHarborBaseConfig() string {
+	return fmt.Sprintf(
+		testAccCloudProjectContainerRegistryHarborConfig,
+		os.Getenv("OVH_CLOUD_PROJECT_SERVICE_TEST"),
+		os.Getenv("OVH_CLOUD_PROJECT_CONTAINERREGISTRY_REGION_TEST"),
+		acctest.RandomWithPrefix(test_prefix),
+	)
+}
+
+// testAccCloudProjectContainerRegistryHarborImportStateIdFunc returns the import ID
+// of a resource of the Harbor API ending with the given attribute, exporting the
+// credentials of the registry user as they can't be imported
+func testAccCloudProjectContainerRegistryHarborImportStateIdFunc(t *testing.T, resourceName, attribute string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		user, ok := s.RootModule().Resources["ovh_cloud_project_containerregistry_user.user"]
+		if !ok {
+			return "", fmt.Errorf("registry user not found in state")
+		}
+		res, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("resource %s not found in state", resourceName)
+		}
+
+		t.Setenv("OVH_CLOUD_PROJECT_CONTAINERREGISTRY_USERNAME", user.Primary.Attributes["user"])
+		t.Setenv("OVH_CLOUD_PROJECT_CONTAINERREGISTRY_PASSWORD", user.Primary.Attributes["password"])
+
+		return fmt.Sprintf(
+			"%s/%s/%s",
+			res.Primary.Attributes["service_name"],
+			res.Primary.Attributes["registry_id"],
+			res.Primary.Attributes[attribute],
+		), nil
+	}
+}
+
+const testAccCloudProjectContainerRegistryProjectConfig = `
+resource "ovh_cloud_project_containerregistry_project" "apps" {
+	service_name       = ovh_cloud_project_containerregistry.reg.service_name
+	registry_id        = ovh_cloud_project_containerregistry.reg.id
+	username           = ovh_cloud_project_containerregistry_user.user.user
+	password           = ovh_cloud_project_containerregistry_user.user.password
+	name               = "apps"
+	storage_limit      = %d
+	auto_scan          = %t
+	prevent_vulnerable = true
+	severity           = "high"
+}
+`
+
+func TestAccCloudProjectContainerRegistryProject_basic(t *testing.T) {
+	base := testAccCloudProjectContainerRegistryHarborBaseConfig()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckContainerRegistry(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: base + fmt.Sprintf(testAccCloudProjectContainerRegistryProjectConfig, -1, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ovh_cloud_project_containerregistry_project.apps", "name", "apps"),
+					resource.TestCheckResourceAttr("ovh_cloud_project_containerregistry_project.apps", "public", "false"),
+					resource.TestCheckResourceAttr("ovh_cloud_project_containerregistry_project.apps", "storage_limit", "-1"),
+					resource.TestCheckResourceAttr("ovh_cloud_project_containerregistry_project.apps", "auto_scan", "false"),
+					resource.TestCheckResourceAttr("ovh_cloud_project_containerregistry_project.apps", "severity", "high"),
+					resource.TestCheckResourceAttrSet("ovh_cloud_project_containerregistry_project.apps", "project_id"),
+				),
+			},
+			{
+				Config: base + fmt.Sprintf(testAccCloudProjectContainerRegistryProjectConfig, 10737418240, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ovh_cloud_project_containerregistry_project.apps", "storage_limit", "10737418240"),
+					resource.TestCheckResourceAttr("ovh_cloud_project_containerregistry_project.apps", "auto_scan", "true"),
+				),
+			},
+			{
+				ResourceName:      "ovh_cloud_project_containerregistry_project.apps",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccCloudProjectContainerRegistryHarborImportStateIdFunc(t, "ovh_cloud_project_containerregistry_project.apps", "id"),
+			},
+		},
+	})
+}
@@ -0,0 +1,272 @@
+package ovh
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/ovh/terraform-provider-ovh/v2/ovh/helpers"
+)
+
+func resourceCloudProjectContainerRegistryReplication() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCloudProjectContainerRegistryReplicationCreate,
+		ReadContext:   resourceCloudProjectContainerRegistryReplicationRead,
+		UpdateContext: resourceCloudProjectContainerRegistryReplicationUpdate,
+		DeleteContext: resourceCloudProjectContainerRegistryReplicationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: cloudProjectContainerRegistryHarborImportState,
+		},
+
+		Schema: cloudProjectContainerRegistryHarborSchema(map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Description: "Replication rule name",
+				Required:    true,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Description: "Replication rule description",
+				Optional:    true,
+			},
+			"direction": {
+				Type:         schema.TypeString,
+				Description:  "Whether artifacts are pulled from or pushed to the remote registry",
+				ForceNew:     true,
+				Required:     true,
+				ValidateFunc: helpers.ValidateEnum([]string{"pull", "push"}),
+			},
+			"remote_registry": {
+				Type:        schema.TypeList,
+				Description: "Remote registry artifacts are replicated from or to",
+				Required:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Description: "Name of the remote registry endpoint",
+							Required:    true,
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Description: "Provider of the remote registry (e.g. harbor, docker-hub, docker-registry)",
+							ForceNew:    true,
+							Required:    true,
+						},
+						"url": {
+							Type:        schema.TypeString,
+							Description: "URL of the remote registry",
+							Required:    true,
+						},
+						"insecure": {
+							Type:        schema.TypeBool,
+							Description: "Whether the certificate of the remote registry is not verified",
+							Optional:    true,
+							Default:     false,
+						},
+						"access_key": {
+							Type:        schema.TypeString,
+							Description: "Login on the remote registry",
+							Optional:    true,
+						},
+						"access_secret": {
+							Type:        schema.TypeString,
+							Description: "Password on the remote registry",
+							Optional:    true,
+							Sensitive:   true,
+						},
+					},
+				},
+			},
+			"dest_namespace": {
+				Type:        schema.TypeString,
+				Description: "Destination namespace, defaults to the one of the source",
+				Optional:    true,
+			},
+			"name_filter": {
+				Type:        schema.TypeString,
+				Description: "Doublestar pattern of the repositories to replicate",
+				Optional:    true,
+			},
+			"tag_filter": {
+				Type:        schema.TypeString,
+				Description: "Doublestar pattern of the tags to replicate",
+				Optional:    true,
+			},
+			"trigger": {
+				Type:         schema.TypeString,
+				Description:  "Trigger of the replication",
+				Optional:     true,
+				Default:      "manual",
+				ValidateFunc: helpers.ValidateEnum([]string{"manual", "scheduled", "event_based"}),
+			},
+			"cron": {
+				Type:        schema.TypeString,
+				Description: "Cron expression, with seconds, of the scheduled trigger",
+				Optional:    true,
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Description: "Whether the replication rule is enabled",
+				Optional:    true,
+				Default:     true,
+			},
+			"override": {
+				Type:        schema.TypeBool,
+				Description: "Whether artifacts with the same name are overwritten on the destination",
+				Optional:    true,
+				Default:     true,
+			},
+			"deletion": {
+				Type:        schema.TypeBool,
+				Description: "Whether deletions are replicated too",
+				Optional:    true,
+				Default:     false,
+			},
+
+			// Computed
+			"remote_registry_id": {
+				Type:        schema.TypeInt,
+				Description: "ID of the remote registry endpoint in Harbor",
+				Computed:    true,
+			},
+		}),
+	}
+}
+
+func resourceCloudProjectContainerRegistryReplicationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := newHarborApiClient(ctx, d, meta.(*Config))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	registry := HarborRegistryFromResource(d)
+
+	log.Printf("[DEBUG] Will create remote registry endpoint %s", registry.Name)
+	registryId, err := client.do(ctx, http.MethodPost, "/registries", registry, nil)
+	if err != nil {
+		return diag.Errorf("creating remote registry endpoint %s: %s", registry.Name, err)
+	}
+
+	policy := HarborReplicationPolicyFromResource(d, registryId)
+
+	log.Printf("[DEBUG] Will create replication rule: %+v", policy)
+	id, err := client.do(ctx, http.MethodPost, "/replication/policies", policy, nil)
+	if err != nil {
+		// Don't leave an unused endpoint behind
+		if _, errDelete := client.do(ctx, http.MethodDelete, "/registries/"+strconv.FormatInt(registryId, 10), nil, nil); errDelete != nil {
+			log.Printf("[WARN] Failed to delete remote registry endpoint %d: %s", registryId, errDelete)
+		}
+		return diag.Errorf("creating replication rule %s: %s", policy.Name, err)
+	}
+
+	d.SetId(strconv.FormatInt(id, 10))
+	d.Set("remote_registry_id", registryId)
+
+	return resourceCloudProjectContainerRegistryReplicationRead(ctx, d, meta)
+}
+
+func resourceCloudProjectContainerRegistryReplicationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := newHarborApiClient(ctx, d, meta.(*Config))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	policy := &HarborReplicationPolicy{}
+	if _, err := client.do(ctx, http.MethodGet, "/replication/policies/"+d.Id(), nil, policy); err != nil {
+		if isJsonApiNotFound(err) {
+			log.Printf("[DEBUG] Replication rule %s does not exist, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("reading replication rule %s: %s", d.Id(), err)
+	}
+
+	for k, v := range policy.ToMap() {
+		d.Set(k, v)
+	}
+
+	registryId := d.Get("remote_registry_id").(int)
+	registry := &HarborRegistry{}
+	if _, err := client.do(ctx, http.MethodGet, "/registries/"+strconv.Itoa(registryId), nil, registry); err != nil {
+		return diag.Errorf("reading remote registry endpoint %d: %s", registryId, err)
+	}
+
+	// The secret is never returned, keep the configured one
+	remote := d.Get("remote_registry").([]interface{})
+	remoteRegistry := map[string]interface{}{
+		"name":          registry.Name,
+		"type":          registry.Type,
+		"url":           registry.Url,
+		"insecure":      registry.Insecure,
+		"access_key":    "",
+		"access_secret": "",
+	}
+	if registry.Credential != nil {
+		remoteRegistry["access_key"] = registry.Credential.AccessKey
+	}
+	if len(remote) > 0 && remote[0] != nil {
+		remoteRegistry["access_secret"] = remote[0].(map[string]interface{})["access_secret"]
+	}
+	d.Set("remote_registry", []interface{}{remoteRegistry})
+
+	return nil
+}
+
+func resourceCloudProjectContainerRegistryReplicationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := newHarborApiClient(ctx, d, meta.(*Config))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	registryId := int64(d.Get("remote_registry_id").(int))
+
+	if d.HasChange("remote_registry") {
+		registry := HarborRegistryFromResource(d)
+
+		log.Printf("[DEBUG] Will update remote registry endpoint %d", registryId)
+		if _, err := client.do(ctx, http.MethodPut, "/registries/"+strconv.FormatInt(registryId, 10), registry, nil); err != nil {
+			return diag.Errorf("updating remote registry endpoint %d: %s", registryId, err)
+		}
+	}
+
+	if d.HasChangesExcept("remote_registry", "username", "password") {
+		policy := HarborReplicationPolicyFromResource(d, registryId)
+		policy.Id, _ = strconv.ParseInt(d.Id(), 10, 64)
+
+		log.Printf("[DEBUG] Will update replication rule %s: %+v", d.Id(), policy)
+		if _, err := client.do(ctx, http.MethodPut, "/replication/policies/"+d.Id(), policy, nil); err != nil {
+			return diag.Errorf("updating replication rule %s: %s", d.Id(), err)
+		}
+	}
+
+	return resourceCloudProjectContainerRegistryReplicationRead(ctx, d, meta)
+}
+
+func resourceCloudProjectContainerRegistryReplicationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := newHarborApiClient(ctx, d, meta.(*Config))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Will delete replication rule %s", d.Id())
+	if _, err := client.do(ctx, http.MethodDelete, "/replication/policies/"+d.Id(), nil, nil); err != nil && !isJsonApiNotFound(err) {
+		return diag.Errorf("deleting replication rule %s: %s", d.Id(), err)
+	}
+
+	registryId := strconv.Itoa(d.Get("remote_registry_id").(int))
+
+	log.Printf("[DEBUG] Will delete remote registry endpoint %s", registryId)
+	if _, err := client.do(ctx, http.MethodDelete, "/registries/"+registryId, nil, nil); err != nil && !isJsonApiNotFound(err) {
+		return diag.Errorf("deleting remote registry endpoint %s: %s", registryId, err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
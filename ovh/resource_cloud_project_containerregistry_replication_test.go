@@ -0,0 +1,51 @@
+package ovh
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const testAccCloudProjectContainerRegistryReplicationConfig = `
+resource "ovh_cloud_project_containerregistry_replication" "mirror" {
+	service_name = ovh_cloud_project_containerregistry.reg.service_name
+	registry_id  = ovh_cloud_project_containerregistry.reg.id
+	username     = ovh_cloud_project_containerregistry_user.user.user
+	password     = ovh_cloud_project_containerregistry_user.user.password
+	name         = "mirror-alpine"
+	direction    = "pull"
+	name_filter  = "library/alpine"
+	tag_filter   = "3.*"
+
+	remote_registry {
+		name = "docker-hub"
+		type = "docker-hub"
+		url  = "https://hub.docker.com"
+	}
+}
+`
+
+func TestAccCloudProjectContainerRegistryReplication_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckContainerRegistry(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudProjectContainerRegistryHarborBaseConfig() + testAccCloudProjectContainerRegistryReplicationConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("ovh_cloud_project_containerregistry_replication.mirror", "remote_registry_id"),
+					resource.TestCheckResourceAttr("ovh_cloud_project_containerregistry_replication.mirror", "direction", "pull"),
+					resource.TestCheckResourceAttr("ovh_cloud_project_containerregistry_replication.mirror", "trigger", "manual"),
+					resource.TestCheckResourceAttr("ovh_cloud_project_containerregistry_replication.mirror", "remote_registry.0.type", "docker-hub"),
+				),
+			},
+			{
+				ResourceName:            "ovh_cloud_project_containerregistry_replication.mirror",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"remote_registry.0.access_secret"},
+				ImportStateIdFunc:       testAccCloudProjectContainerRegistryHarborImportStateIdFunc(t, "ovh_cloud_project_containerregistry_replication.mirror", "id"),
+			},
+		},
+	})
+}
@@ -0,0 +1,215 @@
+package ovh
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/ovh/terraform-provider-ovh/v2/ovh/helpers"
+)
+
+func resourceCloudProjectContainerRegistryRetention() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCloudProjectContainerRegistryRetentionCreate,
+		ReadContext:   resourceCloudProjectContainerRegistryRetentionRead,
+		UpdateContext: resourceCloudProjectContainerRegistryRetentionUpdate,
+		DeleteContext: resourceCloudProjectContainerRegistryRetentionDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudProjectContainerRegistryRetentionImportState,
+		},
+
+		Schema: cloudProjectContainerRegistryHarborSchema(map[string]*schema.Schema{
+			"project_name": {
+				Type:        schema.TypeString,
+				Description: "Name of the registry project",
+				ForceNew:    true,
+				Required:    true,
+			},
+			"schedule": {
+				Type:        schema.TypeString,
+				Description: "Cron expression, with seconds, of the retention runs. Empty to only run it manually",
+				Optional:    true,
+			},
+			"rule": {
+				Type:        schema.TypeList,
+				Description: "Retention rules, an artifact matching any of them is retained",
+				Required:    true,
+				MinItems:    1,
+				MaxItems:    15,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"template": {
+							Type:        schema.TypeString,
+							Description: "Kind of the rule",
+							Required:    true,
+							ValidateFunc: helpers.ValidateEnum([]string{
+								"always",
+								"latestPushedK",
+								"latestPulledN",
+								"nDaysSinceLastPush",
+								"nDaysSinceLastPull",
+							}),
+						},
+						"count": {
+							Type:        schema.TypeInt,
+							Description: "Number of artifacts or days of the rule, ignored for the always template",
+							Optional:    true,
+						},
+						"repository_pattern": {
+							Type:        schema.TypeString,
+							Description: "Doublestar pattern of the repositories the rule applies to",
+							Optional:    true,
+							Default:     "**",
+						},
+						"tag_pattern": {
+							Type:        schema.TypeString,
+							Description: "Doublestar pattern of the tags the rule applies to",
+							Optional:    true,
+							Default:     "**",
+						},
+						"untagged": {
+							Type:        schema.TypeBool,
+							Description: "Whether the rule also applies to untagged artifacts",
+							Optional:    true,
+							Default:     true,
+						},
+						"disabled": {
+							Type:        schema.TypeBool,
+							Description: "Whether the rule is disabled",
+							Optional:    true,
+							Default:     false,
+						},
+					},
+				},
+			},
+		}),
+	}
+}
+
+// resourceCloudProjectContainerRegistryRetentionImportState imports the retention
+// policy of a project from an ID formatted as service_name/registry_id/project_name
+func resourceCloudProjectContainerRegistryRetentionImportState(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if _, err := cloudProjectContainerRegistryHarborImportState(ctx, d, meta); err != nil {
+		return nil, err
+	}
+
+	client, err := newHarborApiClient(ctx, d, meta.(*Config))
+	if err != nil {
+		return nil, err
+	}
+
+	projectName := d.Id()
+	project := &HarborProject{}
+	if _, err := client.do(ctx, http.MethodGet, harborProjectPath(projectName), nil, project); err != nil {
+		return nil, fmt.Errorf("reading registry project %s: %w", projectName, err)
+	}
+	if project.Metadata.RetentionId == "" {
+		return nil, fmt.Errorf("registry project %s has no retention policy", projectName)
+	}
+
+	d.SetId(project.Metadata.RetentionId)
+	d.Set("project_name", projectName)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceCloudProjectContainerRegistryRetentionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := newHarborApiClient(ctx, d, meta.(*Config))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	projectName := d.Get("project_name").(string)
+	project := &HarborProject{}
+	if _, err := client.do(ctx, http.MethodGet, harborProjectPath(projectName), nil, project); err != nil {
+		return diag.Errorf("reading registry project %s: %s", projectName, err)
+	}
+
+	policy := HarborRetentionPolicyFromResource(d, project.ProjectId)
+
+	log.Printf("[DEBUG] Will create retention policy of registry project %s: %+v", projectName, policy)
+	id, err := client.do(ctx, http.MethodPost, "/retentions", policy, nil)
+	if err != nil {
+		return diag.Errorf("creating retention policy of registry project %s: %s", projectName, err)
+	}
+
+	// Fallback on the project metadata when the ID is not returned
+	if id == 0 {
+		if _, err := client.do(ctx, http.MethodGet, harborProjectPath(projectName), nil, project); err != nil {
+			return diag.Errorf("reading registry project %s: %s", projectName, err)
+		}
+		id, _ = strconv.ParseInt(project.Metadata.RetentionId, 10, 64)
+	}
+
+	d.SetId(strconv.FormatInt(id, 10))
+
+	return resourceCloudProjectContainerRegistryRetentionRead(ctx, d, meta)
+}
+
+func resourceCloudProjectContainerRegistryRetentionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := newHarborApiClient(ctx, d, meta.(*Config))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	policy := &HarborRetentionPolicy{}
+	if _, err := client.do(ctx, http.MethodGet, "/retentions/"+d.Id(), nil, policy); err != nil {
+		if isJsonApiNotFound(err) {
+			log.Printf("[DEBUG] Retention policy %s does not exist, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("reading retention policy %s: %s", d.Id(), err)
+	}
+
+	for k, v := range policy.ToMap() {
+		d.Set(k, v)
+	}
+
+	return nil
+}
+
+func resourceCloudProjectContainerRegistryRetentionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := newHarborApiClient(ctx, d, meta.(*Config))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChanges("schedule", "rule") {
+		current := &HarborRetentionPolicy{}
+		if _, err := client.do(ctx, http.MethodGet, "/retentions/"+d.Id(), nil, current); err != nil {
+			return diag.Errorf("reading retention policy %s: %s", d.Id(), err)
+		}
+
+		policy := HarborRetentionPolicyFromResource(d, current.Scope.Ref)
+		policy.Id = current.Id
+
+		log.Printf("[DEBUG] Will update retention policy %s: %+v", d.Id(), policy)
+		if _, err := client.do(ctx, http.MethodPut, "/retentions/"+d.Id(), policy, nil); err != nil {
+			return diag.Errorf("updating retention policy %s: %s", d.Id(), err)
+		}
+	}
+
+	return resourceCloudProjectContainerRegistryRetentionRead(ctx, d, meta)
+}
+
+func resourceCloudProjectContainerRegistryRetentionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := newHarborApiClient(ctx, d, meta.(*Config))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Will delete retention policy %s", d.Id())
+	if _, err := client.do(ctx, http.MethodDelete, "/retentions/"+d.Id(), nil, nil); err != nil && !isJsonApiNotFound(err) {
+		return diag.Errorf("deleting retention policy %s: %s", d.Id(), err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
@@ -0,0 +1,60 @@
+package ovh
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const testAccCloudProjectContainerRegistryRetentionConfig = `
+resource "ovh_cloud_project_containerregistry_project" "apps" {
+	service_name = ovh_cloud_project_containerregistry.reg.service_name
+	registry_id  = ovh_cloud_project_containerregistry.reg.id
+	username     = ovh_cloud_project_containerregistry_user.user.user
+	password     = ovh_cloud_project_containerregistry_user.user.password
+	name         = "apps"
+}
+
+resource "ovh_cloud_project_containerregistry_retention" "apps" {
+	service_name = ovh_cloud_project_containerregistry.reg.service_name
+	registry_id  = ovh_cloud_project_containerregistry.reg.id
+	username     = ovh_cloud_project_containerregistry_user.user.user
+	password     = ovh_cloud_project_containerregistry_user.user.password
+	project_name = ovh_cloud_project_containerregistry_project.apps.name
+	schedule     = "0 0 0 * * *"
+
+	rule {
+		template = "latestPushedK"
+		count    = 10
+	}
+
+	rule {
+		template    = "always"
+		tag_pattern = "release-*"
+	}
+}
+`
+
+func TestAccCloudProjectContainerRegistryRetention_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckContainerRegistry(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudProjectContainerRegistryHarborBaseConfig() + testAccCloudProjectContainerRegistryRetentionConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("ovh_cloud_project_containerregistry_retention.apps", "id"),
+					resource.TestCheckResourceAttr("ovh_cloud_project_containerregistry_retention.apps", "rule.#", "2"),
+					resource.TestCheckResourceAttr("ovh_cloud_project_containerregistry_retention.apps", "rule.0.count", "10"),
+					resource.TestCheckResourceAttr("ovh_cloud_project_containerregistry_retention.apps", "rule.1.tag_pattern", "release-*"),
+				),
+			},
+			{
+				ResourceName:      "ovh_cloud_project_containerregistry_retention.apps",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccCloudProjectContainerRegistryHarborImportStateIdFunc(t, "ovh_cloud_project_containerregistry_retention.apps", "project_name"),
+			},
+		},
+	})
+}
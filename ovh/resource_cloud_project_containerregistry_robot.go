@@ -0,0 +1,227 @@
+package ovh
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/ovh/terraform-provider-ovh/v2/ovh/helpers"
+)
+
+func resourceCloudProjectContainerRegistryRobot() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCloudProjectContainerRegistryRobotCreate,
+		ReadContext:   resourceCloudProjectContainerRegistryRobotRead,
+		UpdateContext: resourceCloudProjectContainerRegistryRobotUpdate,
+		DeleteContext: resourceCloudProjectContainerRegistryRobotDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudProjectContainerRegistryRobotImportState,
+		},
+
+		Schema: cloudProjectContainerRegistryHarborSchema(map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Description: "Robot account name",
+				ForceNew:    true,
+				Required:    true,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Description: "Robot account description",
+				Optional:    true,
+			},
+			"level": {
+				Type:         schema.TypeString,
+				Description:  "Level of the robot account: project for a single project, system for several ones",
+				ForceNew:     true,
+				Optional:     true,
+				Default:      "project",
+				ValidateFunc: helpers.ValidateEnum([]string{"project", "system"}),
+			},
+			"duration": {
+				Type:        schema.TypeInt,
+				Description: "Validity of the robot account in days, -1 to never expire",
+				Optional:    true,
+				Default:     -1,
+			},
+			"disabled": {
+				Type:        schema.TypeBool,
+				Description: "Whether the robot account is disabled",
+				Optional:    true,
+				Default:     false,
+			},
+			"permission": {
+				Type:        schema.TypeList,
+				Description: "Permissions of the robot account on the projects",
+				Required:    true,
+				MinItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"kind": {
+							Type:        schema.TypeString,
+							Description: "Kind of the permission",
+							Optional:    true,
+							Default:     "project",
+						},
+						"namespace": {
+							Type:        schema.TypeString,
+							Description: "Name of the project, or * for all the projects",
+							Required:    true,
+						},
+						"access": {
+							Type:        schema.TypeSet,
+							Description: "Actions allowed on the resources of the project",
+							Required:    true,
+							MinItems:    1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"resource": {
+										Type:        schema.TypeString,
+										Description: "Resource of the project (e.g. repository, artifact, tag)",
+										Required:    true,
+									},
+									"action": {
+										Type:        schema.TypeString,
+										Description: "Action on the resource (e.g. pull, push, list, delete)",
+										Required:    true,
+									},
+									"effect": {
+										Type:         schema.TypeString,
+										Description:  "Effect of the access",
+										Optional:     true,
+										Default:      "allow",
+										ValidateFunc: helpers.ValidateEnum([]string{"allow", "deny"}),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			// Computed
+			"full_name": {
+				Type:        schema.TypeString,
+				Description: "Full name of the robot account, used as login",
+				Computed:    true,
+			},
+			"secret": {
+				Type:        schema.TypeString,
+				Description: "Secret of the robot account, used as password",
+				Sensitive:   true,
+				Computed:    true,
+			},
+			"expires_at": {
+				Type:        schema.TypeInt,
+				Description: "Expiration timestamp of the robot account, -1 if it never expires",
+				Computed:    true,
+			},
+		}),
+	}
+}
+
+// resourceCloudProjectContainerRegistryRobotImportState imports a robot account
+// from an ID formatted as service_name/registry_id/robot_id
+func resourceCloudProjectContainerRegistryRobotImportState(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if _, err := cloudProjectContainerRegistryHarborImportState(ctx, d, meta); err != nil {
+		return nil, err
+	}
+
+	client, err := newHarborApiClient(ctx, d, meta.(*Config))
+	if err != nil {
+		return nil, err
+	}
+
+	// Only the full name of the robot account is returned by Harbor
+	robot := &HarborRobot{}
+	if _, err := client.do(ctx, http.MethodGet, "/robots/"+d.Id(), nil, robot); err != nil {
+		return nil, fmt.Errorf("reading registry robot account %s: %w", d.Id(), err)
+	}
+	d.Set("name", robot.ShortName())
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceCloudProjectContainerRegistryRobotCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := newHarborApiClient(ctx, d, meta.(*Config))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	robot := HarborRobotFromResource(d, d.Get("name").(string))
+	res := &HarborRobot{}
+
+	log.Printf("[DEBUG] Will create registry robot account %s", robot.Name)
+	if _, err := client.do(ctx, http.MethodPost, "/robots", robot, res); err != nil {
+		return diag.Errorf("creating registry robot account %s: %s", robot.Name, err)
+	}
+
+	// The secret is only returned on creation
+	d.SetId(strconv.FormatInt(res.Id, 10))
+	d.Set("secret", res.Secret)
+
+	return resourceCloudProjectContainerRegistryRobotRead(ctx, d, meta)
+}
+
+func resourceCloudProjectContainerRegistryRobotRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := newHarborApiClient(ctx, d, meta.(*Config))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	robot := &HarborRobot{}
+	if _, err := client.do(ctx, http.MethodGet, "/robots/"+d.Id(), nil, robot); err != nil {
+		if isJsonApiNotFound(err) {
+			log.Printf("[DEBUG] Registry robot account %s does not exist, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("reading registry robot account %s: %s", d.Id(), err)
+	}
+
+	for k, v := range robot.ToMap() {
+		d.Set(k, v)
+	}
+
+	return nil
+}
+
+func resourceCloudProjectContainerRegistryRobotUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := newHarborApiClient(ctx, d, meta.(*Config))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChanges("description", "duration", "disabled", "permission") {
+		robot := HarborRobotFromResource(d, d.Get("full_name").(string))
+		robot.Id, _ = strconv.ParseInt(d.Id(), 10, 64)
+
+		log.Printf("[DEBUG] Will update registry robot account %s", d.Id())
+		if _, err := client.do(ctx, http.MethodPut, "/robots/"+d.Id(), robot, nil); err != nil {
+			return diag.Errorf("updating registry robot account %s: %s", d.Id(), err)
+		}
+	}
+
+	return resourceCloudProjectContainerRegistryRobotRead(ctx, d, meta)
+}
+
+func resourceCloudProjectContainerRegistryRobotDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := newHarborApiClient(ctx, d, meta.(*Config))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Will delete registry robot account %s", d.Id())
+	if _, err := client.do(ctx, http.MethodDelete, "/robots/"+d.Id(), nil, nil); err != nil && !isJsonApiNotFound(err) {
+		return diag.Errorf("deleting registry robot account %s: %s", d.Id(), err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
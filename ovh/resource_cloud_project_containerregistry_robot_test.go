@@ -0,0 +1,64 @@
+package ovh
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const testAccCloudProjectContainerRegistryRobotConfig = `
+resource "ovh_cloud_project_containerregistry_project" "apps" {
+	service_name = ovh_cloud_project_containerregistry.reg.service_name
+	registry_id  = ovh_cloud_project_containerregistry.reg.id
+	username     = ovh_cloud_project_containerregistry_user.user.user
+	password     = ovh_cloud_project_containerregistry_user.user.password
+	name         = "apps"
+}
+
+resource "ovh_cloud_project_containerregistry_robot" "ci" {
+	service_name = ovh_cloud_project_containerregistry.reg.service_name
+	registry_id  = ovh_cloud_project_containerregistry.reg.id
+	username     = ovh_cloud_project_containerregistry_user.user.user
+	password     = ovh_cloud_project_containerregistry_user.user.password
+	name         = "ci"
+	description  = "CI pipelines"
+
+	permission {
+		namespace = ovh_cloud_project_containerregistry_project.apps.name
+
+		access {
+			resource = "repository"
+			action   = "pull"
+		}
+		access {
+			resource = "repository"
+			action   = "push"
+		}
+	}
+}
+`
+
+func TestAccCloudProjectContainerRegistryRobot_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckContainerRegistry(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudProjectContainerRegistryHarborBaseConfig() + testAccCloudProjectContainerRegistryRobotConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ovh_cloud_project_containerregistry_robot.ci", "full_name", "robot$apps+ci"),
+					resource.TestCheckResourceAttr("ovh_cloud_project_containerregistry_robot.ci", "level", "project"),
+					resource.TestCheckResourceAttr("ovh_cloud_project_containerregistry_robot.ci", "permission.0.access.#", "2"),
+					resource.TestCheckResourceAttrSet("ovh_cloud_project_containerregistry_robot.ci", "secret"),
+				),
+			},
+			{
+				ResourceName:            "ovh_cloud_project_containerregistry_robot.ci",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"secret"},
+				ImportStateIdFunc:       testAccCloudProjectContainerRegistryHarborImportStateIdFunc(t, "ovh_cloud_project_containerregistry_robot.ci", "id"),
+			},
+		},
+	})
+}
@@ -5,12 +5,14 @@ import (
 	"log"
 	"net/url"
 	"os"
+	"regexp"
 	"strings"
 	"testing"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 )
 
 func init() {
@@ -127,3 +129,52 @@ resource "ovh_cloud_project_containerregistry" "reg" {
     region       = data.ovh_cloud_project_capabilities_containerregistry_filter.regcap.region
 }
 `
+
+func TestAccCloudProjectContainerRegistry_planUpgrade(t *testing.T) {
+	serviceName := os.Getenv("OVH_CLOUD_PROJECT_SERVICE_TEST")
+	registryName := acctest.RandomWithPrefix(test_prefix)
+	region := os.Getenv("OVH_CLOUD_PROJECT_CONTAINERREGISTRY_REGION_TEST")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckContainerRegistry(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccCloudProjectContainerRegistryPlanConfig, serviceName, "SMALL", region, registryName),
+				Check: resource.TestCheckResourceAttr(
+					"ovh_cloud_project_containerregistry.reg", "plan.0.name", "SMALL"),
+			},
+			{
+				// The registry is upgraded in place
+				Config: fmt.Sprintf(testAccCloudProjectContainerRegistryPlanConfig, serviceName, "MEDIUM", region, registryName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("ovh_cloud_project_containerregistry.reg", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.TestCheckResourceAttr(
+					"ovh_cloud_project_containerregistry.reg", "plan.0.name", "MEDIUM"),
+			},
+			{
+				// Downgrades are refused at plan time
+				Config:      fmt.Sprintf(testAccCloudProjectContainerRegistryPlanConfig, serviceName, "SMALL", region, registryName),
+				ExpectError: regexp.MustCompile("is not an available upgrade"),
+			},
+		},
+	})
+}
+
+const testAccCloudProjectContainerRegistryPlanConfig = `
+data "ovh_cloud_project_capabilities_containerregistry_filter" "regcap" {
+	service_name = "%s"
+	plan_name    = "%s"
+	region       = "%s"
+}
+
+resource "ovh_cloud_project_containerregistry" "reg" {
+	service_name = data.ovh_cloud_project_capabilities_containerregistry_filter.regcap.service_name
+	plan_id      = data.ovh_cloud_project_capabilities_containerregistry_filter.regcap.id
+	name         = "%s"
+	region       = data.ovh_cloud_project_capabilities_containerregistry_filter.regcap.region
+}
+`
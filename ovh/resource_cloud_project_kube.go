@@ -1,6 +1,7 @@
 package ovh
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net/url"
@@ -9,6 +10,8 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/ovh/go-ovh/ovh"
@@ -21,7 +24,15 @@ func resourceCloudProjectKube() *schema.Resource {
 		Create: resourceCloudProjectKubeCreate,
 		Read:   resourceCloudProjectKubeRead,
 		Delete: resourceCloudProjectKubeDelete,
-		Update: resourceCloudProjectKubeUpdate,
+		UpdateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return diag.FromErr(resourceCloudProjectKubeUpdate(ctx, d, meta))
+		},
+
+		CustomizeDiff: customdiff.All(
+			resourceCloudProjectKubeCustomizeDiff,
+			customdiff.ComputedIf(kubeClusterKubeconfigKey, cloudProjectKubeKubeconfigRotated),
+			customdiff.ComputedIf(kubeClusterKubeconfigAttributesKey, cloudProjectKubeKubeconfigRotated),
+		),
 
 		Importer: &schema.ResourceImporter{
 			State: resourceCloudProjectKubeImportState,
@@ -53,6 +64,22 @@ func resourceCloudProjectKube() *schema.Resource {
 				Optional: true,
 				ForceNew: false,
 			},
+			kubeClusterUpgradeKey: {
+				Type:        schema.TypeList,
+				Description: "Orchestration of the upgrades triggered by a change of version",
+				Optional:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						kubeClusterUpgradeStagedKey: {
+							Type:        schema.TypeBool,
+							Description: "Upgrade one minor version at a time when the version is bumped by several minor versions",
+							Optional:    true,
+							Default:     false,
+						},
+					},
+				},
+			},
 			kubeClusterPlanKey: {
 				Type:         schema.TypeString,
 				Computed:     true,
@@ -546,6 +573,11 @@ func resourceCloudProjectKube() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			kubeClusterKubeconfigRotationKey: {
+				Type:        schema.TypeString,
+				Description: "Arbitrary string to change to reset the kubeconfig of the cluster",
+				Optional:    true,
+			},
 			kubeClusterKubeconfigKey: {
 				Type:      schema.TypeString,
 				Computed:  true,
@@ -774,7 +806,7 @@ func resourceCloudProjectKubeDelete(d *schema.ResourceData, meta interface{}) er
 	return nil
 }
 
-func resourceCloudProjectKubeUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceCloudProjectKubeUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 	serviceName := d.Get(kubeServiceNameKey).(string)
 
@@ -824,53 +856,25 @@ func resourceCloudProjectKubeUpdate(d *schema.ResourceData, meta interface{}) er
 	if d.HasChange(kubeVersionKey) {
 		oldValueI, newValueI := d.GetChange(kubeVersionKey)
 
-		oldValue := oldValueI.(string)
-		newValue := newValueI.(string)
-
-		log.Printf("[DEBUG] cluster version change from %s to %s", oldValue, newValue)
+		log.Printf("[DEBUG] cluster version change from %s to %s", oldValueI, newValueI)
 
-		oldVersion, err := version.NewVersion(oldValueI.(string))
+		steps, err := cloudProjectKubeUpgradeSteps(oldValueI.(string), newValueI.(string))
 		if err != nil {
-			return fmt.Errorf("version %s does not match a semver", oldValue)
-		}
-		newVersion, err := version.NewVersion(newValueI.(string))
-		if err != nil {
-			return fmt.Errorf("version %s does not match a semver", newValue)
-		}
-
-		oldVersionSegments := oldVersion.Segments()
-		newVersionSegments := newVersion.Segments()
-
-		if oldVersionSegments[0] != 1 || newVersionSegments[0] != 1 {
-			return fmt.Errorf("the only supported major version is 1")
-		}
-		if len(oldVersionSegments) < 2 || len(newVersionSegments) < 2 {
-			log.Printf("[DEBUG] old version segments: %#v new version segments: %#v", oldVersionSegments, newVersionSegments)
-			return fmt.Errorf("the version should only specify the major and minor versions (e.g. \\\"1.20\\\")")
-		}
-
-		if newVersion.LessThan(oldVersion) {
-			return fmt.Errorf("cannot downgrade cluster from %s to %s", oldValue, newValue)
-		}
-
-		if oldVersionSegments[1]+1 != newVersionSegments[1] {
-			return fmt.Errorf("cannot upgrade cluster from %s to %s, only next minor version is authorized", oldValue, newValue)
+			return err
 		}
 
-		endpoint := fmt.Sprintf("/cloud/project/%s/kube/%s/update", serviceName, d.Id())
-		err = config.OVHClient.Post(endpoint, CloudProjectKubeUpdateOpts{
-			Strategy: "NEXT_MINOR",
-		}, nil)
-		if err != nil {
-			return err
+		upgrade := cloudProjectKubeUpgradeFromResource(d)
+		if len(steps) > 1 && (upgrade == nil || !upgrade.Staged) {
+			return fmt.Errorf("cannot upgrade cluster from %s to %s, only next minor version is authorized unless %s.0.%s is enabled", oldValueI, newValueI, kubeClusterUpgradeKey, kubeClusterUpgradeStagedKey)
 		}
 
-		log.Printf("[DEBUG] Waiting for kube %s to be READY", d.Id())
-		err = waitForCloudProjectKubeReady(config.OVHClient, serviceName, d.Id(), []string{"UPDATING", "REDEPLOYING", "RESETTING"}, []string{"READY"}, d.Timeout(schema.TimeoutUpdate))
-		if err != nil {
-			return fmt.Errorf("timeout while waiting kube %s to be READY: %w", d.Id(), err)
+		// All the minor versions share the update timeout
+		deadline := time.Now().Add(d.Timeout(schema.TimeoutUpdate))
+		for _, step := range steps {
+			if err := cloudProjectKubeUpgradeNextMinor(ctx, config, serviceName, d.Id(), step, upgrade, deadline); err != nil {
+				return err
+			}
 		}
-		log.Printf("[DEBUG] kube %s is READY", d.Id())
 	}
 
 	if d.HasChanges(kubeClusterPlanKey) {
@@ -957,6 +961,13 @@ func resourceCloudProjectKubeUpdate(d *schema.ResourceData, meta interface{}) er
 		log.Printf("[DEBUG] kube %s is READY", d.Id())
 	}
 
+	// The kubeconfig is reset last, once the cluster has settled
+	if d.HasChange(kubeClusterKubeconfigRotationKey) {
+		if err := resetCloudProjectKubeKubeconfig(d, meta); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -1045,3 +1056,211 @@ func setKubeconfig(d *schema.ResourceData, meta interface{}) error {
 
 	return nil
 }
+
+// resourceCloudProjectKubeCustomizeDiff checks at plan time that a change of
+// version can be applied: the first minor version to upgrade to must be one of
+// the next_upgrade_versions, and jumps of several minor versions need staged
+// upgrades
+func resourceCloudProjectKubeCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if d.Id() == "" || !d.HasChange(kubeVersionKey) || !d.NewValueKnown(kubeVersionKey) {
+		return nil
+	}
+
+	oldValueI, newValueI := d.GetChange(kubeVersionKey)
+	if oldValueI.(string) == "" || newValueI.(string) == "" {
+		return nil
+	}
+
+	steps, err := cloudProjectKubeUpgradeSteps(oldValueI.(string), newValueI.(string))
+	if err != nil {
+		return err
+	}
+
+	staged := false
+	if upgrades := d.Get(kubeClusterUpgradeKey).([]interface{}); len(upgrades) > 0 && upgrades[0] != nil {
+		staged = upgrades[0].(map[string]interface{})[kubeClusterUpgradeStagedKey].(bool)
+	}
+	if len(steps) > 1 && !staged {
+		return fmt.Errorf("cannot upgrade cluster from %s to %s, only next minor version is authorized unless %s.0.%s is enabled", oldValueI, newValueI, kubeClusterUpgradeKey, kubeClusterUpgradeStagedKey)
+	}
+
+	nextVersions := d.Get(kubeClusterNextUpgradeVersionsKey).(*schema.Set)
+	if len(steps) > 0 && nextVersions.Len() > 0 && !nextVersions.Contains(steps[0]) {
+		return fmt.Errorf("cannot upgrade cluster from %s to %s, the next upgrade versions of the cluster are %v", oldValueI, steps[0], nextVersions.List())
+	}
+
+	return nil
+}
+
+// cloudProjectKubeUpgradeSteps returns the minor versions a cluster goes
+// through to be upgraded from oldValue to newValue, newValue included
+func cloudProjectKubeUpgradeSteps(oldValue, newValue string) ([]string, error) {
+	oldVersion, err := version.NewVersion(oldValue)
+	if err != nil {
+		return nil, fmt.Errorf("version %s does not match a semver", oldValue)
+	}
+	newVersion, err := version.NewVersion(newValue)
+	if err != nil {
+		return nil, fmt.Errorf("version %s does not match a semver", newValue)
+	}
+
+	oldVersionSegments := oldVersion.Segments()
+	newVersionSegments := newVersion.Segments()
+
+	if oldVersionSegments[0] != 1 || newVersionSegments[0] != 1 {
+		return nil, fmt.Errorf("the only supported major version is 1")
+	}
+	if len(oldVersionSegments) < 2 || len(newVersionSegments) < 2 {
+		log.Printf("[DEBUG] old version segments: %#v new version segments: %#v", oldVersionSegments, newVersionSegments)
+		return nil, fmt.Errorf("the version should only specify the major and minor versions (e.g. \\\"1.20\\\")")
+	}
+
+	if newVersion.LessThan(oldVersion) {
+		return nil, fmt.Errorf("cannot downgrade cluster from %s to %s", oldValue, newValue)
+	}
+
+	var steps []string
+	for minor := oldVersionSegments[1] + 1; minor <= newVersionSegments[1]; minor++ {
+		steps = append(steps, fmt.Sprintf("1.%d", minor))
+	}
+
+	return steps, nil
+}
+
+type cloudProjectKubeUpgrade struct {
+	Staged bool
+}
+
+// cloudProjectKubeUpgradeFromResource returns the upgrade block of the
+// cluster, or nil when it is not set
+func cloudProjectKubeUpgradeFromResource(d *schema.ResourceData) *cloudProjectKubeUpgrade {
+	upgrades := d.Get(kubeClusterUpgradeKey).([]interface{})
+	if len(upgrades) == 0 || upgrades[0] == nil {
+		return nil
+	}
+
+	upgrade := &cloudProjectKubeUpgrade{}
+
+	mapping := upgrades[0].(map[string]interface{})
+	upgrade.Staged = mapping[kubeClusterUpgradeStagedKey].(bool)
+
+	return upgrade
+}
+
+// cloudProjectKubeUpgradeNextMinor upgrades the cluster to the next minor
+// version. The service upgrades the control plane, then rolls all the node
+// pools at the same time, the API doesn't allow to upgrade them in a given
+// order. When the upgrade block is set, each node pool is then awaited until
+// all its nodes are up to date, before the next minor version is applied
+func cloudProjectKubeUpgradeNextMinor(ctx context.Context, config *Config, serviceName, kubeId, target string, upgrade *cloudProjectKubeUpgrade, deadline time.Time) error {
+	log.Printf("[DEBUG] Will upgrade kube %s to %s", kubeId, target)
+
+	endpoint := fmt.Sprintf("/cloud/project/%s/kube/%s/update", serviceName, kubeId)
+	err := config.OVHClient.Post(endpoint, CloudProjectKubeUpdateOpts{
+		Strategy: "NEXT_MINOR",
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Waiting for kube %s to be READY", kubeId)
+	err = waitForCloudProjectKubeReady(config.OVHClient, serviceName, kubeId, []string{"UPDATING", "REDEPLOYING", "RESETTING"}, []string{"READY"}, time.Until(deadline))
+	if err != nil {
+		return fmt.Errorf("timeout while waiting kube %s to be READY: %w", kubeId, err)
+	}
+
+	kube := &CloudProjectKubeResponse{}
+	endpoint = fmt.Sprintf("/cloud/project/%s/kube/%s", serviceName, kubeId)
+	if err := config.OVHClient.Get(endpoint, kube); err != nil {
+		return fmt.Errorf("calling Get %s: %w", endpoint, err)
+	}
+	if !cloudProjectKubeControlPlaneUpgraded(kube, target) {
+		return fmt.Errorf("control plane of kube %s is not upgraded to %s, current version is %s", kubeId, target, kube.Version)
+	}
+	log.Printf("[DEBUG] control plane of kube %s is upgraded to %s", kubeId, target)
+
+	if upgrade == nil {
+		return nil
+	}
+
+	pools := []CloudProjectKubeNodePoolResponse{}
+	endpoint = fmt.Sprintf("/cloud/project/%s/kube/%s/nodepool", serviceName, kubeId)
+	if err := config.OVHClient.Get(endpoint, &pools); err != nil {
+		return fmt.Errorf("calling Get %s: %w", endpoint, err)
+	}
+
+	for _, pool := range pools {
+		log.Printf("[DEBUG] Waiting for node pool %s of kube %s to be upgraded to %s", pool.Name, kubeId, target)
+		if err := waitForCloudProjectKubeNodePoolUpToDate(ctx, config, serviceName, kubeId, pool.Id, time.Until(deadline)); err != nil {
+			return fmt.Errorf("timeout while waiting node pool %s to be upgraded to %s: %w", pool.Name, target, err)
+		}
+	}
+
+	log.Printf("[DEBUG] kube %s is READY", kubeId)
+	return nil
+}
+
+// cloudProjectKubeControlPlaneUpgraded returns whether the control plane runs
+// the target minor version. The API returns the full version (e.g. 1.31.2-0)
+func cloudProjectKubeControlPlaneUpgraded(kube *CloudProjectKubeResponse, target string) bool {
+	return kube.ControlPlaneIsUpToDate && kube.MinorVersion() == target
+}
+
+func waitForCloudProjectKubeNodePoolUpToDate(ctx context.Context, config *Config, serviceName, kubeId, id string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"UPGRADING"},
+		Target:  []string{"UP_TO_DATE"},
+		Refresh: func() (interface{}, string, error) {
+			res := &CloudProjectKubeNodePoolResponse{}
+			endpoint := fmt.Sprintf("/cloud/project/%s/kube/%s/nodepool/%s", serviceName, kubeId, id)
+			if err := config.OVHClient.Get(endpoint, res); err != nil {
+				return res, "", err
+			}
+
+			if res.Status == "ERROR" {
+				return res, "", fmt.Errorf("node pool %s is in ERROR status", res.Name)
+			}
+			if res.Status != "READY" || res.UpToDateNodes < res.CurrentNodes {
+				return res, "UPGRADING", nil
+			}
+
+			return res, "UP_TO_DATE", nil
+		},
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+	return err
+}
+
+// cloudProjectKubeKubeconfigRotated returns whether the kubeconfig of an
+// existing cluster is going to be reset
+func cloudProjectKubeKubeconfigRotated(_ context.Context, d *schema.ResourceDiff, _ interface{}) bool {
+	return d.Id() != "" && d.HasChange(kubeClusterKubeconfigRotationKey)
+}
+
+// resetCloudProjectKubeKubeconfig resets the credentials of the cluster, waits
+// for it to be READY, and stores the new kubeconfig
+func resetCloudProjectKubeKubeconfig(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get(kubeServiceNameKey).(string)
+
+	log.Printf("[DEBUG] Will reset kubeconfig of kube %s", d.Id())
+	endpoint := fmt.Sprintf("/cloud/project/%s/kube/%s/kubeconfig/reset",
+		url.PathEscape(serviceName),
+		url.PathEscape(d.Id()),
+	)
+	if err := config.OVHClient.Post(endpoint, nil, nil); err != nil {
+		return fmt.Errorf("calling Post %s: %w", endpoint, err)
+	}
+
+	log.Printf("[DEBUG] Waiting for kube %s to be READY", d.Id())
+	err := waitForCloudProjectKubeReady(config.OVHClient, serviceName, d.Id(), []string{"RESETTING", "REDEPLOYING", "UPDATING"}, []string{"READY"}, d.Timeout(schema.TimeoutUpdate))
+	if err != nil {
+		return fmt.Errorf("timeout while waiting kube %s to be READY: %w", d.Id(), err)
+	}
+
+	return setKubeconfig(d, meta)
+}
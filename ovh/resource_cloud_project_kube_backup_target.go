@@ -0,0 +1,279 @@
+package ovh
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/ovh/go-ovh/ovh"
+	"github.com/ovh/terraform-provider-ovh/v2/ovh/helpers"
+)
+
+func resourceCloudProjectKubeBackupTarget() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCloudProjectKubeBackupTargetCreate,
+		ReadContext:   resourceCloudProjectKubeBackupTargetRead,
+		UpdateContext: resourceCloudProjectKubeBackupTargetUpdate,
+		DeleteContext: resourceCloudProjectKubeBackupTargetDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			kubeServiceNameKey: {
+				Type:        schema.TypeString,
+				Description: "Service name of the resource representing the id of the cloud project.",
+				ForceNew:    true,
+				Required:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OVH_CLOUD_PROJECT_SERVICE", nil),
+			},
+			kubeRegionKey: {
+				Type:        schema.TypeString,
+				Description: "Region of the bucket (e.g. GRA).",
+				ForceNew:    true,
+				Required:    true,
+				StateFunc: func(v interface{}) string {
+					return strings.ToUpper(v.(string))
+				},
+			},
+			kubeBackupTargetBucketNameKey: {
+				Type:        schema.TypeString,
+				Description: "Name of the bucket the backups are stored in.",
+				ForceNew:    true,
+				Required:    true,
+			},
+			kubeBackupTargetForceDestroyKey: {
+				Type:        schema.TypeBool,
+				Description: "Delete all the backups stored in the bucket when destroying the resource.",
+				Optional:    true,
+				Default:     false,
+			},
+
+			// Computed
+			kubeBackupTargetUserIdKey: {
+				Type:        schema.TypeString,
+				Description: "Id of the object storage user owning the bucket.",
+				Computed:    true,
+			},
+			kubeBackupTargetUsernameKey: {
+				Type:        schema.TypeString,
+				Description: "Username of the object storage user owning the bucket.",
+				Computed:    true,
+			},
+			kubeBackupTargetAccessKeyIdKey: {
+				Type:        schema.TypeString,
+				Description: "S3 access key id of the user.",
+				Computed:    true,
+			},
+			kubeBackupTargetSecretAccessKeyKey: {
+				Type:        schema.TypeString,
+				Description: "S3 secret access key of the user.",
+				Sensitive:   true,
+				Computed:    true,
+			},
+			kubeBackupTargetPolicyKey: {
+				Type:        schema.TypeString,
+				Description: "S3 policy of the user, only granting access to the bucket.",
+				Computed:    true,
+			},
+			kubeBackupTargetVirtualHostKey: {
+				Type:        schema.TypeString,
+				Description: "Virtual host of the bucket.",
+				Computed:    true,
+			},
+			kubeBackupTargetS3EndpointKey: {
+				Type:        schema.TypeString,
+				Description: "S3 endpoint to set as s3Url in the Velero BackupStorageLocation.",
+				Computed:    true,
+			},
+			kubeBackupTargetS3RegionKey: {
+				Type:        schema.TypeString,
+				Description: "S3 region to set as region in the Velero BackupStorageLocation.",
+				Computed:    true,
+			},
+			kubeBackupTargetVeleroCredentialsKey: {
+				Type:        schema.TypeString,
+				Description: "Content of the credentials file of the secret referenced by the Velero BackupStorageLocation.",
+				Sensitive:   true,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceCloudProjectKubeBackupTargetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	serviceName := d.Get(kubeServiceNameKey).(string)
+	region := strings.ToUpper(d.Get(kubeRegionKey).(string))
+	bucket := d.Get(kubeBackupTargetBucketNameKey).(string)
+
+	description := "Backups of kubernetes clusters in bucket " + bucket
+	userOpts := &CloudProjectUserCreateOpts{
+		Description: &description,
+		Roles:       []string{kubeBackupTargetUserRole},
+	}
+	user := &CloudProjectUser{}
+
+	log.Printf("[DEBUG] Will create object storage user for backup target %s", bucket)
+	endpoint := fmt.Sprintf("/cloud/project/%s/user", url.PathEscape(serviceName))
+	if err := config.OVHClient.PostWithContext(ctx, endpoint, userOpts, user); err != nil {
+		return diag.Errorf("calling Post %s with params %s:\n\t %q", endpoint, userOpts, err)
+	}
+
+	// From now on, anything created is cleaned up by the delete of the tainted resource
+	d.SetId(fmt.Sprintf("%s/%s/%s", serviceName, region, bucket))
+	d.Set(kubeBackupTargetUserIdKey, strconv.Itoa(user.Id))
+
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{"creating"},
+		Target:     []string{"ok"},
+		Refresh:    retry.StateRefreshFunc(waitForCloudProjectUser(config.OVHClient, serviceName, strconv.Itoa(user.Id))),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return diag.Errorf("waiting for object storage user %d: %s", user.Id, err)
+	}
+
+	storageOpts := &CloudProjectKubeBackupTargetStorageCreateOpts{
+		Name:    bucket,
+		OwnerId: user.Id,
+	}
+
+	log.Printf("[DEBUG] Will create bucket %s in region %s", bucket, region)
+	endpoint = fmt.Sprintf("/cloud/project/%s/region/%s/storage", url.PathEscape(serviceName), url.PathEscape(region))
+	if err := config.OVHClient.PostWithContext(ctx, endpoint, storageOpts, nil); err != nil {
+		return diag.Errorf("calling Post %s:\n\t %q", endpoint, err)
+	}
+
+	s3Credential := &CloudProjectUserS3CredentialSecret{}
+
+	log.Printf("[DEBUG] Will create S3 credentials of object storage user %d", user.Id)
+	endpoint = fmt.Sprintf("/cloud/project/%s/user/%d/s3Credentials", url.PathEscape(serviceName), user.Id)
+	if err := config.OVHClient.PostWithContext(ctx, endpoint, nil, s3Credential); err != nil {
+		return diag.Errorf("calling Post %s:\n\t %q", endpoint, err)
+	}
+
+	// The secret is only set at creation time
+	d.Set(kubeBackupTargetAccessKeyIdKey, s3Credential.Access)
+	d.Set(kubeBackupTargetSecretAccessKeyKey, s3Credential.Secret)
+	d.Set(kubeBackupTargetVeleroCredentialsKey, cloudProjectKubeBackupTargetVeleroCredentials(s3Credential.Access, s3Credential.Secret))
+
+	policy, err := cloudProjectKubeBackupTargetPolicy(bucket)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Will set the S3 policy of object storage user %d", user.Id)
+	if err := postPolicy(serviceName, strconv.Itoa(user.Id), policy, config); err != nil {
+		return err
+	}
+
+	return resourceCloudProjectKubeBackupTargetRead(ctx, d, meta)
+}
+
+func resourceCloudProjectKubeBackupTargetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	serviceName := d.Get(kubeServiceNameKey).(string)
+	region := d.Get(kubeRegionKey).(string)
+	bucket := d.Get(kubeBackupTargetBucketNameKey).(string)
+	userId := d.Get(kubeBackupTargetUserIdKey).(string)
+
+	user := &CloudProjectUser{}
+	endpoint := fmt.Sprintf("/cloud/project/%s/user/%s", url.PathEscape(serviceName), url.PathEscape(userId))
+	if err := config.OVHClient.GetWithContext(ctx, endpoint, user); err != nil {
+		return diag.FromErr(helpers.CheckDeleted(d, err, endpoint))
+	}
+
+	storage := &CloudProjectKubeBackupTargetStorage{}
+	endpoint = fmt.Sprintf("/cloud/project/%s/region/%s/storage/%s", url.PathEscape(serviceName), url.PathEscape(region), url.PathEscape(bucket))
+	if err := config.OVHClient.GetWithContext(ctx, endpoint, storage); err != nil {
+		return diag.FromErr(helpers.CheckDeleted(d, err, endpoint))
+	}
+
+	policy := &CloudProjectUserS3Policy{}
+	endpoint = buildUserS3PolicyEndpoint(serviceName, userId)
+	if err := config.OVHClient.GetWithContext(ctx, endpoint, policy); err != nil {
+		return diag.Errorf("calling Get %s:\n\t %q", endpoint, err)
+	}
+
+	d.Set(kubeBackupTargetUsernameKey, user.Username)
+	d.Set(kubeBackupTargetPolicyKey, policy.Policy)
+	d.Set(kubeBackupTargetVirtualHostKey, storage.VirtualHost)
+	d.Set(kubeBackupTargetS3EndpointKey, cloudProjectKubeBackupTargetS3Endpoint(storage.VirtualHost, bucket, region))
+	d.Set(kubeBackupTargetS3RegionKey, strings.ToLower(region))
+
+	return nil
+}
+
+func resourceCloudProjectKubeBackupTargetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Only force_destroy can be updated, it is only used on delete
+	return resourceCloudProjectKubeBackupTargetRead(ctx, d, meta)
+}
+
+func resourceCloudProjectKubeBackupTargetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	serviceName := d.Get(kubeServiceNameKey).(string)
+	region := d.Get(kubeRegionKey).(string)
+	bucket := d.Get(kubeBackupTargetBucketNameKey).(string)
+	userId := d.Get(kubeBackupTargetUserIdKey).(string)
+
+	if d.Get(kubeBackupTargetForceDestroyKey).(bool) {
+		log.Printf("[DEBUG] Will empty and delete bucket %s in region %s", bucket, region)
+		if err := cloudProjectStorageDeleteBucket(ctx, config, serviceName, region, bucket); err != nil {
+			return diag.Errorf("deleting bucket %s: %s", bucket, err)
+		}
+	} else {
+		// The API refuses to delete a bucket that still contains backups
+		log.Printf("[DEBUG] Will delete bucket %s in region %s", bucket, region)
+		endpoint := fmt.Sprintf("/cloud/project/%s/region/%s/storage/%s", url.PathEscape(serviceName), url.PathEscape(region), url.PathEscape(bucket))
+		if err := config.OVHClient.DeleteWithContext(ctx, endpoint, nil); err != nil {
+			if errOvh, ok := err.(*ovh.APIError); !ok || errOvh.Code != http.StatusNotFound {
+				return diag.Errorf("calling Delete %s:\n\t %q", endpoint, err)
+			}
+		}
+	}
+
+	if userId == "" {
+		d.SetId("")
+		return nil
+	}
+
+	// Deleting the user also deletes its S3 credentials and policy
+	log.Printf("[DEBUG] Will delete object storage user %s", userId)
+	endpoint := fmt.Sprintf("/cloud/project/%s/user/%s", url.PathEscape(serviceName), url.PathEscape(userId))
+	if err := config.OVHClient.DeleteWithContext(ctx, endpoint, nil); err != nil {
+		if errOvh, ok := err.(*ovh.APIError); ok && errOvh.Code == http.StatusNotFound {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("calling Delete %s:\n\t %q", endpoint, err)
+	}
+
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{"deleting"},
+		Target:     []string{"deleted"},
+		Refresh:    retry.StateRefreshFunc(waitForCloudProjectUser(config.OVHClient, serviceName, userId)),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return diag.Errorf("waiting for deletion of object storage user %s: %s", userId, err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
@@ -0,0 +1,43 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccCloudProjectKubeBackupTarget_basic(t *testing.T) {
+	bucketName := acctest.RandomWithPrefix(test_prefix)
+	serviceName := os.Getenv("OVH_CLOUD_PROJECT_SERVICE_TEST")
+	config := fmt.Sprintf(`
+	resource "ovh_cloud_project_kube_backup_target" "velero" {
+		service_name  = "%s"
+		region        = "GRA"
+		bucket_name   = "%s"
+		force_destroy = true
+	}
+	`, serviceName, bucketName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckCloud(t); testAccCheckCloudProjectExists(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ovh_cloud_project_kube_backup_target.velero", "id", fmt.Sprintf("%s/GRA/%s", serviceName, bucketName)),
+					resource.TestCheckResourceAttr("ovh_cloud_project_kube_backup_target.velero", "s3_region", "gra"),
+					resource.TestCheckResourceAttr("ovh_cloud_project_kube_backup_target.velero", "s3_endpoint", "https://s3.gra.io.cloud.ovh.net"),
+					resource.TestCheckResourceAttrSet("ovh_cloud_project_kube_backup_target.velero", "user_id"),
+					resource.TestCheckResourceAttrSet("ovh_cloud_project_kube_backup_target.velero", "access_key_id"),
+					resource.TestCheckResourceAttrSet("ovh_cloud_project_kube_backup_target.velero", "secret_access_key"),
+					resource.TestCheckResourceAttrSet("ovh_cloud_project_kube_backup_target.velero", "velero_credentials"),
+					resource.TestCheckResourceAttrSet("ovh_cloud_project_kube_backup_target.velero", "policy"),
+				),
+			},
+		},
+	})
+}
@@ -13,6 +13,7 @@ const (
 	kubeProjectIdKey   = "project_id"
 	kubeNodesKey       = "nodes"
 	kubeFlavorKey      = "flavor"
+	kubeKubesKey       = "kubes"
 )
 
 // Cluster attributes
@@ -31,11 +32,14 @@ const (
 	kubeClusterUrlKey                         = "url"
 	kubeClusterKubeconfigKey                  = "kubeconfig"
 	kubeClusterKubeconfigAttributesKey        = "kubeconfig_attributes"
+	kubeClusterKubeconfigRotationKey          = "kubeconfig_rotation"
 	kubeClusterIpAllocationPolicyKey          = "ip_allocation_policy"
 	kubeClusterPodsIpv4CidrKey                = "pods_ipv4_cidr"
 	kubeClusterServicesIpv4CidrKey            = "services_ipv4_cidr"
 	kubeClusterDefaultVrackGatewayKey         = "default_vrack_gateway"
 	kubeClusterPrivateNetworkRoutingAsDefault = "private_network_routing_as_default"
+	kubeClusterUpgradeKey                     = "upgrade"
+	kubeClusterNodePoolsKey                   = "nodepools"
 
 	// Deprecated
 	kubeClusterCustomization = "customization"
@@ -76,6 +80,9 @@ const (
 	kubeClusterCiliumCpuKey            = "cpu"
 	kubeClusterCiliumMemoryKey         = "memory"
 
+	// upgrade sub-attributes
+	kubeClusterUpgradeStagedKey = "staged"
+
 	// kubeconfig_attributes sub-attributes
 	kubeClusterKubeconfigHostKey                 = "host"
 	kubeClusterKubeconfigClusterCaCertificateKey = "cluster_ca_certificate"
@@ -102,6 +109,8 @@ const (
 	kubeNodePoolTemplateKey                                 = "template"
 	kubeNodePoolAvailabilityZonesKey                        = "availability_zones"
 	kubeNodePoolAttachFloatingIpsKey                        = "attach_floating_ips"
+	kubeNodePoolTemplateRolloutKey                          = "template_rollout"
+	kubeNodePoolTemplateDriftedNodesKey                     = "template_drifted_nodes"
 
 	// template sub-attributes
 	kubeNodePoolTemplateMetadataKey      = "metadata"
@@ -114,6 +123,11 @@ const (
 	kubeNodePoolTemplateTaintKeyKey      = "key"
 	kubeNodePoolTemplateTaintEffectKey   = "effect"
 	kubeNodePoolTemplateTaintValueKey    = "value"
+
+	// template_rollout sub-attributes
+	kubeNodePoolTemplateRolloutMaxUnavailableKey = "max_unavailable"
+	kubeNodePoolTemplateRolloutDrainKey          = "drain"
+	kubeNodePoolTemplateRolloutDrainTimeoutKey   = "drain_timeout"
 )
 
 // OIDC attributes
@@ -152,3 +166,37 @@ const (
 	kubeNodeIsUpToDateKey = "is_up_to_date"
 	kubeNodePoolIdKey     = "node_pool_id"
 )
+
+// Node action attributes
+const (
+	kubeNodeActionNodeIdKey       = "node_id"
+	kubeNodeActionNodeNameKey     = "node_name"
+	kubeNodeActionActionKey       = "action"
+	kubeNodeActionCordonKey       = "cordon"
+	kubeNodeActionDrainKey        = "drain"
+	kubeNodeActionDrainTimeoutKey = "drain_timeout"
+	kubeNodeActionTriggersKey     = "triggers"
+	kubeNodeActionEvictedPodsKey  = "evicted_pods"
+)
+
+// Etcd usage attributes
+const (
+	kubeEtcdUsageQuotaKey        = "quota"
+	kubeEtcdUsageUsageKey        = "usage"
+	kubeEtcdUsageUsagePercentKey = "usage_percent"
+)
+
+// Backup target attributes
+const (
+	kubeBackupTargetBucketNameKey        = "bucket_name"
+	kubeBackupTargetForceDestroyKey      = "force_destroy"
+	kubeBackupTargetUserIdKey            = "user_id"
+	kubeBackupTargetUsernameKey          = "username"
+	kubeBackupTargetAccessKeyIdKey       = "access_key_id"
+	kubeBackupTargetSecretAccessKeyKey   = "secret_access_key"
+	kubeBackupTargetPolicyKey            = "policy"
+	kubeBackupTargetVirtualHostKey       = "virtual_host"
+	kubeBackupTargetS3EndpointKey        = "s3_endpoint"
+	kubeBackupTargetS3RegionKey          = "s3_region"
+	kubeBackupTargetVeleroCredentialsKey = "velero_credentials"
+)
@@ -1,7 +1,16 @@
 package ovh
 
 import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"errors"
 	"fmt"
+	"io"
+	"net/http"
+	"net/url"
 
 	"gopkg.in/yaml.v3"
 )
@@ -72,3 +81,262 @@ func parseKubeconfig(kubeconfigRaw *CloudProjectKubeKubeConfigResponse) (*Kubect
 	kubeconfig.Raw = &kubeconfigRaw.Content
 	return &kubeconfig, nil
 }
+
+// kubeApiClient is a minimal client of the Kubernetes API of a cluster,
+// authenticated with the credentials of its kubeconfig
+type kubeApiClient struct {
+	*jsonApiClient
+}
+
+// newKubeApiClient builds a client for the cluster of the current context of the kubeconfig
+func newKubeApiClient(kubeconfig *KubectlConfig) (*kubeApiClient, error) {
+	var (
+		cluster *KubectlCluster
+		user    *KubectlUser
+	)
+
+	contextName := kubeconfig.CurrentContext
+	for _, c := range kubeconfig.Contexts {
+		if contextName != "" && c.Name != contextName {
+			continue
+		}
+		for _, cl := range kubeconfig.Clusters {
+			if cl.Name == c.Context.Cluster {
+				cluster = &cl.Cluster
+			}
+		}
+		for _, u := range kubeconfig.Users {
+			if u.Name == c.Context.User {
+				user = &u.User
+			}
+		}
+		break
+	}
+
+	if cluster == nil || user == nil {
+		return nil, errors.New("no cluster or user found for the current context of the kubeconfig")
+	}
+
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+
+	if cluster.CertificateAuthorityData != "" {
+		ca, err := base64.StdEncoding.DecodeString(cluster.CertificateAuthorityData)
+		if err != nil {
+			return nil, fmt.Errorf("decoding certificate-authority-data: %w", err)
+		}
+		tlsConfig.RootCAs = x509.NewCertPool()
+		tlsConfig.RootCAs.AppendCertsFromPEM(ca)
+	}
+
+	if user.ClientCertificateData != "" && user.ClientKeyData != "" {
+		certificate, err := base64.StdEncoding.DecodeString(user.ClientCertificateData)
+		if err != nil {
+			return nil, fmt.Errorf("decoding client-certificate-data: %w", err)
+		}
+		key, err := base64.StdEncoding.DecodeString(user.ClientKeyData)
+		if err != nil {
+			return nil, fmt.Errorf("decoding client-key-data: %w", err)
+		}
+		keyPair, err := tls.X509KeyPair(certificate, key)
+		if err != nil {
+			return nil, fmt.Errorf("loading client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{keyPair}
+	}
+
+	c := newJsonApiClient("kubernetes", cluster.Server, &http.Transport{TLSClientConfig: tlsConfig})
+	if user.Token != "" {
+		c.header.Set("Authorization", "Bearer "+user.Token)
+	}
+
+	return &kubeApiClient{c}, nil
+}
+
+// SetUnschedulable cordons or uncordons a node
+func (c *kubeApiClient) SetUnschedulable(ctx context.Context, node string, unschedulable bool) error {
+	patch := map[string]interface{}{
+		"spec": map[string]interface{}{"unschedulable": unschedulable},
+	}
+
+	return c.do(ctx, http.MethodPatch, "/api/v1/nodes/"+url.PathEscape(node), "application/merge-patch+json", patch, nil)
+}
+
+type kubeTaint struct {
+	Key    string `json:"key"`
+	Value  string `json:"value"`
+	Effect string `json:"effect"`
+}
+
+type kubeNode struct {
+	Metadata struct {
+		Name        string            `json:"name"`
+		Labels      map[string]string `json:"labels"`
+		Annotations map[string]string `json:"annotations"`
+	} `json:"metadata"`
+	Spec struct {
+		Unschedulable bool        `json:"unschedulable"`
+		Taints        []kubeTaint `json:"taints"`
+	} `json:"spec"`
+}
+
+// Node gets a node of the cluster
+func (c *kubeApiClient) Node(ctx context.Context, node string) (*kubeNode, error) {
+	res := &kubeNode{}
+	if err := c.do(ctx, http.MethodGet, "/api/v1/nodes/"+url.PathEscape(node), "", nil, res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+type kubePod struct {
+	Metadata struct {
+		Name            string            `json:"name"`
+		Namespace       string            `json:"namespace"`
+		Annotations     map[string]string `json:"annotations"`
+		OwnerReferences []struct {
+			Kind string `json:"kind"`
+		} `json:"ownerReferences"`
+	} `json:"metadata"`
+	Status struct {
+		Phase string `json:"phase"`
+	} `json:"status"`
+}
+
+// evictable returns whether the pod has to be evicted to drain its node,
+// DaemonSet and static pods are left untouched like kubectl drain does
+func (p *kubePod) evictable() bool {
+	if _, mirror := p.Metadata.Annotations["kubernetes.io/config.mirror"]; mirror {
+		return false
+	}
+
+	for _, owner := range p.Metadata.OwnerReferences {
+		if owner.Kind == "DaemonSet" {
+			return false
+		}
+	}
+
+	return p.Status.Phase != "Succeeded" && p.Status.Phase != "Failed"
+}
+
+// EvictablePods lists the pods of a node that have to be evicted to drain it
+func (c *kubeApiClient) EvictablePods(ctx context.Context, node string) ([]kubePod, error) {
+	list := struct {
+		Items []kubePod `json:"items"`
+	}{}
+
+	path := "/api/v1/pods?fieldSelector=" + url.QueryEscape("spec.nodeName="+node)
+	if err := c.do(ctx, http.MethodGet, path, "", nil, &list); err != nil {
+		return nil, err
+	}
+
+	pods := make([]kubePod, 0, len(list.Items))
+	for _, pod := range list.Items {
+		if pod.evictable() {
+			pods = append(pods, pod)
+		}
+	}
+
+	return pods, nil
+}
+
+// Evict evicts a pod, respecting its PodDisruptionBudgets
+func (c *kubeApiClient) Evict(ctx context.Context, pod kubePod) error {
+	eviction := map[string]interface{}{
+		"apiVersion": "policy/v1",
+		"kind":       "Eviction",
+		"metadata": map[string]string{
+			"name":      pod.Metadata.Name,
+			"namespace": pod.Metadata.Namespace,
+		},
+	}
+
+	path := fmt.Sprintf("/api/v1/namespaces/%s/pods/%s/eviction",
+		url.PathEscape(pod.Metadata.Namespace),
+		url.PathEscape(pod.Metadata.Name),
+	)
+
+	return c.do(ctx, http.MethodPost, path, "application/json", eviction, nil)
+}
+
+// kubeApplyKinds lists the kinds that can be applied by the client, with their
+// plural resource name and whether they live in a namespace
+var kubeApplyKinds = map[string]struct {
+	plural     string
+	namespaced bool
+}{
+	"Namespace":          {"namespaces", false},
+	"ServiceAccount":     {"serviceaccounts", true},
+	"Secret":             {"secrets", true},
+	"ConfigMap":          {"configmaps", true},
+	"Service":            {"services", true},
+	"Deployment":         {"deployments", true},
+	"DaemonSet":          {"daemonsets", true},
+	"ClusterRole":        {"clusterroles", false},
+	"ClusterRoleBinding": {"clusterrolebindings", false},
+	"Role":               {"roles", true},
+	"RoleBinding":        {"rolebindings", true},
+}
+
+// kubeApplyPath returns the API path of an object of a manifest
+func kubeApplyPath(object map[string]interface{}) (string, error) {
+	apiVersion, _ := object["apiVersion"].(string)
+	kind, _ := object["kind"].(string)
+	metadata, _ := object["metadata"].(map[string]interface{})
+	name, _ := metadata["name"].(string)
+	namespace, _ := metadata["namespace"].(string)
+
+	if apiVersion == "" || name == "" {
+		return "", fmt.Errorf("object of kind %q has no apiVersion or name", kind)
+	}
+
+	applyKind, ok := kubeApplyKinds[kind]
+	if !ok {
+		return "", fmt.Errorf("unsupported kind %q for object %q", kind, name)
+	}
+
+	path := "/apis/" + apiVersion
+	if apiVersion == "v1" {
+		path = "/api/v1"
+	}
+
+	if applyKind.namespaced {
+		if namespace == "" {
+			namespace = "default"
+		}
+		path += "/namespaces/" + url.PathEscape(namespace)
+	}
+
+	return path + "/" + applyKind.plural + "/" + url.PathEscape(name), nil
+}
+
+// Apply creates or updates the objects of a multi-document YAML manifest
+// using server-side apply, in the order they are declared
+func (c *kubeApiClient) Apply(ctx context.Context, manifest []byte, fieldManager string) error {
+	decoder := yaml.NewDecoder(bytes.NewReader(manifest))
+
+	for {
+		var object map[string]interface{}
+		if err := decoder.Decode(&object); err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return fmt.Errorf("decoding manifest: %w", err)
+		}
+
+		// Skip empty documents
+		if len(object) == 0 {
+			continue
+		}
+
+		path, err := kubeApplyPath(object)
+		if err != nil {
+			return err
+		}
+
+		path += "?fieldManager=" + url.QueryEscape(fieldManager) + "&force=true"
+		if err := c.do(ctx, http.MethodPatch, path, "application/apply-patch+yaml", object, nil); err != nil {
+			return fmt.Errorf("applying %s: %w", path, err)
+		}
+	}
+}
@@ -84,3 +84,79 @@ users:
 		})
 	}
 }
+
+func Test_kubeApplyPath(t *testing.T) {
+	tests := []struct {
+		name    string
+		object  map[string]interface{}
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "cluster scoped core object",
+			object: map[string]interface{}{
+				"apiVersion": "v1",
+				"kind":       "Namespace",
+				"metadata":   map[string]interface{}{"name": "cattle-system"},
+			},
+			want: "/api/v1/namespaces/cattle-system",
+		},
+		{
+			name: "namespaced group object",
+			object: map[string]interface{}{
+				"apiVersion": "apps/v1",
+				"kind":       "Deployment",
+				"metadata":   map[string]interface{}{"name": "cattle-cluster-agent", "namespace": "cattle-system"},
+			},
+			want: "/apis/apps/v1/namespaces/cattle-system/deployments/cattle-cluster-agent",
+		},
+		{
+			name: "namespaced object without namespace",
+			object: map[string]interface{}{
+				"apiVersion": "v1",
+				"kind":       "ServiceAccount",
+				"metadata":   map[string]interface{}{"name": "cattle"},
+			},
+			want: "/api/v1/namespaces/default/serviceaccounts/cattle",
+		},
+		{
+			name: "cluster scoped group object",
+			object: map[string]interface{}{
+				"apiVersion": "rbac.authorization.k8s.io/v1",
+				"kind":       "ClusterRoleBinding",
+				"metadata":   map[string]interface{}{"name": "cattle-admin-binding"},
+			},
+			want: "/apis/rbac.authorization.k8s.io/v1/clusterrolebindings/cattle-admin-binding",
+		},
+		{
+			name: "unsupported kind",
+			object: map[string]interface{}{
+				"apiVersion": "batch/v1",
+				"kind":       "CronJob",
+				"metadata":   map[string]interface{}{"name": "backup"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "missing name",
+			object: map[string]interface{}{
+				"apiVersion": "v1",
+				"kind":       "Secret",
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := kubeApplyPath(tt.object)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("kubeApplyPath() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("kubeApplyPath() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
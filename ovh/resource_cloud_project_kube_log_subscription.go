@@ -18,6 +18,7 @@ func resourceCloudProjectKubeLogSubscription() *schema.Resource {
 		CreateContext: resourceCloudProjectKubeLogSubscriptionCreate,
 		ReadContext:   resourceCloudProjectKubeLogSubscriptionRead,
 		DeleteContext: resourceCloudProjectKubeLogSubscriptionDelete,
+		CustomizeDiff: resourceCloudProjectKubeLogSubscriptionCustomizeDiff,
 
 		Importer: &schema.ResourceImporter{
 			State: resourceCloudProjectKubeLogSubscriptionImportState,
@@ -93,6 +94,37 @@ func resourceCloudProjectKubeLogSubscription() *schema.Resource {
 	}
 }
 
+// resourceCloudProjectKubeLogSubscriptionCustomizeDiff checks at plan time that
+// the log kind is available for the cluster
+func resourceCloudProjectKubeLogSubscriptionCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// Only validate subscriptions that will be created, once the cluster and the kind are known
+	if !d.HasChanges(kubeServiceNameKey, kubeKubeIdKey, kubeLogSubscriptionKindKey) {
+		return nil
+	}
+	if !d.NewValueKnown(kubeServiceNameKey) || !d.NewValueKnown(kubeKubeIdKey) || !d.NewValueKnown(kubeLogSubscriptionKindKey) {
+		return nil
+	}
+
+	config := meta.(*Config)
+	serviceName := d.Get(kubeServiceNameKey).(string)
+	kubeID := d.Get(kubeKubeIdKey).(string)
+
+	endpoint := fmt.Sprintf("/cloud/project/%s/kube/%s/log/kind",
+		url.PathEscape(serviceName),
+		url.PathEscape(kubeID),
+	)
+	kinds := []string{}
+	if err := config.OVHClient.GetWithContext(ctx, endpoint, &kinds); err != nil {
+		return fmt.Errorf("calling GET %s:\n\t %q", endpoint, err)
+	}
+
+	if err := helpers.ValidateStringEnum(d.Get(kubeLogSubscriptionKindKey).(string), kinds); err != nil {
+		return fmt.Errorf("invalid %s for kube %s: %w", kubeLogSubscriptionKindKey, kubeID, err)
+	}
+
+	return nil
+}
+
 func resourceCloudProjectKubeLogSubscriptionImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	givenID := d.Id()
 	n := 3
@@ -3,6 +3,8 @@ package ovh
 import (
 	"fmt"
 	"os"
+	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
@@ -101,6 +103,11 @@ func TestAccCloudProjectKubeLogSubscription_basic(t *testing.T) {
 					), nil
 				},
 			},
+			// Step 4: Log kind not available for the cluster
+			{
+				Config:      strings.Replace(config, `kind         = "audit"`, `kind         = "unknown"`, 1),
+				ExpectError: regexp.MustCompile(`is not among valid values`),
+			},
 		},
 	})
 }
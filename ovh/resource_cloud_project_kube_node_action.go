@@ -0,0 +1,341 @@
+package ovh
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/ovh/go-ovh/ovh"
+)
+
+func resourceCloudProjectKubeNodeAction() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCloudProjectKubeNodeActionCreate,
+		ReadContext:   resourceCloudProjectKubeNodeActionRead,
+		DeleteContext: resourceCloudProjectKubeNodeActionDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			kubeServiceNameKey: {
+				Type:        schema.TypeString,
+				Description: "Service name of the resource representing the id of the cloud project.",
+				ForceNew:    true,
+				Required:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OVH_CLOUD_PROJECT_SERVICE", nil),
+			},
+			kubeKubeIdKey: {
+				Type:        schema.TypeString,
+				Description: "Id of the managed kubernetes cluster.",
+				ForceNew:    true,
+				Required:    true,
+			},
+			kubeNodeActionNodeIdKey: {
+				Type:         schema.TypeString,
+				Description:  "Id of the node.",
+				ForceNew:     true,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{kubeNodeActionNodeIdKey, kubeNodeActionNodeNameKey},
+			},
+			kubeNodeActionNodeNameKey: {
+				Type:        schema.TypeString,
+				Description: "Name of the node.",
+				ForceNew:    true,
+				Optional:    true,
+				Computed:    true,
+			},
+			kubeNodeActionActionKey: {
+				Type:         schema.TypeString,
+				Description:  "Action to run on the node: reinstall or delete.",
+				ForceNew:     true,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"reinstall", "delete"}, false),
+			},
+			kubeNodeActionCordonKey: {
+				Type:        schema.TypeBool,
+				Description: "Mark the node as unschedulable before running the action.",
+				ForceNew:    true,
+				Optional:    true,
+				Default:     false,
+			},
+			kubeNodeActionDrainKey: {
+				Type:        schema.TypeBool,
+				Description: "Cordon the node and evict its pods before running the action.",
+				ForceNew:    true,
+				Optional:    true,
+				Default:     false,
+			},
+			kubeNodeActionDrainTimeoutKey: {
+				Type:        schema.TypeString,
+				Description: "Maximum duration of the drain, as a duration string (e.g. 5m).",
+				ForceNew:    true,
+				Optional:    true,
+				Default:     "5m",
+				ValidateFunc: func(v interface{}, k string) (ws []string, errs []error) {
+					if _, err := time.ParseDuration(v.(string)); err != nil {
+						errs = append(errs, fmt.Errorf("%s: %w", k, err))
+					}
+					return
+				},
+			},
+			kubeNodeActionTriggersKey: {
+				Type:        schema.TypeMap,
+				Description: "Arbitrary map of values that, when changed, runs the action again.",
+				ForceNew:    true,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+
+			// Computed
+			kubeNodePoolIdKey: {
+				Type:        schema.TypeString,
+				Description: "Id of the node pool of the node.",
+				Computed:    true,
+			},
+			kubeNodeActionEvictedPodsKey: {
+				Type:        schema.TypeList,
+				Description: "Pods evicted by the drain, formatted like namespace/name.",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceCloudProjectKubeNodeActionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	serviceName := d.Get(kubeServiceNameKey).(string)
+	kubeId := d.Get(kubeKubeIdKey).(string)
+	action := d.Get(kubeNodeActionActionKey).(string)
+	drain := d.Get(kubeNodeActionDrainKey).(bool)
+	cordon := drain || d.Get(kubeNodeActionCordonKey).(bool)
+
+	node, err := cloudProjectKubeNodeFind(ctx, config, serviceName, kubeId,
+		d.Get(kubeNodeActionNodeIdKey).(string),
+		d.Get(kubeNodeActionNodeNameKey).(string),
+	)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set(kubeNodeActionNodeIdKey, node.Id)
+	d.Set(kubeNodeActionNodeNameKey, node.Name)
+	d.Set(kubeNodePoolIdKey, node.NodePoolId)
+
+	var kubeClient *kubeApiClient
+	if cordon {
+		kubeconfig, err := getKubeconfig(config, serviceName, kubeId)
+		if err != nil {
+			return diag.Errorf("fetching kubeconfig of cluster %s: %s", kubeId, err)
+		}
+
+		if kubeClient, err = newKubeApiClient(kubeconfig); err != nil {
+			return diag.FromErr(err)
+		}
+
+		log.Printf("[DEBUG] Will cordon node %s of cluster %s", node.Name, kubeId)
+		if err := kubeClient.SetUnschedulable(ctx, node.Name, true); err != nil {
+			return diag.Errorf("cordoning node %s: %s", node.Name, err)
+		}
+	}
+
+	evicted := []string{}
+	if drain {
+		timeout, _ := time.ParseDuration(d.Get(kubeNodeActionDrainTimeoutKey).(string))
+		if evicted, err = cloudProjectKubeNodeDrain(ctx, kubeClient, node.Name, timeout); err != nil {
+			return diag.Errorf("draining node %s: %s", node.Name, err)
+		}
+	}
+	d.Set(kubeNodeActionEvictedPodsKey, evicted)
+
+	endpoint := fmt.Sprintf("/cloud/project/%s/kube/%s/node/%s",
+		url.PathEscape(serviceName),
+		url.PathEscape(kubeId),
+		url.PathEscape(node.Id),
+	)
+
+	switch action {
+	case "delete":
+		log.Printf("[DEBUG] Will delete node %s of cluster %s", node.Name, kubeId)
+		if err := config.OVHClient.DeleteWithContext(ctx, endpoint, nil); err != nil {
+			return diag.Errorf("calling Delete %s: %s", endpoint, err)
+		}
+
+		if err := waitForCloudProjectKubeNodeDeleted(ctx, config, endpoint, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.Errorf("waiting for node %s to be deleted: %s", node.Name, err)
+		}
+
+	case "reinstall":
+		log.Printf("[DEBUG] Will reinstall node %s of cluster %s", node.Name, kubeId)
+		if err := config.OVHClient.PostWithContext(ctx, endpoint+"/reinstall", nil, nil); err != nil {
+			return diag.Errorf("calling Post %s/reinstall: %s", endpoint, err)
+		}
+
+		if err := waitForCloudProjectKubeNodeReady(ctx, config, endpoint, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.Errorf("waiting for node %s to be reinstalled: %s", node.Name, err)
+		}
+
+		// The reinstalled node registers again in the cluster, it only has
+		// to be uncordoned if the kubernetes node object was kept
+		if cordon {
+			if err := kubeClient.SetUnschedulable(ctx, node.Name, false); err != nil {
+				if !isJsonApiNotFound(err) {
+					return diag.Errorf("uncordoning node %s: %s", node.Name, err)
+				}
+			}
+		}
+	}
+
+	log.Printf("[DEBUG] Waiting for node pool %s to be READY", node.NodePoolId)
+	if err := waitForCloudProjectKubeNodePoolWithStateTarget(config.OVHClient, serviceName, kubeId, node.NodePoolId, d.Timeout(schema.TimeoutCreate), []string{"READY"}); err != nil {
+		return diag.Errorf("timeout while waiting node pool %s to be READY: %s", node.NodePoolId, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%d", kubeId, node.Id, time.Now().Unix()))
+
+	return resourceCloudProjectKubeNodeActionRead(ctx, d, meta)
+}
+
+func resourceCloudProjectKubeNodeActionRead(_ context.Context, _ *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	// The action is run once on creation, there is nothing to refresh
+	return nil
+}
+
+func resourceCloudProjectKubeNodeActionDelete(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	// An action can't be reverted, the resource is only removed from the state
+	d.SetId("")
+	return nil
+}
+
+// cloudProjectKubeNodeFind returns the node of the cluster matching the id
+// or the name
+func cloudProjectKubeNodeFind(ctx context.Context, config *Config, serviceName, kubeId, id, name string) (*CloudProjectKubeNodeResponse, error) {
+	endpoint := fmt.Sprintf("/cloud/project/%s/kube/%s/node",
+		url.PathEscape(serviceName),
+		url.PathEscape(kubeId),
+	)
+
+	var nodes []CloudProjectKubeNodeResponse
+	if err := config.OVHClient.GetWithContext(ctx, endpoint, &nodes); err != nil {
+		return nil, fmt.Errorf("calling Get %s: %s", endpoint, err)
+	}
+
+	for i := range nodes {
+		if (id != "" && nodes[i].Id == id) || (id == "" && nodes[i].Name == name) {
+			return &nodes[i], nil
+		}
+	}
+
+	if id != "" {
+		return nil, fmt.Errorf("node %s not found in cluster %s", id, kubeId)
+	}
+	return nil, fmt.Errorf("node named %s not found in cluster %s", name, kubeId)
+}
+
+// cloudProjectKubeNodeDrain evicts the pods of a cordoned node and waits for
+// them to be gone. Evictions refused because of a PodDisruptionBudget are
+// retried until the timeout
+func cloudProjectKubeNodeDrain(ctx context.Context, client *kubeApiClient, node string, timeout time.Duration) ([]string, error) {
+	evicted := map[string]bool{}
+
+	err := retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+		pods, err := client.EvictablePods(ctx, node)
+		if err != nil {
+			return retry.NonRetryableError(err)
+		}
+
+		if len(pods) == 0 {
+			return nil
+		}
+
+		for _, pod := range pods {
+			key := pod.Metadata.Namespace + "/" + pod.Metadata.Name
+			if evicted[key] {
+				continue
+			}
+
+			if err := client.Evict(ctx, pod); err != nil {
+				if errKube, ok := err.(*jsonApiError); ok {
+					switch errKube.Code {
+					case http.StatusNotFound:
+						evicted[key] = true
+						continue
+					case http.StatusTooManyRequests:
+						log.Printf("[DEBUG] Eviction of pod %s refused by its disruption budget, will retry", key)
+						continue
+					}
+				}
+				return retry.NonRetryableError(fmt.Errorf("evicting pod %s: %w", key, err))
+			}
+
+			log.Printf("[DEBUG] Evicted pod %s from node %s", key, node)
+			evicted[key] = true
+		}
+
+		return retry.RetryableError(fmt.Errorf("waiting for %d pods to leave node %s", len(pods), node))
+	})
+
+	keys := make([]string, 0, len(evicted))
+	for key := range evicted {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys, err
+}
+
+func waitForCloudProjectKubeNodeDeleted(ctx context.Context, config *Config, endpoint string, timeout time.Duration) error {
+	return retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+		node := &CloudProjectKubeNodeResponse{}
+		if err := config.OVHClient.GetWithContext(ctx, endpoint, node); err != nil {
+			if errOvh, ok := err.(*ovh.APIError); ok && errOvh.Code == http.StatusNotFound {
+				return nil
+			}
+			return retry.NonRetryableError(err)
+		}
+
+		return retry.RetryableError(fmt.Errorf("waiting for node to be deleted, current status: %s", node.Status))
+	})
+}
+
+// waitForCloudProjectKubeNodeReady waits for the node to leave the READY
+// status, then to come back to it
+func waitForCloudProjectKubeNodeReady(ctx context.Context, config *Config, endpoint string, timeout time.Duration) error {
+	started := false
+	deadline := time.Now().Add(2 * time.Minute)
+
+	return retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+		node := &CloudProjectKubeNodeResponse{}
+		if err := config.OVHClient.GetWithContext(ctx, endpoint, node); err != nil {
+			return retry.NonRetryableError(err)
+		}
+
+		if node.Status == "ERROR" {
+			return retry.NonRetryableError(errors.New("node is in ERROR status"))
+		}
+
+		if node.Status != "READY" {
+			started = true
+			return retry.RetryableError(fmt.Errorf("waiting for node to be READY, current status: %s", node.Status))
+		}
+
+		// The status may not have changed yet right after the call
+		if !started && time.Now().Before(deadline) {
+			return retry.RetryableError(errors.New("waiting for node reinstallation to start"))
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,188 @@
+package ovh
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// fakeKubeApi serves the pods of a node, evicting them on request. The pod
+// protected by a disruption budget refuses its first eviction
+type fakeKubeApi struct {
+	sync.Mutex
+	pods          []map[string]interface{}
+	refused       bool
+	unschedulable bool
+}
+
+func (f *fakeKubeApi) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.Lock()
+	defer f.Unlock()
+
+	switch {
+	case r.Method == http.MethodPatch && r.URL.Path == "/api/v1/nodes/node-1":
+		var patch struct {
+			Spec struct {
+				Unschedulable bool `json:"unschedulable"`
+			} `json:"spec"`
+		}
+		json.NewDecoder(r.Body).Decode(&patch)
+		f.unschedulable = patch.Spec.Unschedulable
+		w.Write([]byte(`{}`))
+
+	case r.Method == http.MethodGet && r.URL.Path == "/api/v1/pods":
+		if r.URL.Query().Get("fieldSelector") != "spec.nodeName=node-1" {
+			http.Error(w, `{"message":"unexpected selector"}`, http.StatusBadRequest)
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"items": f.pods})
+
+	case r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/eviction"):
+		name := strings.Split(r.URL.Path, "/")[6]
+		if name == "protected" && !f.refused {
+			f.refused = true
+			w.WriteHeader(http.StatusTooManyRequests)
+			w.Write([]byte(`{"message":"Cannot evict pod as it would violate the pod's disruption budget."}`))
+			return
+		}
+		for i, pod := range f.pods {
+			if pod["metadata"].(map[string]interface{})["name"] == name {
+				f.pods = append(f.pods[:i], f.pods[i+1:]...)
+				break
+			}
+		}
+		w.Write([]byte(`{}`))
+
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func fakeKubePod(name string, annotations map[string]string, ownerKind string) map[string]interface{} {
+	metadata := map[string]interface{}{
+		"name":        name,
+		"namespace":   "default",
+		"annotations": annotations,
+	}
+	if ownerKind != "" {
+		metadata["ownerReferences"] = []map[string]string{{"kind": ownerKind}}
+	}
+
+	return map[string]interface{}{
+		"metadata": metadata,
+		"status":   map[string]string{"phase": "Running"},
+	}
+}
+
+func TestCloudProjectKubeNodeDrain(t *testing.T) {
+	api := &fakeKubeApi{
+		pods: []map[string]interface{}{
+			fakeKubePod("web", nil, "ReplicaSet"),
+			fakeKubePod("protected", nil, "StatefulSet"),
+			fakeKubePod("agent", nil, "DaemonSet"),
+			fakeKubePod("static", map[string]string{"kubernetes.io/config.mirror": "abc"}, ""),
+		},
+	}
+	server := httptest.NewTLSServer(api)
+	defer server.Close()
+
+	client := &kubeApiClient{newJsonApiClient("kubernetes", server.URL, server.Client().Transport)}
+
+	if err := client.SetUnschedulable(context.Background(), "node-1", true); err != nil {
+		t.Fatalf("unexpected error cordoning node: %s", err)
+	}
+	if !api.unschedulable {
+		t.Fatalf("node should be unschedulable")
+	}
+
+	evicted, err := cloudProjectKubeNodeDrain(context.Background(), client, "node-1", time.Minute)
+	if err != nil {
+		t.Fatalf("unexpected error draining node: %s", err)
+	}
+
+	want := []string{"default/protected", "default/web"}
+	if !reflect.DeepEqual(evicted, want) {
+		t.Errorf("evicted pods = %v, want %v", evicted, want)
+	}
+
+	if len(api.pods) != 2 {
+		t.Errorf("DaemonSet and static pods should be kept, got %v", api.pods)
+	}
+}
+
+func TestAccCloudProjectKubeNodeAction_delete(t *testing.T) {
+	name := acctest.RandomWithPrefix(test_prefix)
+	region := os.Getenv("OVH_CLOUD_PROJECT_KUBE_REGION_TEST")
+
+	config := fmt.Sprintf(
+		testAccCloudProjectKubeNodeActionConfig,
+		os.Getenv("OVH_CLOUD_PROJECT_SERVICE_TEST"),
+		name,
+		region,
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckKubernetes(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"ovh_cloud_project_kube_node_action.delete", "node_pool_id",
+						"ovh_cloud_project_kube_nodepool.pool", "id"),
+					resource.TestCheckResourceAttrSet(
+						"ovh_cloud_project_kube_node_action.delete", "node_id"),
+					resource.TestCheckResourceAttrSet(
+						"ovh_cloud_project_kube_node_action.delete", "evicted_pods.#"),
+				),
+			},
+		},
+	})
+}
+
+var testAccCloudProjectKubeNodeActionConfig = `
+resource "ovh_cloud_project_kube" "cluster" {
+	service_name  = "%s"
+	name          = "%s"
+	region        = "%s"
+}
+
+resource "ovh_cloud_project_kube_nodepool" "pool" {
+	service_name  = ovh_cloud_project_kube.cluster.service_name
+	kube_id       = ovh_cloud_project_kube.cluster.id
+	name          = ovh_cloud_project_kube.cluster.name
+	flavor_name   = "b3-8"
+	desired_nodes = 2
+	min_nodes     = 0
+	max_nodes     = 2
+	autoscale     = false
+}
+
+data "ovh_cloud_project_kube_nodepool_nodes" "nodes" {
+	service_name = ovh_cloud_project_kube_nodepool.pool.service_name
+	kube_id      = ovh_cloud_project_kube_nodepool.pool.kube_id
+	name         = ovh_cloud_project_kube_nodepool.pool.name
+}
+
+resource "ovh_cloud_project_kube_node_action" "delete" {
+	service_name = ovh_cloud_project_kube_nodepool.pool.service_name
+	kube_id      = ovh_cloud_project_kube_nodepool.pool.kube_id
+	node_name    = data.ovh_cloud_project_kube_nodepool_nodes.nodes.nodes[0].name
+	action       = "delete"
+	drain        = true
+}
+`
@@ -1,12 +1,17 @@
 package ovh
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"net/url"
+	"sort"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/ovh/go-ovh/ovh"
 	"github.com/ovh/terraform-provider-ovh/v2/ovh/helpers"
@@ -15,10 +20,12 @@ import (
 
 func resourceCloudProjectKubeNodePool() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceCloudProjectKubeNodePoolCreate,
-		Read:   resourceCloudProjectKubeNodePoolRead,
-		Delete: resourceCloudProjectKubeNodePoolDelete,
-		Update: resourceCloudProjectKubeNodePoolUpdate,
+		CreateContext: resourceCloudProjectKubeNodePoolCreate,
+		ReadContext:   resourceCloudProjectKubeNodePoolRead,
+		DeleteContext: resourceCloudProjectKubeNodePoolDelete,
+		UpdateContext: resourceCloudProjectKubeNodePoolUpdate,
+
+		CustomizeDiff: resourceCloudProjectKubeNodePoolCustomizeDiff,
 
 		Importer: &schema.ResourceImporter{
 			State: resourceCloudProjectKubeNodePoolImportState,
@@ -278,10 +285,78 @@ func resourceCloudProjectKubeNodePool() *schema.Resource {
 					Type: schema.TypeString,
 				},
 			},
+			kubeNodePoolTemplateRolloutKey: {
+				Description: "Replace the nodes whose labels, annotations or taints don't match the template",
+				Optional:    true,
+				Type:        schema.TypeList,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						kubeNodePoolTemplateRolloutMaxUnavailableKey: {
+							Description:  "Maximum number of nodes replaced at the same time",
+							Optional:     true,
+							Default:      1,
+							Type:         schema.TypeInt,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						kubeNodePoolTemplateRolloutDrainKey: {
+							Description: "Whether the nodes are drained before being deleted",
+							Optional:    true,
+							Default:     true,
+							Type:        schema.TypeBool,
+						},
+						kubeNodePoolTemplateRolloutDrainTimeoutKey: {
+							Description: "Maximum duration of the drain of each node, as a duration string (e.g. 5m)",
+							Optional:    true,
+							Default:     "5m",
+							Type:        schema.TypeString,
+							ValidateFunc: func(v interface{}, k string) (ws []string, errs []error) {
+								if _, err := time.ParseDuration(v.(string)); err != nil {
+									errs = append(errs, fmt.Errorf("%s: %w", k, err))
+								}
+								return
+							},
+						},
+					},
+				},
+			},
+			kubeNodePoolTemplateDriftedNodesKey: {
+				Description: "Names of the nodes whose labels, annotations or taints don't match the template",
+				Computed:    true,
+				Type:        schema.TypeList,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
 		},
 	}
 }
 
+// resourceCloudProjectKubeNodePoolCustomizeDiff plans the replacement of the
+// nodes that don't match the template when template_rollout is set
+func resourceCloudProjectKubeNodePoolCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if _, ok := d.GetOk(kubeNodePoolTemplateRolloutKey); !ok {
+		return nil
+	}
+
+	// Surging the node pool would be undone by the autoscaler
+	if d.Get(kubeNodePoolAutoscaleKey).(bool) {
+		return fmt.Errorf("%s can't be used on a node pool with %s enabled", kubeNodePoolTemplateRolloutKey, kubeNodePoolAutoscaleKey)
+	}
+
+	if d.Id() == "" {
+		return nil
+	}
+
+	if d.HasChange(kubeNodePoolTemplateKey) {
+		return d.SetNewComputed(kubeNodePoolTemplateDriftedNodesKey)
+	}
+
+	if len(d.Get(kubeNodePoolTemplateDriftedNodesKey).([]interface{})) > 0 {
+		return d.SetNew(kubeNodePoolTemplateDriftedNodesKey, []string{})
+	}
+
+	return nil
+}
+
 func resourceCloudProjectKubeNodePoolImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	givenId := d.Id()
 	splitId := strings.SplitN(givenId, "/", 3)
@@ -300,7 +375,7 @@ func resourceCloudProjectKubeNodePoolImportState(d *schema.ResourceData, meta in
 	return results, nil
 }
 
-func resourceCloudProjectKubeNodePoolCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceCloudProjectKubeNodePoolCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 	serviceName := d.Get(kubeServiceNameKey).(string)
 	kubeId := d.Get(kubeKubeIdKey).(string)
@@ -308,14 +383,14 @@ func resourceCloudProjectKubeNodePoolCreate(d *schema.ResourceData, meta interfa
 	endpoint := fmt.Sprintf("/cloud/project/%s/kube/%s/nodepool", serviceName, kubeId)
 	params, err := (&CloudProjectKubeNodePoolCreateOpts{}).FromResource(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	res := &CloudProjectKubeNodePoolResponse{}
 
 	log.Printf("[DEBUG] Will create nodepool: %+v", params)
 	err = config.OVHClient.Post(endpoint, params, res)
 	if err != nil {
-		return fmt.Errorf("calling Post %s with params %s:\n\t %w", endpoint, params, err)
+		return diag.Errorf("calling Post %s with params %s:\n\t %s", endpoint, params, err)
 	}
 
 	// This is a fix for a weird bug where the nodepool is not immediately available on API
@@ -323,22 +398,22 @@ func resourceCloudProjectKubeNodePoolCreate(d *schema.ResourceData, meta interfa
 	endpoint = fmt.Sprintf("/cloud/project/%s/kube/%s/nodepool/%s", serviceName, kubeId, res.Id)
 	err = helpers.WaitAvailable(config.OVHClient, endpoint, 2*time.Minute)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	log.Printf("[DEBUG] Waiting for nodepool %s to be READY or ERROR", res.Id)
 	err = waitForCloudProjectKubeNodePoolWithStateTarget(config.OVHClient, serviceName, kubeId, res.Id, d.Timeout(schema.TimeoutCreate), []string{"READY", "ERROR"})
 	if err != nil {
-		return fmt.Errorf("timeout while waiting nodepool %s to be READY: %w", res.Id, err)
+		return diag.Errorf("timeout while waiting nodepool %s to be READY: %s", res.Id, err)
 	}
 	log.Printf("[DEBUG] nodepool %s is READY", res.Id)
 
 	d.SetId(res.Id)
 
-	return resourceCloudProjectKubeNodePoolRead(d, meta)
+	return resourceCloudProjectKubeNodePoolRead(ctx, d, meta)
 }
 
-func resourceCloudProjectKubeNodePoolRead(d *schema.ResourceData, meta interface{}) error {
+func resourceCloudProjectKubeNodePoolRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 	serviceName := d.Get(kubeServiceNameKey).(string)
 	kubeId := d.Get(kubeKubeIdKey).(string)
@@ -348,7 +423,7 @@ func resourceCloudProjectKubeNodePoolRead(d *schema.ResourceData, meta interface
 
 	log.Printf("[DEBUG] Will read nodepool %s from cluster %s in project %s", d.Id(), kubeId, serviceName)
 	if err := config.OVHClient.Get(endpoint, res); err != nil {
-		return helpers.CheckDeleted(d, err, endpoint)
+		return diag.FromErr(helpers.CheckDeleted(d, err, endpoint))
 	}
 
 	for k, v := range res.ToMap() {
@@ -359,11 +434,35 @@ func resourceCloudProjectKubeNodePoolRead(d *schema.ResourceData, meta interface
 		}
 	}
 
+	drifted := []string{}
+	if _, ok := d.GetOk(kubeNodePoolTemplateRolloutKey); ok {
+		template, err := loadNodelPoolTemplateFromResource(d.Get(kubeNodePoolTemplateKey))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		nodes, err := cloudProjectKubeNodePoolDriftedNodes(ctx, config, serviceName, kubeId, d.Id(), template, nil)
+		if err != nil {
+			// The cluster API may not be reachable from here, don't prevent the
+			// node pool from being read and keep the previously drifted nodes
+			return diag.Diagnostics{{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("Unable to check the nodes of nodepool %s against its template", d.Id()),
+				Detail:   err.Error(),
+			}}
+		}
+
+		for _, node := range nodes {
+			drifted = append(drifted, node.Name)
+		}
+	}
+	d.Set(kubeNodePoolTemplateDriftedNodesKey, drifted)
+
 	log.Printf("[DEBUG] Read nodepool: %+v", res)
 	return nil
 }
 
-func resourceCloudProjectKubeNodePoolUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceCloudProjectKubeNodePoolUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 	serviceName := d.Get(kubeServiceNameKey).(string)
 	kubeId := d.Get(kubeKubeIdKey).(string)
@@ -371,7 +470,7 @@ func resourceCloudProjectKubeNodePoolUpdate(d *schema.ResourceData, meta interfa
 	endpoint := fmt.Sprintf("/cloud/project/%s/kube/%s/nodepool/%s", serviceName, kubeId, d.Id())
 	params, err := (&CloudProjectKubeNodePoolUpdateOpts{}).FromResource(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if params.MaxNodes != nil && params.DesiredNodes == nil {
@@ -386,7 +485,7 @@ func resourceCloudProjectKubeNodePoolUpdate(d *schema.ResourceData, meta interfa
 			if apiErr, ok := err.(*ovh.APIError); ok && apiErr.Code == 404 {
 				// Nodepool not found when attempting pre-update GET; proceed without capping.
 			} else {
-				return fmt.Errorf("failed to GET existing nodepool %s before update: %w", d.Id(), err)
+				return diag.Errorf("failed to GET existing nodepool %s before update: %s", d.Id(), err)
 			}
 		}
 	}
@@ -394,20 +493,36 @@ func resourceCloudProjectKubeNodePoolUpdate(d *schema.ResourceData, meta interfa
 	log.Printf("[DEBUG] Will update nodepool: %#v", *params)
 	err = config.OVHClient.Put(endpoint, params, nil)
 	if err != nil {
-		return fmt.Errorf("calling Put %s with params %v:\n\t %w", endpoint, *params, err)
+		return diag.Errorf("calling Put %s with params %v:\n\t %s", endpoint, *params, err)
 	}
 
 	log.Printf("[DEBUG] Waiting for nodepool %s to be READY", d.Id())
 	err = waitForCloudProjectKubeNodePoolWithStateTarget(config.OVHClient, serviceName, kubeId, d.Id(), d.Timeout(schema.TimeoutUpdate), []string{"READY"})
 	if err != nil {
-		return fmt.Errorf("timeout while waiting nodepool %s to be READY: %w", d.Id(), err)
+		return diag.Errorf("timeout while waiting nodepool %s to be READY: %s", d.Id(), err)
 	}
 	log.Printf("[DEBUG] nodepool %s is READY", d.Id())
 
-	return resourceCloudProjectKubeNodePoolRead(d, meta)
+	if _, ok := d.GetOk(kubeNodePoolTemplateRolloutKey); ok {
+		o, n := d.GetChange(kubeNodePoolTemplateKey)
+		previous, err := loadNodelPoolTemplateFromResource(o)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		template, err := loadNodelPoolTemplateFromResource(n)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		if err := cloudProjectKubeNodePoolRollout(ctx, d, config, template, previous); err != nil {
+			return diag.Errorf("rolling out template of nodepool %s: %s", d.Id(), err)
+		}
+	}
+
+	return resourceCloudProjectKubeNodePoolRead(ctx, d, meta)
 }
 
-func resourceCloudProjectKubeNodePoolDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceCloudProjectKubeNodePoolDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 	serviceName := d.Get(kubeServiceNameKey).(string)
 	kubeId := d.Get(kubeKubeIdKey).(string)
@@ -417,13 +532,13 @@ func resourceCloudProjectKubeNodePoolDelete(d *schema.ResourceData, meta interfa
 	log.Printf("[DEBUG] Will delete nodepool %s from cluster %s in project %s", d.Id(), kubeId, serviceName)
 	err := config.OVHClient.Delete(endpoint, nil)
 	if err != nil {
-		return helpers.CheckDeleted(d, err, endpoint)
+		return diag.FromErr(helpers.CheckDeleted(d, err, endpoint))
 	}
 
 	log.Printf("[DEBUG] Waiting for nodepool %s to be DELETED", d.Id())
 	err = waitForCloudProjectKubeNodePoolDeleted(config.OVHClient, serviceName, kubeId, d.Id(), d.Timeout(schema.TimeoutDelete))
 	if err != nil {
-		return fmt.Errorf("timeout while waiting nodepool %s to be DELETED: %v", d.Id(), err)
+		return diag.Errorf("timeout while waiting nodepool %s to be DELETED: %v", d.Id(), err)
 	}
 	log.Printf("[DEBUG] nodepool %s is DELETED", d.Id())
 
@@ -488,3 +603,245 @@ func waitForCloudProjectKubeNodePoolDeleted(client *ovhwrap.Client, serviceName,
 	_, err := stateConf.WaitForState()
 	return err
 }
+
+// cloudProjectKubeNodeTemplateDrift returns why the node doesn't match the
+// template of its pool. Only the labels, annotations and taints of the
+// template are compared, plus the ones removed from the previous template
+func cloudProjectKubeNodeTemplateDrift(node *kubeNode, template, previous *CloudProjectKubeNodePoolTemplate) []string {
+	reasons := []string{}
+
+	for key, value := range template.Metadata.Labels {
+		if current, ok := node.Metadata.Labels[key]; !ok || current != value {
+			reasons = append(reasons, fmt.Sprintf("label %s=%s missing", key, value))
+		}
+	}
+	for key, value := range template.Metadata.Annotations {
+		if current, ok := node.Metadata.Annotations[key]; !ok || current != value {
+			reasons = append(reasons, fmt.Sprintf("annotation %s=%s missing", key, value))
+		}
+	}
+
+	hasTaint := func(taint Taint) bool {
+		for _, t := range node.Spec.Taints {
+			if t.Key == taint.Key && t.Value == taint.Value && t.Effect == taint.Effect.String() {
+				return true
+			}
+		}
+		return false
+	}
+	inTemplate := func(taint Taint) bool {
+		for _, t := range template.Spec.Taints {
+			if t == taint {
+				return true
+			}
+		}
+		return false
+	}
+
+	for _, taint := range template.Spec.Taints {
+		if !hasTaint(taint) {
+			reasons = append(reasons, fmt.Sprintf("taint %s=%s:%s missing", taint.Key, taint.Value, taint.Effect))
+		}
+	}
+
+	if template.Spec.Unschedulable && !node.Spec.Unschedulable {
+		reasons = append(reasons, "node is schedulable")
+	}
+
+	if previous != nil {
+		for key := range previous.Metadata.Labels {
+			if _, inNew := template.Metadata.Labels[key]; !inNew {
+				if _, ok := node.Metadata.Labels[key]; ok {
+					reasons = append(reasons, fmt.Sprintf("label %s removed from template", key))
+				}
+			}
+		}
+		for key := range previous.Metadata.Annotations {
+			if _, inNew := template.Metadata.Annotations[key]; !inNew {
+				if _, ok := node.Metadata.Annotations[key]; ok {
+					reasons = append(reasons, fmt.Sprintf("annotation %s removed from template", key))
+				}
+			}
+		}
+		for _, taint := range previous.Spec.Taints {
+			if !inTemplate(taint) && hasTaint(taint) {
+				reasons = append(reasons, fmt.Sprintf("taint %s=%s:%s removed from template", taint.Key, taint.Value, taint.Effect))
+			}
+		}
+		if previous.Spec.Unschedulable && !template.Spec.Unschedulable && node.Spec.Unschedulable {
+			reasons = append(reasons, "node is unschedulable")
+		}
+	}
+
+	sort.Strings(reasons)
+	return reasons
+}
+
+// cloudProjectKubeNodePoolDriftedNodes lists the READY nodes of the pool whose
+// kubernetes node doesn't match the template
+func cloudProjectKubeNodePoolDriftedNodes(ctx context.Context, config *Config, serviceName, kubeId, id string, template, previous *CloudProjectKubeNodePoolTemplate) ([]CloudProjectKubeNodeResponse, error) {
+	kubeconfig, err := getKubeconfig(config, serviceName, kubeId)
+	if err != nil {
+		return nil, fmt.Errorf("fetching kubeconfig of cluster %s: %w", kubeId, err)
+	}
+
+	kubeClient, err := newKubeApiClient(kubeconfig)
+	if err != nil {
+		return nil, err
+	}
+
+	endpoint := fmt.Sprintf("/cloud/project/%s/kube/%s/nodepool/%s/nodes",
+		url.PathEscape(serviceName),
+		url.PathEscape(kubeId),
+		url.PathEscape(id),
+	)
+
+	var nodes []CloudProjectKubeNodeResponse
+	if err := config.OVHClient.GetWithContext(ctx, endpoint, &nodes); err != nil {
+		return nil, fmt.Errorf("calling Get %s: %w", endpoint, err)
+	}
+
+	drifted := []CloudProjectKubeNodeResponse{}
+	for _, node := range nodes {
+		// Nodes being installed or deleted are not compared
+		if node.Status != "READY" {
+			continue
+		}
+
+		kubeNode, err := kubeClient.Node(ctx, node.Name)
+		if err != nil {
+			if isJsonApiNotFound(err) {
+				continue
+			}
+			return nil, fmt.Errorf("getting node %s: %w", node.Name, err)
+		}
+
+		if reasons := cloudProjectKubeNodeTemplateDrift(kubeNode, template, previous); len(reasons) > 0 {
+			log.Printf("[DEBUG] Node %s doesn't match the template of nodepool %s: %s", node.Name, id, strings.Join(reasons, ", "))
+			drifted = append(drifted, node)
+		}
+	}
+
+	sort.Slice(drifted, func(i, j int) bool { return drifted[i].Name < drifted[j].Name })
+	return drifted, nil
+}
+
+// cloudProjectKubeNodePoolRollout replaces the nodes of the pool that don't
+// match the template. For each batch of max_unavailable nodes, the pool is
+// surged by the size of the batch so that its capacity never drops, then
+// the old nodes are cordoned, drained and deleted
+func cloudProjectKubeNodePoolRollout(ctx context.Context, d *schema.ResourceData, config *Config, template, previous *CloudProjectKubeNodePoolTemplate) error {
+	serviceName := d.Get(kubeServiceNameKey).(string)
+	kubeId := d.Get(kubeKubeIdKey).(string)
+	timeout := d.Timeout(schema.TimeoutUpdate)
+
+	maxUnavailable := d.Get(kubeNodePoolTemplateRolloutKey + ".0." + kubeNodePoolTemplateRolloutMaxUnavailableKey).(int)
+	drain := d.Get(kubeNodePoolTemplateRolloutKey + ".0." + kubeNodePoolTemplateRolloutDrainKey).(bool)
+	drainTimeout, _ := time.ParseDuration(d.Get(kubeNodePoolTemplateRolloutKey + ".0." + kubeNodePoolTemplateRolloutDrainTimeoutKey).(string))
+
+	drifted, err := cloudProjectKubeNodePoolDriftedNodes(ctx, config, serviceName, kubeId, d.Id(), template, previous)
+	if err != nil {
+		return err
+	}
+	if len(drifted) == 0 {
+		return nil
+	}
+
+	kubeconfig, err := getKubeconfig(config, serviceName, kubeId)
+	if err != nil {
+		return fmt.Errorf("fetching kubeconfig of cluster %s: %w", kubeId, err)
+	}
+	kubeClient, err := newKubeApiClient(kubeconfig)
+	if err != nil {
+		return err
+	}
+
+	endpoint := fmt.Sprintf("/cloud/project/%s/kube/%s/nodepool/%s",
+		url.PathEscape(serviceName),
+		url.PathEscape(kubeId),
+		url.PathEscape(d.Id()),
+	)
+
+	for len(drifted) > 0 {
+		pool := &CloudProjectKubeNodePoolResponse{}
+		if err := config.OVHClient.GetWithContext(ctx, endpoint, pool); err != nil {
+			return fmt.Errorf("calling Get %s: %w", endpoint, err)
+		}
+		desired := pool.DesiredNodes
+
+		batch := min(maxUnavailable, len(drifted), pool.MaxNodes-desired)
+		if batch < 1 {
+			return fmt.Errorf("can't surge nodepool %s above its %d max_nodes", d.Id(), pool.MaxNodes)
+		}
+
+		surged := desired + batch
+		log.Printf("[DEBUG] Will surge nodepool %s to %d nodes", d.Id(), surged)
+		if err := config.OVHClient.PutWithContext(ctx, endpoint, &CloudProjectKubeNodePoolUpdateOpts{DesiredNodes: &surged}, nil); err != nil {
+			return fmt.Errorf("calling Put %s: %w", endpoint, err)
+		}
+		if err := waitForCloudProjectKubeNodePoolWithStateTarget(config.OVHClient, serviceName, kubeId, d.Id(), timeout, []string{"READY"}); err != nil {
+			return fmt.Errorf("timeout while waiting nodepool %s to be READY: %w", d.Id(), err)
+		}
+
+		for _, node := range drifted[:batch] {
+			log.Printf("[DEBUG] Will replace node %s of nodepool %s", node.Name, d.Id())
+			if err := kubeClient.SetUnschedulable(ctx, node.Name, true); err != nil {
+				return fmt.Errorf("cordoning node %s: %w", node.Name, err)
+			}
+
+			if drain {
+				if _, err := cloudProjectKubeNodeDrain(ctx, kubeClient, node.Name, drainTimeout); err != nil {
+					return fmt.Errorf("draining node %s: %w", node.Name, err)
+				}
+			}
+
+			nodeEndpoint := fmt.Sprintf("/cloud/project/%s/kube/%s/node/%s",
+				url.PathEscape(serviceName),
+				url.PathEscape(kubeId),
+				url.PathEscape(node.Id),
+			)
+			if err := config.OVHClient.DeleteWithContext(ctx, nodeEndpoint, nil); err != nil {
+				return fmt.Errorf("calling Delete %s: %w", nodeEndpoint, err)
+			}
+			if err := waitForCloudProjectKubeNodeDeleted(ctx, config, nodeEndpoint, timeout); err != nil {
+				return fmt.Errorf("waiting for node %s to be deleted: %w", node.Name, err)
+			}
+		}
+
+		if err := waitForCloudProjectKubeNodePoolWithStateTarget(config.OVHClient, serviceName, kubeId, d.Id(), timeout, []string{"READY"}); err != nil {
+			return fmt.Errorf("timeout while waiting nodepool %s to be READY: %w", d.Id(), err)
+		}
+
+		// Deleting a node decrements the desired nodes of its pool, make sure
+		// the pool is back to its size before the surge anyway
+		if err := config.OVHClient.GetWithContext(ctx, endpoint, pool); err != nil {
+			return fmt.Errorf("calling Get %s: %w", endpoint, err)
+		}
+		if pool.DesiredNodes != desired {
+			log.Printf("[DEBUG] Will scale nodepool %s back to %d nodes", d.Id(), desired)
+			if err := config.OVHClient.PutWithContext(ctx, endpoint, &CloudProjectKubeNodePoolUpdateOpts{DesiredNodes: &desired}, nil); err != nil {
+				return fmt.Errorf("calling Put %s: %w", endpoint, err)
+			}
+			if err := waitForCloudProjectKubeNodePoolWithStateTarget(config.OVHClient, serviceName, kubeId, d.Id(), timeout, []string{"READY"}); err != nil {
+				return fmt.Errorf("timeout while waiting nodepool %s to be READY: %w", d.Id(), err)
+			}
+		}
+
+		drifted = drifted[batch:]
+	}
+
+	// The new nodes are expected to be created from the template
+	remaining, err := cloudProjectKubeNodePoolDriftedNodes(ctx, config, serviceName, kubeId, d.Id(), template, previous)
+	if err != nil {
+		return err
+	}
+	if len(remaining) > 0 {
+		names := make([]string, 0, len(remaining))
+		for _, node := range remaining {
+			names = append(names, node.Name)
+		}
+		return fmt.Errorf("nodes %s still don't match the template after the rollout", strings.Join(names, ", "))
+	}
+
+	return nil
+}
@@ -1097,3 +1097,157 @@ func TestAccCloudProjectKubeNodePoolTaints(t *testing.T) {
 		},
 	})
 }
+
+var testAccCloudProjectKubeNodePoolConfigTemplateRollout = `
+resource "ovh_cloud_project_kube" "cluster" {
+  service_name = "%s"
+  name         = "%s"
+  region       = "%s"
+  version      = "%s"
+}
+
+resource "ovh_cloud_project_kube_nodepool" "pool" {
+  service_name  = ovh_cloud_project_kube.cluster.service_name
+  kube_id       = ovh_cloud_project_kube.cluster.id
+  name          = ovh_cloud_project_kube.cluster.name
+  flavor_name   = "c3-4"
+  desired_nodes = 1
+  min_nodes     = 0
+  max_nodes     = 2
+  autoscale     = false
+  template {
+    metadata {
+      annotations = {}
+      finalizers  = []
+      labels = {
+        role = "%s"
+      }
+    }
+    spec {
+      unschedulable = false
+      taints = [
+        {
+          effect = "NoSchedule"
+          key    = "role"
+          value  = "%s"
+        }
+      ]
+    }
+  }
+  template_rollout {
+    max_unavailable = 1
+    drain_timeout   = "10m"
+  }
+}
+`
+
+func TestAccCloudProjectKubeNodePoolTemplateRollout(t *testing.T) {
+	name := acctest.RandomWithPrefix(test_prefix)
+	region := os.Getenv("OVH_CLOUD_PROJECT_KUBE_REGION_TEST")
+	version := os.Getenv("OVH_CLOUD_PROJECT_KUBE_VERSION_TEST")
+	resourceName := "ovh_cloud_project_kube_nodepool.pool"
+
+	config := func(role string) string {
+		return fmt.Sprintf(
+			testAccCloudProjectKubeNodePoolConfigTemplateRollout,
+			os.Getenv("OVH_CLOUD_PROJECT_SERVICE_TEST"),
+			name,
+			region,
+			version,
+			role,
+			role,
+		)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckCloud(t)
+			testAccCheckCloudProjectExists(t)
+			testAccPreCheckKubernetes(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: config("front"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "template_rollout.0.max_unavailable", "1"),
+					resource.TestCheckResourceAttr(resourceName, "template_rollout.0.drain", "true"),
+					resource.TestCheckResourceAttr(resourceName, "template_drifted_nodes.#", "0"),
+				),
+			},
+			{
+				// The existing node keeps the previous label and taint, it is replaced
+				Config: config("back"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "template.0.metadata.0.labels.role", "back"),
+					resource.TestCheckResourceAttr(resourceName, "desired_nodes", "1"),
+					resource.TestCheckResourceAttr(resourceName, "template_drifted_nodes.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestCloudProjectKubeNodeTemplateDrift(t *testing.T) {
+	newNode := func(labels map[string]string, taints ...string) *kubeNode {
+		node := &kubeNode{}
+		node.Metadata.Name = "node"
+		node.Metadata.Labels = labels
+		node.Metadata.Annotations = map[string]string{"node.alpha.kubernetes.io/ttl": "0"}
+		for _, taint := range taints {
+			parts := strings.SplitN(taint, ":", 3)
+			node.Spec.Taints = append(node.Spec.Taints, kubeTaint{Key: parts[0], Value: parts[1], Effect: parts[2]})
+		}
+		return node
+	}
+	newTemplate := func(labels map[string]string, taints ...Taint) *CloudProjectKubeNodePoolTemplate {
+		template := &CloudProjectKubeNodePoolTemplate{}
+		template.Metadata.Labels = labels
+		template.Spec.Taints = taints
+		return template
+	}
+
+	tests := []struct {
+		name     string
+		node     *kubeNode
+		template *CloudProjectKubeNodePoolTemplate
+		previous *CloudProjectKubeNodePoolTemplate
+		want     []string
+	}{
+		{
+			name:     "matching node with extra kubernetes labels",
+			node:     newNode(map[string]string{"role": "front", "kubernetes.io/os": "linux"}, "role:front:NoSchedule"),
+			template: newTemplate(map[string]string{"role": "front"}, Taint{Key: "role", Value: "front", Effect: NoSchedule}),
+			want:     []string{},
+		},
+		{
+			name:     "changed label and taint",
+			node:     newNode(map[string]string{"role": "front"}, "role:front:NoSchedule"),
+			template: newTemplate(map[string]string{"role": "back"}, Taint{Key: "role", Value: "back", Effect: NoSchedule}),
+			want:     []string{"label role=back missing", "taint role=back:NoSchedule missing"},
+		},
+		{
+			name:     "taint removed from template",
+			node:     newNode(map[string]string{}, "dedicated:gpu:NoExecute"),
+			template: newTemplate(map[string]string{}),
+			previous: newTemplate(map[string]string{}, Taint{Key: "dedicated", Value: "gpu", Effect: NoExecute}),
+			want:     []string{"taint dedicated=gpu:NoExecute removed from template"},
+		},
+		{
+			name:     "label removed from template already gone",
+			node:     newNode(map[string]string{}),
+			template: newTemplate(map[string]string{}),
+			previous: newTemplate(map[string]string{"role": "front"}),
+			want:     []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := cloudProjectKubeNodeTemplateDrift(tt.node, tt.template, tt.previous)
+			if strings.Join(got, "|") != strings.Join(tt.want, "|") {
+				t.Errorf("cloudProjectKubeNodeTemplateDrift() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"log"
 	"math/rand/v2"
 	"os"
+	"reflect"
 	"regexp"
 	"strconv"
 	"strings"
@@ -1290,6 +1291,57 @@ func TestAccCloudProjectKube_basic(t *testing.T) {
 // check some properties
 // update cluster name
 // check some properties && cluster updated name
+var testAccCloudProjectKubeKubeconfigRotationConfig = `
+resource "ovh_cloud_project_kube" "cluster" {
+	service_name        = "%s"
+	name                = "%s"
+	region              = "%s"
+	kubeconfig_rotation = "%s"
+}
+`
+
+func TestAccCloudProjectKubeKubeconfigRotation_basic(t *testing.T) {
+	name := acctest.RandomWithPrefix(test_prefix)
+	region := os.Getenv("OVH_CLOUD_PROJECT_KUBE_REGION_TEST")
+	serviceName := os.Getenv("OVH_CLOUD_PROJECT_SERVICE_TEST")
+
+	var clientKey string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckCloud(t)
+			testAccCheckCloudProjectExists(t)
+			testAccPreCheckKubernetes(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccCloudProjectKubeKubeconfigRotationConfig, serviceName, name, region, "2024-Q1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ovh_cloud_project_kube.cluster", "kubeconfig_rotation", "2024-Q1"),
+					resource.TestCheckResourceAttrWith("ovh_cloud_project_kube.cluster", "kubeconfig_attributes.0.client_key", func(value string) error {
+						clientKey = value
+						return nil
+					}),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccCloudProjectKubeKubeconfigRotationConfig, serviceName, name, region, "2024-Q2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ovh_cloud_project_kube.cluster", "kubeconfig_rotation", "2024-Q2"),
+					resource.TestCheckResourceAttr("ovh_cloud_project_kube.cluster", "status", "READY"),
+					resource.TestCheckResourceAttrWith("ovh_cloud_project_kube.cluster", "kubeconfig_attributes.0.client_key", func(value string) error {
+						if value == clientKey {
+							return fmt.Errorf("client key was not rotated")
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
+
 func TestAccCloudProjectKubeEmptyVersion_basic(t *testing.T) {
 	region := os.Getenv("OVH_CLOUD_PROJECT_KUBE_REGION_TEST")
 
@@ -1447,6 +1499,35 @@ func TestAccCloudProjectKubeUpdateVersion_basic(t *testing.T) {
 	})
 }
 
+func TestCloudProjectKubeUpgradeSteps(t *testing.T) {
+	tests := []struct {
+		name    string
+		from    string
+		to      string
+		want    []string
+		wantErr bool
+	}{
+		{name: "next minor", from: "1.30", to: "1.31", want: []string{"1.31"}},
+		{name: "several minors", from: "1.29", to: "1.32", want: []string{"1.30", "1.31", "1.32"}},
+		{name: "same minor", from: "1.30", to: "1.30", want: nil},
+		{name: "downgrade", from: "1.31", to: "1.30", wantErr: true},
+		{name: "major", from: "1.31", to: "2.0", wantErr: true},
+		{name: "not a version", from: "1.31", to: "latest", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := cloudProjectKubeUpgradeSteps(tt.from, tt.to)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("cloudProjectKubeUpgradeSteps() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("cloudProjectKubeUpgradeSteps() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestCustomIPVSIPTablesSchemaSetFunc(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -2368,3 +2449,23 @@ func TestAccCloudProjectKubeCustomizationCiliumFull(t *testing.T) {
 		},
 	})
 }
+
+func TestCloudProjectKubeControlPlaneUpgraded(t *testing.T) {
+	tests := []struct {
+		name string
+		kube CloudProjectKubeResponse
+		want bool
+	}{
+		{name: "patch version of the target", kube: CloudProjectKubeResponse{Version: "1.31.2-0", ControlPlaneIsUpToDate: true}, want: true},
+		{name: "previous minor", kube: CloudProjectKubeResponse{Version: "1.30.9-2", ControlPlaneIsUpToDate: true}, want: false},
+		{name: "control plane not up to date", kube: CloudProjectKubeResponse{Version: "1.31.2-0", ControlPlaneIsUpToDate: false}, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := cloudProjectKubeControlPlaneUpgraded(&tt.kube, "1.31"); got != tt.want {
+				t.Errorf("cloudProjectKubeControlPlaneUpgraded() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,218 @@
+package ovh
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/ovh/go-ovh/ovh"
+	"github.com/ovh/terraform-provider-ovh/v2/ovh/helpers"
+	ovhtypes "github.com/ovh/terraform-provider-ovh/v2/ovh/types"
+)
+
+var _ resource.ResourceWithConfigure = (*cloudProjectRancherAdminCredentialsResource)(nil)
+
+func NewCloudProjectRancherAdminCredentialsResource() resource.Resource {
+	return &cloudProjectRancherAdminCredentialsResource{}
+}
+
+type cloudProjectRancherAdminCredentialsResource struct {
+	config *Config
+}
+
+func (r *cloudProjectRancherAdminCredentialsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cloud_project_rancher_admin_credentials"
+}
+
+func (r *cloudProjectRancherAdminCredentialsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.config = config
+}
+
+func (r *cloudProjectRancherAdminCredentialsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reset the credentials of the local administrator of a managed Rancher",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				CustomType:  ovhtypes.TfStringType{},
+				Computed:    true,
+				Description: "Unique identifier for the resource",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Required:            true,
+				Description:         "Project ID",
+				MarkdownDescription: "Project ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"rancher_id": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Required:            true,
+				Description:         "Managed Rancher ID",
+				MarkdownDescription: "Managed Rancher ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				Description:         "Arbitrary map of values that, when changed, resets the credentials again",
+				MarkdownDescription: "Arbitrary map of values that, when changed, resets the credentials again",
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"username": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Computed:            true,
+				Description:         "Username of the local administrator",
+				MarkdownDescription: "Username of the local administrator",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"password": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Computed:            true,
+				Sensitive:           true,
+				Description:         "New password of the local administrator",
+				MarkdownDescription: "New password of the local administrator",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"api_token": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Computed:            true,
+				Sensitive:           true,
+				Description:         "API token of the local administrator, revoked when the resource is destroyed. Its lifetime is bounded by the auth-token-max-ttl-minutes setting of the Rancher",
+				MarkdownDescription: "API token of the local administrator, revoked when the resource is destroyed. Its lifetime is bounded by the `auth-token-max-ttl-minutes` setting of the Rancher",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *cloudProjectRancherAdminCredentialsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data cloudProjectRancherAdminCredentialsModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	credentials := struct {
+		Password string `json:"password"`
+	}{}
+
+	rancherEndpoint := cloudProjectRancherEndpoint(data.ProjectId.ValueString(), data.RancherId.ValueString())
+	endpoint := rancherEndpoint + "/adminCredentials"
+	if err := r.config.OVHClient.PostWithContext(ctx, endpoint, nil, &credentials); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error calling Post %s", endpoint), err.Error())
+		return
+	}
+
+	// The password is applied asynchronously on the Rancher
+	if err := helpers.WaitForAPIv2ResourceStatusReady(ctx, r.config.OVHClient, rancherEndpoint); err != nil {
+		resp.Diagnostics.AddError("Error waiting for resource to be ready", err.Error())
+		return
+	}
+
+	// Log in once, the resources managed through the Rancher API are given
+	// the token rather than the password
+	client, err := newRancherApiClient(ctx, r.config, data.ProjectId.ValueString(), data.RancherId.ValueString(), "")
+	if err != nil {
+		resp.Diagnostics.AddError("Error connecting to the Rancher API", err.Error())
+		return
+	}
+
+	token, err := client.Login(ctx, credentials.Password)
+	if err != nil {
+		resp.Diagnostics.AddError("Error logging in the Rancher API", err.Error())
+		return
+	}
+
+	data.ID = data.RancherId
+	data.Username = ovhtypes.NewTfStringValue(rancherAdminUsername)
+	data.Password = ovhtypes.NewTfStringValue(credentials.Password)
+	data.ApiToken = ovhtypes.NewTfStringValue(token)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *cloudProjectRancherAdminCredentialsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data cloudProjectRancherAdminCredentialsModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The password can't be read back, only check that the Rancher still exists
+	endpoint := cloudProjectRancherEndpoint(data.ProjectId.ValueString(), data.RancherId.ValueString())
+	if err := r.config.OVHClient.GetWithContext(ctx, endpoint, nil); err != nil {
+		if errOvh, ok := err.(*ovh.APIError); ok && errOvh.Code == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(fmt.Sprintf("Error calling Get %s", endpoint), err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *cloudProjectRancherAdminCredentialsResource) Update(_ context.Context, _ resource.UpdateRequest, _ *resource.UpdateResponse) {
+	// No update on API side, all the arguments require a replacement
+}
+
+func (r *cloudProjectRancherAdminCredentialsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data cloudProjectRancherAdminCredentialsModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A credentials reset can't be reverted, only the API token is revoked
+	if data.ApiToken.ValueString() == "" {
+		return
+	}
+
+	client, err := newRancherApiClient(ctx, r.config, data.ProjectId.ValueString(), data.RancherId.ValueString(), data.ApiToken.ValueString())
+	if err != nil {
+		if !isRancherNotFound(err) {
+			tflog.Warn(ctx, fmt.Sprintf("failed to revoke the API token of rancher %s: %s", data.RancherId.ValueString(), err))
+		}
+		return
+	}
+
+	client.Logout(ctx)
+}
@@ -0,0 +1,50 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccCloudProjectRancherAdminCredentials_basic(t *testing.T) {
+	projectID := os.Getenv("OVH_CLOUD_PROJECT_SERVICE_TEST")
+	rancherID := os.Getenv("OVH_CLOUD_PROJECT_RANCHER_TEST")
+
+	config := `
+	resource "ovh_cloud_project_rancher_admin_credentials" "admin" {
+		project_id = "%s"
+		rancher_id = "%s"
+		triggers = {
+			rotation = "%s"
+		}
+	}
+	`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckRancher(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(config, projectID, rancherID, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ovh_cloud_project_rancher_admin_credentials.admin", "rancher_id", rancherID),
+					resource.TestCheckResourceAttr("ovh_cloud_project_rancher_admin_credentials.admin", "username", "admin"),
+					resource.TestCheckResourceAttrSet("ovh_cloud_project_rancher_admin_credentials.admin", "password"),
+					resource.TestCheckResourceAttrSet("ovh_cloud_project_rancher_admin_credentials.admin", "api_token"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(config, projectID, rancherID, "2"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("ovh_cloud_project_rancher_admin_credentials.admin", plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.TestCheckResourceAttrSet("ovh_cloud_project_rancher_admin_credentials.admin", "password"),
+			},
+		},
+	})
+}
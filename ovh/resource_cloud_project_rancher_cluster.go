@@ -0,0 +1,276 @@
+package ovh
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	ovhtypes "github.com/ovh/terraform-provider-ovh/v2/ovh/types"
+)
+
+// rancherClusterFieldManager is the field manager of the objects of the
+// Rancher agent applied on the imported clusters
+const rancherClusterFieldManager = "terraform-provider-ovh"
+
+var _ resource.ResourceWithConfigure = (*cloudProjectRancherClusterResource)(nil)
+
+func NewCloudProjectRancherClusterResource() resource.Resource {
+	return &cloudProjectRancherClusterResource{}
+}
+
+type cloudProjectRancherClusterResource struct {
+	config *Config
+}
+
+func (r *cloudProjectRancherClusterResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cloud_project_rancher_cluster"
+}
+
+func (r *cloudProjectRancherClusterResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.config = config
+}
+
+func (r *cloudProjectRancherClusterResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Import a Managed Kubernetes Service cluster into a managed Rancher",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				CustomType:  ovhtypes.TfStringType{},
+				Computed:    true,
+				Description: "ID of the cluster in Rancher",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Required:            true,
+				Description:         "Project ID of the managed Rancher and the Kubernetes cluster",
+				MarkdownDescription: "Project ID of the managed Rancher and the Kubernetes cluster",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"rancher_id": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Required:            true,
+				Description:         "Managed Rancher ID",
+				MarkdownDescription: "Managed Rancher ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"api_token": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Required:            true,
+				Sensitive:           true,
+				Description:         "API token used to call the Rancher API, such as the api_token of ovh_cloud_project_rancher_admin_credentials",
+				MarkdownDescription: "API token used to call the Rancher API, such as the `api_token` of `ovh_cloud_project_rancher_admin_credentials`",
+			},
+			"kube_id": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Required:            true,
+				Description:         "ID of the Managed Kubernetes Service cluster to import",
+				MarkdownDescription: "ID of the Managed Kubernetes Service cluster to import",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Required:            true,
+				Description:         "Name of the cluster in Rancher",
+				MarkdownDescription: "Name of the cluster in Rancher",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"state": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Computed:            true,
+				Description:         "State of the cluster in Rancher",
+				MarkdownDescription: "State of the cluster in Rancher",
+			},
+		},
+	}
+}
+
+func (r *cloudProjectRancherClusterResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data cloudProjectRancherClusterModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := newRancherApiClient(ctx, r.config, data.ProjectId.ValueString(), data.RancherId.ValueString(), data.ApiToken.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error connecting to the Rancher API", err.Error())
+		return
+	}
+
+	cluster, err := client.CreateCluster(ctx, data.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating cluster in Rancher", err.Error())
+		return
+	}
+
+	data.ID = ovhtypes.NewTfStringValue(cluster.ID)
+	data.State = ovhtypes.NewTfStringValue(cluster.State)
+
+	// From now on the cluster exists in Rancher, keep it in the state so that
+	// a failed import gets tainted and cleaned up
+	if err := r.register(ctx, client, &data); err != nil {
+		resp.Diagnostics.AddError("Error importing cluster in Rancher", err.Error())
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// register deploys the Rancher agent on the Kubernetes cluster and waits for
+// the cluster to be active in Rancher
+func (r *cloudProjectRancherClusterResource) register(ctx context.Context, client *rancherApiClient, data *cloudProjectRancherClusterModel) error {
+	var manifestURL string
+	err := retry.RetryContext(ctx, 10*time.Minute, func() *retry.RetryError {
+		var err error
+		manifestURL, err = client.ClusterManifestURL(ctx, data.ID.ValueString())
+		if err != nil {
+			return retry.NonRetryableError(err)
+		}
+		if manifestURL == "" {
+			return retry.RetryableError(errors.New("waiting for the registration manifest to be generated"))
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	manifest, err := client.download(ctx, manifestURL)
+	if err != nil {
+		return fmt.Errorf("downloading registration manifest: %w", err)
+	}
+
+	kubeconfig, err := getKubeconfig(r.config, data.ProjectId.ValueString(), data.KubeId.ValueString())
+	if err != nil {
+		return fmt.Errorf("getting kubeconfig of cluster %s: %w", data.KubeId.ValueString(), err)
+	}
+
+	kubeClient, err := newKubeApiClient(kubeconfig)
+	if err != nil {
+		return err
+	}
+
+	if err := kubeClient.Apply(ctx, manifest, rancherClusterFieldManager); err != nil {
+		return fmt.Errorf("deploying Rancher agent: %w", err)
+	}
+
+	return retry.RetryContext(ctx, 30*time.Minute, func() *retry.RetryError {
+		cluster, err := client.Cluster(ctx, data.ID.ValueString())
+		if err != nil {
+			return retry.NonRetryableError(err)
+		}
+
+		data.State = ovhtypes.NewTfStringValue(cluster.State)
+		if cluster.State != "active" {
+			return retry.RetryableError(fmt.Errorf("waiting for cluster to be active (current state: %s)", cluster.State))
+		}
+		return nil
+	})
+}
+
+func (r *cloudProjectRancherClusterResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data cloudProjectRancherClusterModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := newRancherApiClient(ctx, r.config, data.ProjectId.ValueString(), data.RancherId.ValueString(), data.ApiToken.ValueString())
+	if err != nil {
+		if isRancherNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error connecting to the Rancher API", err.Error())
+		return
+	}
+
+	cluster, err := client.Cluster(ctx, data.ID.ValueString())
+	if err != nil {
+		if isRancherNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading cluster in Rancher", err.Error())
+		return
+	}
+
+	data.Name = ovhtypes.NewTfStringValue(cluster.Name)
+	data.State = ovhtypes.NewTfStringValue(cluster.State)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *cloudProjectRancherClusterResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, stateData cloudProjectRancherClusterModel
+
+	// Only the API token can be updated, it is used by the provider
+	// to call the Rancher API and is not sent to it
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &stateData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.State = stateData.State
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *cloudProjectRancherClusterResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data cloudProjectRancherClusterModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := newRancherApiClient(ctx, r.config, data.ProjectId.ValueString(), data.RancherId.ValueString(), data.ApiToken.ValueString())
+	if err != nil {
+		if isRancherNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError("Error connecting to the Rancher API", err.Error())
+		return
+	}
+
+	// Rancher removes its agent from the Kubernetes cluster by itself
+	if err := client.DeleteCluster(ctx, data.ID.ValueString()); err != nil && !isRancherNotFound(err) {
+		resp.Diagnostics.AddError("Error deleting cluster in Rancher", err.Error())
+	}
+}
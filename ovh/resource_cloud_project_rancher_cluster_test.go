@@ -0,0 +1,62 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccCloudProjectRancherCluster_basic(t *testing.T) {
+	projectID := os.Getenv("OVH_CLOUD_PROJECT_SERVICE_TEST")
+	rancherID := os.Getenv("OVH_CLOUD_PROJECT_RANCHER_TEST")
+	region := os.Getenv("OVH_CLOUD_PROJECT_KUBE_REGION_TEST")
+	name := acctest.RandomWithPrefix(test_prefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckRancher(t)
+			checkEnvOrSkip(t, "OVH_CLOUD_PROJECT_KUBE_REGION_TEST")
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+				resource "ovh_cloud_project_kube" "cluster" {
+					service_name = "%[1]s"
+					name         = "%[3]s"
+					region       = "%[4]s"
+				}
+
+				resource "ovh_cloud_project_kube_nodepool" "pool" {
+					service_name  = ovh_cloud_project_kube.cluster.service_name
+					kube_id       = ovh_cloud_project_kube.cluster.id
+					name          = "pool"
+					flavor_name   = "b3-8"
+					desired_nodes = 1
+				}
+
+				resource "ovh_cloud_project_rancher_admin_credentials" "admin" {
+					project_id = "%[1]s"
+					rancher_id = "%[2]s"
+				}
+
+				resource "ovh_cloud_project_rancher_cluster" "cluster" {
+					project_id = ovh_cloud_project_rancher_admin_credentials.admin.project_id
+					rancher_id = ovh_cloud_project_rancher_admin_credentials.admin.rancher_id
+					api_token  = ovh_cloud_project_rancher_admin_credentials.admin.api_token
+					kube_id    = ovh_cloud_project_kube_nodepool.pool.kube_id
+					name       = "%[3]s"
+				}
+				`, projectID, rancherID, name, region),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("ovh_cloud_project_rancher_cluster.cluster", "id"),
+					resource.TestCheckResourceAttr("ovh_cloud_project_rancher_cluster.cluster", "name", name),
+					resource.TestCheckResourceAttr("ovh_cloud_project_rancher_cluster.cluster", "state", "active"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,198 @@
+package ovh
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/ovh/go-ovh/ovh"
+)
+
+// rancherAdminUsername is the name of the local administrator of a managed Rancher
+const rancherAdminUsername = "admin"
+
+// cloudProjectRancherEndpoint returns the APIv2 endpoint of a managed Rancher
+func cloudProjectRancherEndpoint(projectID, rancherID string) string {
+	return "/v2/publicCloud/project/" + url.PathEscape(projectID) + "/rancher/" + url.PathEscape(rancherID)
+}
+
+// rancherApiClient is a minimal client of the API of a managed Rancher,
+// authenticated as its local administrator
+type rancherApiClient struct {
+	*jsonApiClient
+}
+
+// isRancherNotFound returns whether the error is a 404 of the Rancher API,
+// or of the OVHcloud API when the managed Rancher itself is gone
+func isRancherNotFound(err error) bool {
+	if isJsonApiNotFound(err) {
+		return true
+	}
+
+	var ovhErr *ovh.APIError
+	return errors.As(err, &ovhErr) && ovhErr.Code == http.StatusNotFound
+}
+
+// newRancherApiClient builds a client of the API of a managed Rancher authenticated
+// with an API token. Without token, the client can only log in.
+func newRancherApiClient(ctx context.Context, config *Config, projectID, rancherID, token string) (*rancherApiClient, error) {
+	rancher := struct {
+		CurrentState struct {
+			Url string `json:"url"`
+		} `json:"currentState"`
+	}{}
+
+	endpoint := cloudProjectRancherEndpoint(projectID, rancherID)
+	if err := config.OVHClient.GetWithContext(ctx, endpoint, &rancher); err != nil {
+		return nil, fmt.Errorf("calling Get %s: %w", endpoint, err)
+	}
+	if rancher.CurrentState.Url == "" {
+		return nil, fmt.Errorf("rancher %s has no URL yet", rancherID)
+	}
+
+	c := &rancherApiClient{newJsonApiClient("rancher", strings.TrimSuffix(rancher.CurrentState.Url, "/"), nil)}
+
+	if token != "" {
+		c.header.Set("Authorization", "Bearer "+token)
+	}
+
+	return c, nil
+}
+
+// Login logs in as the local administrator and returns the API token of the
+// session. The token has no TTL of its own, its lifetime is bounded by the
+// auth-token-max-ttl-minutes setting of the Rancher.
+func (c *rancherApiClient) Login(ctx context.Context, password string) (string, error) {
+	login := map[string]interface{}{
+		"username":     rancherAdminUsername,
+		"password":     password,
+		"responseType": "json",
+		"description":  "Terraform provider OVH",
+		"ttl":          0,
+	}
+	token := struct {
+		Token string `json:"token"`
+	}{}
+
+	if err := c.do(ctx, http.MethodPost, "/v3-public/localProviders/local?action=login", "", login, &token); err != nil {
+		return "", err
+	}
+
+	return token.Token, nil
+}
+
+// Logout revokes the API token of the client, a failure only leaves the token
+// to expire on its own
+func (c *rancherApiClient) Logout(ctx context.Context) {
+	if err := c.do(ctx, http.MethodPost, "/v3/tokens?action=logout", "", nil, nil); err != nil {
+		tflog.Warn(ctx, fmt.Sprintf("failed to log out of the Rancher API: %s", err))
+	}
+}
+
+type rancherCluster struct {
+	ID    string `json:"id"`
+	Name  string `json:"name"`
+	State string `json:"state"`
+}
+
+// CreateCluster creates an imported cluster waiting for its agent to register
+func (c *rancherApiClient) CreateCluster(ctx context.Context, name string) (*rancherCluster, error) {
+	cluster := &rancherCluster{}
+	in := map[string]string{
+		"type": "cluster",
+		"name": name,
+	}
+
+	if err := c.do(ctx, http.MethodPost, "/v3/clusters", "", in, cluster); err != nil {
+		return nil, err
+	}
+
+	return cluster, nil
+}
+
+// Cluster gets a cluster of the Rancher
+func (c *rancherApiClient) Cluster(ctx context.Context, id string) (*rancherCluster, error) {
+	cluster := &rancherCluster{}
+	if err := c.do(ctx, http.MethodGet, "/v3/clusters/"+url.PathEscape(id), "", nil, cluster); err != nil {
+		return nil, err
+	}
+
+	return cluster, nil
+}
+
+// DeleteCluster removes a cluster from the Rancher
+func (c *rancherApiClient) DeleteCluster(ctx context.Context, id string) error {
+	return c.do(ctx, http.MethodDelete, "/v3/clusters/"+url.PathEscape(id), "", nil, nil)
+}
+
+// ClusterManifestURL returns the URL of the manifest deploying the agent of a
+// cluster, creating a registration token if the cluster has none yet. An
+// empty URL is returned while Rancher is still generating it.
+func (c *rancherApiClient) ClusterManifestURL(ctx context.Context, clusterID string) (string, error) {
+	tokens := struct {
+		Data []struct {
+			ManifestUrl string `json:"manifestUrl"`
+		} `json:"data"`
+	}{}
+
+	if err := c.do(ctx, http.MethodGet, "/v3/clusterregistrationtokens?clusterId="+url.QueryEscape(clusterID), "", nil, &tokens); err != nil {
+		return "", err
+	}
+
+	for _, token := range tokens.Data {
+		if token.ManifestUrl != "" {
+			return token.ManifestUrl, nil
+		}
+	}
+
+	if len(tokens.Data) == 0 {
+		in := map[string]string{
+			"type":      "clusterRegistrationToken",
+			"clusterId": clusterID,
+		}
+		if err := c.do(ctx, http.MethodPost, "/v3/clusterregistrationtokens", "", in, nil); err != nil {
+			return "", err
+		}
+	}
+
+	return "", nil
+}
+
+type rancherGlobalRoleBinding struct {
+	ID               string `json:"id,omitempty"`
+	Type             string `json:"type,omitempty"`
+	GlobalRoleID     string `json:"globalRoleId"`
+	UserPrincipalID  string `json:"userPrincipalId,omitempty"`
+	GroupPrincipalID string `json:"groupPrincipalId,omitempty"`
+}
+
+// CreateGlobalRoleBinding grants a global role to a user or group principal
+func (c *rancherApiClient) CreateGlobalRoleBinding(ctx context.Context, binding *rancherGlobalRoleBinding) (*rancherGlobalRoleBinding, error) {
+	binding.Type = "globalRoleBinding"
+
+	res := &rancherGlobalRoleBinding{}
+	if err := c.do(ctx, http.MethodPost, "/v3/globalrolebindings", "", binding, res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// GlobalRoleBinding gets a global role binding of the Rancher
+func (c *rancherApiClient) GlobalRoleBinding(ctx context.Context, id string) (*rancherGlobalRoleBinding, error) {
+	res := &rancherGlobalRoleBinding{}
+	if err := c.do(ctx, http.MethodGet, "/v3/globalrolebindings/"+url.PathEscape(id), "", nil, res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// DeleteGlobalRoleBinding revokes a global role binding
+func (c *rancherApiClient) DeleteGlobalRoleBinding(ctx context.Context, id string) error {
+	return c.do(ctx, http.MethodDelete, "/v3/globalrolebindings/"+url.PathEscape(id), "", nil, nil)
+}
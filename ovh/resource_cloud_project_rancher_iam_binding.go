@@ -0,0 +1,219 @@
+package ovh
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	ovhtypes "github.com/ovh/terraform-provider-ovh/v2/ovh/types"
+)
+
+var _ resource.ResourceWithConfigure = (*cloudProjectRancherIamBindingResource)(nil)
+
+func NewCloudProjectRancherIamBindingResource() resource.Resource {
+	return &cloudProjectRancherIamBindingResource{}
+}
+
+type cloudProjectRancherIamBindingResource struct {
+	config *Config
+}
+
+func (r *cloudProjectRancherIamBindingResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cloud_project_rancher_iam_binding"
+}
+
+func (r *cloudProjectRancherIamBindingResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.config = config
+}
+
+func (r *cloudProjectRancherIamBindingResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Grant a Rancher global role to an OVHcloud IAM user or group of a managed Rancher",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				CustomType:  ovhtypes.TfStringType{},
+				Computed:    true,
+				Description: "ID of the global role binding in Rancher",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Required:            true,
+				Description:         "Project ID",
+				MarkdownDescription: "Project ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"rancher_id": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Required:            true,
+				Description:         "Managed Rancher ID",
+				MarkdownDescription: "Managed Rancher ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"api_token": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Required:            true,
+				Sensitive:           true,
+				Description:         "API token used to call the Rancher API, such as the api_token of ovh_cloud_project_rancher_admin_credentials",
+				MarkdownDescription: "API token used to call the Rancher API, such as the `api_token` of `ovh_cloud_project_rancher_admin_credentials`",
+			},
+			"principal_id": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Required:            true,
+				Description:         "Rancher principal of the IAM user or group (e.g. genericoidc_user://john.doe)",
+				MarkdownDescription: "Rancher principal of the IAM user or group (e.g. `genericoidc_user://john.doe`)",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"principal_type": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("user"),
+				Description:         "Type of the principal (user or group). Defaults to user",
+				MarkdownDescription: "Type of the principal (`user` or `group`). Defaults to `user`",
+				Validators: []validator.String{
+					stringvalidator.OneOf("user", "group"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"global_role_id": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Required:            true,
+				Description:         "Rancher global role granted to the principal (e.g. admin, restricted-admin, user, user-base)",
+				MarkdownDescription: "Rancher global role granted to the principal (e.g. `admin`, `restricted-admin`, `user`, `user-base`)",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *cloudProjectRancherIamBindingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data cloudProjectRancherIamBindingModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := newRancherApiClient(ctx, r.config, data.ProjectId.ValueString(), data.RancherId.ValueString(), data.ApiToken.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error connecting to the Rancher API", err.Error())
+		return
+	}
+
+	binding, err := client.CreateGlobalRoleBinding(ctx, data.toBinding())
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating global role binding", err.Error())
+		return
+	}
+
+	data.ID = ovhtypes.NewTfStringValue(binding.ID)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *cloudProjectRancherIamBindingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data cloudProjectRancherIamBindingModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := newRancherApiClient(ctx, r.config, data.ProjectId.ValueString(), data.RancherId.ValueString(), data.ApiToken.ValueString())
+	if err != nil {
+		if isRancherNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error connecting to the Rancher API", err.Error())
+		return
+	}
+
+	binding, err := client.GlobalRoleBinding(ctx, data.ID.ValueString())
+	if err != nil {
+		if isRancherNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading global role binding", err.Error())
+		return
+	}
+
+	data.GlobalRoleId = ovhtypes.NewTfStringValue(binding.GlobalRoleID)
+	if binding.GroupPrincipalID != "" {
+		data.PrincipalId = ovhtypes.NewTfStringValue(binding.GroupPrincipalID)
+		data.PrincipalType = ovhtypes.NewTfStringValue("group")
+	} else {
+		data.PrincipalId = ovhtypes.NewTfStringValue(binding.UserPrincipalID)
+		data.PrincipalType = ovhtypes.NewTfStringValue("user")
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *cloudProjectRancherIamBindingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data cloudProjectRancherIamBindingModel
+
+	// Only the API token can be updated, it is used by the provider
+	// to call the Rancher API and is not sent to it
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *cloudProjectRancherIamBindingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data cloudProjectRancherIamBindingModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := newRancherApiClient(ctx, r.config, data.ProjectId.ValueString(), data.RancherId.ValueString(), data.ApiToken.ValueString())
+	if err != nil {
+		if isRancherNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError("Error connecting to the Rancher API", err.Error())
+		return
+	}
+
+	if err := client.DeleteGlobalRoleBinding(ctx, data.ID.ValueString()); err != nil && !isRancherNotFound(err) {
+		resp.Diagnostics.AddError("Error deleting global role binding", err.Error())
+	}
+}
@@ -0,0 +1,44 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccCloudProjectRancherIamBinding_basic(t *testing.T) {
+	projectID := os.Getenv("OVH_CLOUD_PROJECT_SERVICE_TEST")
+	rancherID := os.Getenv("OVH_CLOUD_PROJECT_RANCHER_TEST")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckRancher(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+				resource "ovh_cloud_project_rancher_admin_credentials" "admin" {
+					project_id = "%s"
+					rancher_id = "%s"
+				}
+
+				resource "ovh_cloud_project_rancher_iam_binding" "ops" {
+					project_id     = ovh_cloud_project_rancher_admin_credentials.admin.project_id
+					rancher_id     = ovh_cloud_project_rancher_admin_credentials.admin.rancher_id
+					api_token      = ovh_cloud_project_rancher_admin_credentials.admin.api_token
+					principal_id   = "genericoidc_group://ops"
+					principal_type = "group"
+					global_role_id = "restricted-admin"
+				}
+				`, projectID, rancherID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("ovh_cloud_project_rancher_iam_binding.ops", "id"),
+					resource.TestCheckResourceAttr("ovh_cloud_project_rancher_iam_binding.ops", "principal_id", "genericoidc_group://ops"),
+					resource.TestCheckResourceAttr("ovh_cloud_project_rancher_iam_binding.ops", "principal_type", "group"),
+					resource.TestCheckResourceAttr("ovh_cloud_project_rancher_iam_binding.ops", "global_role_id", "restricted-admin"),
+				),
+			},
+		},
+	})
+}
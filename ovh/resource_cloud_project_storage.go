@@ -309,7 +309,7 @@ func (r *cloudProjectStorageResource) Delete(ctx context.Context, req resource.D
 		return
 	}
 
-	if err := r.deleteBucket(ctx, data.ServiceName.ValueString(), data.RegionName.ValueString(), data.Name.ValueString()); err != nil {
+	if err := cloudProjectStorageDeleteBucket(ctx, r.config, data.ServiceName.ValueString(), data.RegionName.ValueString(), data.Name.ValueString()); err != nil {
 		resp.Diagnostics.AddError(
 			"Error deleting bucket: "+data.Name.ValueString(),
 			err.Error(),
@@ -328,7 +328,7 @@ func (r *cloudProjectStorageResource) Delete(ctx context.Context, req resource.D
 
 		if destination.RemoveOnMainBucketDeletion.ValueBool() {
 			tflog.Info(ctx, fmt.Sprintf("removing replica bucket %s", destination.Name.ValueString()))
-			if err := r.deleteBucket(ctx, data.ServiceName.ValueString(), destination.Region.ValueString(), destination.Name.ValueString()); err != nil {
+			if err := cloudProjectStorageDeleteBucket(ctx, r.config, data.ServiceName.ValueString(), destination.Region.ValueString(), destination.Name.ValueString()); err != nil {
 				resp.Diagnostics.AddError(
 					fmt.Sprintf("Error removing replica %s", destination.Name.ValueString()),
 					err.Error(),
@@ -338,7 +338,8 @@ func (r *cloudProjectStorageResource) Delete(ctx context.Context, req resource.D
 	}
 }
 
-func (r *cloudProjectStorageResource) deleteBucket(ctx context.Context, serviceName, regionName, storageName string) error {
+// cloudProjectStorageDeleteBucket empties a bucket, including all the object versions, then deletes it
+func cloudProjectStorageDeleteBucket(ctx context.Context, config *Config, serviceName, regionName, storageName string) error {
 	endpoint := "/cloud/project/" + url.PathEscape(serviceName) +
 		"/region/" + url.PathEscape(regionName) +
 		"/storage/" + url.PathEscape(storageName) +
@@ -358,7 +359,7 @@ func (r *cloudProjectStorageResource) deleteBucket(ctx context.Context, serviceN
 			idsToDelete []map[string]string
 		)
 
-		if err := r.config.OVHClient.GetWithContext(ctx, endpoint, &objects); err != nil {
+		if err := config.OVHClient.GetWithContext(ctx, endpoint, &objects); err != nil {
 			return fmt.Errorf("error calling GET %s: %w", endpoint, err)
 		}
 
@@ -374,7 +375,7 @@ func (r *cloudProjectStorageResource) deleteBucket(ctx context.Context, serviceN
 		}
 
 		tflog.Info(ctx, fmt.Sprintf("removing objects %s", idsToDelete))
-		if err := r.config.OVHClient.PostWithContext(ctx, bulkDeleteEndpoint, map[string]any{
+		if err := config.OVHClient.PostWithContext(ctx, bulkDeleteEndpoint, map[string]any{
 			"objects": idsToDelete,
 		}, nil); err != nil {
 			return fmt.Errorf("error calling POST %s: %w", bulkDeleteEndpoint, err)
@@ -386,7 +387,7 @@ func (r *cloudProjectStorageResource) deleteBucket(ctx context.Context, serviceN
 		"/storage/" + url.PathEscape(storageName)
 
 	// Delete bucket itself
-	if err := r.config.OVHClient.DeleteWithContext(ctx, endpoint, nil); err != nil {
+	if err := config.OVHClient.DeleteWithContext(ctx, endpoint, nil); err != nil {
 		if ovhErr, ok := err.(*ovh.APIError); ok && ovhErr.Code == http.StatusNotFound {
 			// If bucket was already deleted, ignore the error
 			return nil
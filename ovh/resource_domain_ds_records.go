@@ -1,26 +1,30 @@
 package ovh
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net/url"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/ovh/terraform-provider-ovh/v2/ovh/helpers"
 )
 
 func resourceDomainDsRecords() *schema.Resource {
 	return &schema.Resource{
-		Description: "Resource to manage a domain name DS records",
-		Schema:      resourceDomainDsRecordsSchema(),
-		Create:      resourceDomainDsRecordsUpdate,
-		Read:        resourceDomainDsRecordsRead,
-		Update:      resourceDomainDsRecordsUpdate,
-		Delete:      resourceDomainDsRecordsDelete,
+		Description:   "Resource to manage a domain name DS records",
+		Schema:        resourceDomainDsRecordsSchema(),
+		CreateContext: resourceDomainDsRecordsUpdate,
+		Read:          resourceDomainDsRecordsRead,
+		UpdateContext: resourceDomainDsRecordsUpdate,
+		Delete:        resourceDomainDsRecordsDelete,
 		Importer: &schema.ResourceImporter{
 			State: func(resourceData *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
 				resourceData.Set("domain", resourceData.Id())
+				resourceData.Set("rollover_mode", DsRecordsRolloverModeImmediate)
+				resourceData.Set("rollover_wait", "24h")
 				return []*schema.ResourceData{resourceData}, nil
 			},
 		},
@@ -82,7 +86,42 @@ func resourceDomainDsRecordsSchema() map[string]*schema.Schema {
 			},
 			Required: true,
 			MinItems: 1,
-			MaxItems: 4,
+			MaxItems: dsRecordsMaxItems,
+		},
+		"rollover_mode": {
+			Type:         schema.TypeString,
+			Description:  "How DS records changes are applied: `immediate` replaces the records at once, `staged` publishes the new records alongside the old ones and removes the old ones once `rollover_wait` has elapsed",
+			Optional:     true,
+			Default:      DsRecordsRolloverModeImmediate,
+			ValidateFunc: helpers.ValidateEnum([]string{DsRecordsRolloverModeImmediate, DsRecordsRolloverModeStaged}),
+		},
+		"rollover_wait": {
+			Type:        schema.TypeString,
+			Description: "Minimum delay between the publication of the new DS records and the removal of the old ones in `staged` mode. Should cover the parent zone propagation and the DS records TTL",
+			Optional:    true,
+			Default:     "24h",
+			ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+				if _, err := time.ParseDuration(v.(string)); err != nil {
+					errors = append(errors, fmt.Errorf("field %q must be a valid duration: %s", k, err))
+				}
+				return
+			},
+		},
+		"rollover_phase": {
+			Type:        schema.TypeString,
+			Description: "Current phase of the key rollover (`stable` or `publishing`)",
+			Computed:    true,
+		},
+		"rollover_published_at": {
+			Type:        schema.TypeString,
+			Description: "Date at which the new DS records have been published alongside the old ones",
+			Computed:    true,
+		},
+		"rollover_pending_removal": {
+			Type:        schema.TypeList,
+			Description: "Tags of the DS records that will be removed once `rollover_wait` has elapsed",
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeInt},
 		},
 	}
 
@@ -122,22 +161,23 @@ func resourceDomainDsRecordsRead(resourceData *schema.ResourceData, meta interfa
 		resourceData.Set(k, v)
 	}
 
+	if resourceData.Get("rollover_phase").(string) == "" {
+		resourceData.Set("rollover_phase", DsRecordsRolloverPhaseStable)
+	}
+
 	return nil
 }
 
-func resourceDomainDsRecordsUpdate(resourceData *schema.ResourceData, meta interface{}) error {
+func resourceDomainDsRecordsUpdate(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 	domainName := resourceData.Get("domain").(string)
-	task := DomainTask{}
 
-	dsRecordsUpdate := &DomainDsRecordsUpdateOpts{
-		DsRecords: make([]DomainDsRecord, 0),
-	}
+	desired := make([]DomainDsRecord, 0)
 
 	for _, dsRecord := range resourceData.Get("ds_records").([]interface{}) {
 		record := dsRecord.(map[string]interface{})
 
-		dsRecordsUpdate.DsRecords = append(dsRecordsUpdate.DsRecords, DomainDsRecord{
+		desired = append(desired, DomainDsRecord{
 			Algorithm: DsRecordAlgorithmValuesMap[record["algorithm"].(string)],
 			Flags:     DsRecordFlagValuesMap[record["flags"].(string)],
 			PublicKey: record["public_key"].(string),
@@ -145,6 +185,121 @@ func resourceDomainDsRecordsUpdate(resourceData *schema.ResourceData, meta inter
 		})
 	}
 
+	resourceData.SetId(domainName)
+
+	if resourceData.Get("rollover_mode").(string) == DsRecordsRolloverModeStaged {
+		if err := resourceDomainDsRecordsStagedRollover(ctx, resourceData, config, desired); err != nil {
+			return diag.FromErr(err)
+		}
+
+		return diag.FromErr(resourceDomainDsRecordsRead(resourceData, meta))
+	}
+
+	if err := resourceDomainDsRecordsPost(config, domainName, desired); err != nil {
+		return diag.FromErr(err)
+	}
+
+	resourceDomainDsRecordsSetRolloverStable(resourceData)
+
+	return diag.FromErr(resourceDomainDsRecordsRead(resourceData, meta))
+}
+
+// resourceDomainDsRecordsStagedRollover applies the DS records in two phases. The new records are
+// first published alongside the old ones. Once rollover_wait has elapsed since their publication,
+// the old records are removed. If the remaining delay doesn't fit in the operation timeout, the
+// rollover phase is saved in the state and the removal happens during a subsequent apply.
+func resourceDomainDsRecordsStagedRollover(ctx context.Context, resourceData *schema.ResourceData, config *Config, desired []DomainDsRecord) error {
+	domainName := resourceData.Get("domain").(string)
+	start := time.Now()
+
+	current, err := domainDsRecordsList(config, domainName)
+	if err != nil {
+		return err
+	}
+
+	rollover := newDomainDsRecordsRollover(current, desired)
+	publishing := resourceData.Get("rollover_phase").(string) == DsRecordsRolloverPhasePublishing
+
+	// Nothing to phase out: new records can be published right away
+	if len(rollover.ToRemove) == 0 || (len(rollover.ToAdd) == 0 && !publishing) {
+		if err := resourceDomainDsRecordsPost(config, domainName, desired); err != nil {
+			return err
+		}
+
+		resourceDomainDsRecordsSetRolloverStable(resourceData)
+		return nil
+	}
+
+	wait, err := time.ParseDuration(resourceData.Get("rollover_wait").(string))
+	if err != nil {
+		return fmt.Errorf("cannot parse rollover_wait attribute: %s", err)
+	}
+
+	publishedAt, _ := time.Parse(time.RFC3339, resourceData.Get("rollover_published_at").(string))
+
+	// Publish the new records alongside the old ones
+	if !publishing || len(rollover.ToAdd) > 0 {
+		if len(rollover.Union) > dsRecordsMaxItems {
+			return fmt.Errorf("cannot publish %d DS records during the rollover of %s, at most %d records are allowed", len(rollover.Union), domainName, dsRecordsMaxItems)
+		}
+
+		log.Printf("[DEBUG] Will publish new DS records alongside the old ones for domain %s\n", domainName)
+
+		if err := resourceDomainDsRecordsPost(config, domainName, rollover.Union); err != nil {
+			return err
+		}
+
+		publishedAt = time.Now().UTC()
+		resourceData.Set("rollover_phase", DsRecordsRolloverPhasePublishing)
+		resourceData.Set("rollover_published_at", publishedAt.Format(time.RFC3339))
+		resourceData.Set("rollover_pending_removal", rollover.RemovedTags())
+	}
+
+	remaining := time.Until(publishedAt.Add(wait))
+	if remaining > 0 {
+		timeout := resourceData.Timeout(schema.TimeoutUpdate)
+		if resourceData.IsNewResource() {
+			timeout = resourceData.Timeout(schema.TimeoutCreate)
+		}
+
+		if remaining > timeout-time.Since(start) {
+			log.Printf("[INFO] DS records of domain %s will be removed after %s, apply again once this delay has elapsed\n", domainName, publishedAt.Add(wait).Format(time.RFC3339))
+			return nil
+		}
+
+		log.Printf("[DEBUG] Waiting %s before removing old DS records of domain %s\n", remaining, domainName)
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("interrupted while waiting to remove old DS records of domain %s, apply again to resume the rollover: %w", domainName, ctx.Err())
+		case <-time.After(remaining):
+		}
+	}
+
+	log.Printf("[DEBUG] Will remove old DS records for domain %s\n", domainName)
+
+	if err := resourceDomainDsRecordsPost(config, domainName, desired); err != nil {
+		return err
+	}
+
+	resourceDomainDsRecordsSetRolloverStable(resourceData)
+
+	return nil
+}
+
+func resourceDomainDsRecordsSetRolloverStable(resourceData *schema.ResourceData) {
+	resourceData.Set("rollover_phase", DsRecordsRolloverPhaseStable)
+	resourceData.Set("rollover_published_at", "")
+	resourceData.Set("rollover_pending_removal", []int{})
+}
+
+// resourceDomainDsRecordsPost replaces the DS records of the domain and waits for the domain task.
+func resourceDomainDsRecordsPost(config *Config, domainName string, records []DomainDsRecord) error {
+	task := DomainTask{}
+
+	dsRecordsUpdate := &DomainDsRecordsUpdateOpts{
+		DsRecords: records,
+	}
+
 	log.Printf("[DEBUG] Will update domain name DS records: %s\n", domainName)
 
 	endpoint := fmt.Sprintf("/domain/%s/dsRecord", url.PathEscape(domainName))
@@ -157,9 +312,31 @@ func resourceDomainDsRecordsUpdate(resourceData *schema.ResourceData, meta inter
 		return fmt.Errorf("waiting for %s DS records to be updated: %s", domainName, err.Error())
 	}
 
-	resourceData.SetId(domainName)
+	return nil
+}
+
+// domainDsRecordsList returns the DS records currently published for the domain.
+func domainDsRecordsList(config *Config, domainName string) ([]DomainDsRecord, error) {
+	var ids []int
+	endpoint := fmt.Sprintf("/domain/%s/dsRecord", url.PathEscape(domainName))
+
+	if err := config.OVHClient.Get(endpoint, &ids); err != nil {
+		return nil, fmt.Errorf("calling GET %s:\n\t %s", endpoint, err.Error())
+	}
+
+	records := make([]DomainDsRecord, 0, len(ids))
+	for _, dsRecordId := range ids {
+		record := DomainDsRecord{}
+		endpoint := fmt.Sprintf("/domain/%s/dsRecord/%d", url.PathEscape(domainName), dsRecordId)
+
+		if err := config.OVHClient.Get(endpoint, &record); err != nil {
+			return nil, fmt.Errorf("calling GET %s:\n\t %s", endpoint, err.Error())
+		}
+
+		records = append(records, record)
+	}
 
-	return resourceDomainDsRecordsRead(resourceData, meta)
+	return records, nil
 }
 
 func resourceDomainDsRecordsDelete(resourceData *schema.ResourceData, meta interface{}) error {
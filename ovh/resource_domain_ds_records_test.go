@@ -93,6 +93,46 @@ func TestAccDomainDsRecords_Basic(t *testing.T) {
 	})
 }
 
+func TestAccDomainDsRecords_StagedRollover(t *testing.T) {
+	domainName := os.Getenv("OVH_ZONE_TEST")
+	resourceName := "ovh_domain_ds_records.test"
+
+	recordAlgorithm := os.Getenv("OVH_DOMAIN_DS_RECORD_ALGORITHM_TEST")
+	recordFlags := "KEY_SIGNING_KEY"
+	recordPublicKey := os.Getenv("OVH_DOMAIN_DS_RECORD_PUBLIC_KEY_TEST")
+	recordTag, _ := strconv.ParseInt(os.Getenv("OVH_DOMAIN_DS_RECORD_TAG_TEST"), 10, 0)
+	newRecordPublicKey := os.Getenv("OVH_DOMAIN_DS_RECORD_ROLLOVER_PUBLIC_KEY_TEST")
+	newRecordTag, _ := strconv.ParseInt(os.Getenv("OVH_DOMAIN_DS_RECORD_ROLLOVER_TAG_TEST"), 10, 0)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckDomain(t)
+			checkEnvOrSkip(t, "OVH_DOMAIN_DS_RECORD_ROLLOVER_PUBLIC_KEY_TEST")
+			checkEnvOrSkip(t, "OVH_DOMAIN_DS_RECORD_ROLLOVER_TAG_TEST")
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckOvhDomainDsRecordsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckOvhDomainDsRecordsConfigStaged(domainName, recordAlgorithm, recordFlags, recordPublicKey, int(recordTag)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOvhDomainDsRecordsCurrent(resourceName, recordAlgorithm, recordFlags, recordPublicKey, int(recordTag)),
+					resource.TestCheckResourceAttr(resourceName, "rollover_mode", "staged"),
+					resource.TestCheckResourceAttr(resourceName, "rollover_phase", "stable"),
+				),
+			},
+			{
+				Config: testAccCheckOvhDomainDsRecordsConfigStaged(domainName, recordAlgorithm, recordFlags, newRecordPublicKey, int(newRecordTag)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOvhDomainDsRecordsCurrent(resourceName, recordAlgorithm, recordFlags, newRecordPublicKey, int(newRecordTag)),
+					resource.TestCheckResourceAttr(resourceName, "rollover_phase", "stable"),
+					resource.TestCheckResourceAttr(resourceName, "rollover_pending_removal.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckOvhDomainDsRecordsConfig_Invalid(domainName string) string {
 	return fmt.Sprintf(`
 resource "ovh_domain_ds_records" "invalid" {
@@ -197,3 +237,20 @@ func testAccCheckOvhDomainDsRecordsDestroy(s *terraform.State) error {
 
 	return nil
 }
+
+func testAccCheckOvhDomainDsRecordsConfigStaged(domainName string, recordAlgorithm string, recordFlags string, recordPublicKey string, recordTag int) string {
+	return fmt.Sprintf(`
+resource "ovh_domain_ds_records" "test" {
+	domain        = "%s"
+	rollover_mode = "staged"
+	rollover_wait = "1m"
+
+	ds_records {
+		algorithm  = "%s"
+		flags      = "%s"
+		public_key = "%s"
+		tag        = %d
+	}
+}
+`, domainName, recordAlgorithm, recordFlags, recordPublicKey, recordTag)
+}
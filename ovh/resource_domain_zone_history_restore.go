@@ -0,0 +1,188 @@
+package ovh
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/ovh/go-ovh/ovh"
+	ovhtypes "github.com/ovh/terraform-provider-ovh/v2/ovh/types"
+)
+
+var _ resource.ResourceWithConfigure = (*domainZoneHistoryRestoreResource)(nil)
+var _ resource.ResourceWithImportState = (*domainZoneHistoryRestoreResource)(nil)
+
+func NewDomainZoneHistoryRestoreResource() resource.Resource {
+	return &domainZoneHistoryRestoreResource{}
+}
+
+type domainZoneHistoryRestoreResource struct {
+	config *Config
+}
+
+func (r *domainZoneHistoryRestoreResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_domain_zone_history_restore"
+}
+
+func (r *domainZoneHistoryRestoreResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.config = config
+}
+
+func (r *domainZoneHistoryRestoreResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Restore a DNS zone to a given point of its history.",
+		MarkdownDescription: "Restore a DNS zone to a given point of its history.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				CustomType:  ovhtypes.TfStringType{},
+				Computed:    true,
+				Description: "Unique identifier for the resource",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"zone_name": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Required:            true,
+				Description:         "Zone name",
+				MarkdownDescription: "Zone name",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"creation_date": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Required:            true,
+				Description:         "Creation date of the history entry to restore, as returned by the `ovh_domain_zone_history` data source",
+				MarkdownDescription: "Creation date of the history entry to restore, as returned by the `ovh_domain_zone_history` data source",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"zone_file_url": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Computed:            true,
+				Description:         "URL to download the restored zone file",
+				MarkdownDescription: "URL to download the restored zone file",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *domainZoneHistoryRestoreResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	zoneName, creationDate, ok := strings.Cut(req.ID, "/")
+	if !ok || zoneName == "" || creationDate == "" {
+		resp.Diagnostics.AddError(
+			"Given ID is malformed",
+			"import ID must be formatted as zone_name/creation_date",
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("zone_name"), zoneName)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("creation_date"), creationDate)...)
+}
+
+func (r *domainZoneHistoryRestoreResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var (
+		data  domainZoneHistoryRestoreModel
+		point DomainZoneRestorePoint
+		task  DomainTask
+	)
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zoneName := data.ZoneName.ValueString()
+	entryEndpoint := "/domain/zone/" + url.PathEscape(zoneName) + "/history/" + url.PathEscape(data.CreationDate.ValueString())
+
+	// Make sure the history entry exists before restoring it
+	if err := r.config.OVHClient.GetWithContext(ctx, entryEndpoint, &point); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error calling Get %s", entryEndpoint), err.Error())
+		return
+	}
+
+	endpoint := entryEndpoint + "/restore"
+	if err := r.config.OVHClient.PostWithContext(ctx, endpoint, nil, &task); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error calling Post %s", endpoint), err.Error())
+		return
+	}
+
+	// Wait for restore task completion
+	if err := waitDNSTask(ctx, r.config, zoneName, task.TaskID); err != nil {
+		resp.Diagnostics.AddError("Error waiting for task completion", err.Error())
+		return
+	}
+
+	data.ID = ovhtypes.NewTfStringValue(zoneName + "/" + data.CreationDate.ValueString())
+	data.MergeWith(&point)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *domainZoneHistoryRestoreResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var (
+		data  domainZoneHistoryRestoreModel
+		point DomainZoneRestorePoint
+	)
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	endpoint := "/domain/zone/" + url.PathEscape(data.ZoneName.ValueString()) + "/history/" + url.PathEscape(data.CreationDate.ValueString())
+	if err := r.config.OVHClient.GetWithContext(ctx, endpoint, &point); err != nil {
+		// The history entry has expired, the restore can no longer be tracked
+		if errOvh, ok := err.(*ovh.APIError); ok && errOvh.Code == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(fmt.Sprintf("Error calling Get %s", endpoint), err.Error())
+		return
+	}
+
+	data.MergeWith(&point)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *domainZoneHistoryRestoreResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.AddError("update should never happen", "this code should be unreachable")
+}
+
+func (r *domainZoneHistoryRestoreResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// A restore cannot be undone: removing the resource only removes it from the state.
+	// To roll the zone forward again, restore a more recent history entry.
+}
@@ -0,0 +1,44 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDomainZoneHistoryRestore_basic(t *testing.T) {
+	zoneName := os.Getenv("OVH_ZONE_TEST")
+
+	config := fmt.Sprintf(`
+	data "ovh_domain_zone_history" "history" {
+		zone_name = "%s"
+	}
+
+	resource "ovh_domain_zone_history_restore" "restore" {
+		zone_name     = data.ovh_domain_zone_history.history.zone_name
+		creation_date = data.ovh_domain_zone_history.history.history[length(data.ovh_domain_zone_history.history.history) - 1].creation_date
+	}
+	`, zoneName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckDomain(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ovh_domain_zone_history_restore.restore", "zone_name", zoneName),
+					resource.TestCheckResourceAttrSet("ovh_domain_zone_history_restore.restore", "creation_date"),
+					resource.TestCheckResourceAttrSet("ovh_domain_zone_history_restore.restore", "zone_file_url"),
+				),
+			},
+			{
+				ResourceName:      "ovh_domain_zone_history_restore.restore",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
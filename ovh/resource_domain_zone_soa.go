@@ -0,0 +1,284 @@
+package ovh
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	ovhtypes "github.com/ovh/terraform-provider-ovh/v2/ovh/types"
+)
+
+var _ resource.ResourceWithConfigure = (*domainZoneSoaResource)(nil)
+var _ resource.ResourceWithImportState = (*domainZoneSoaResource)(nil)
+var _ resource.ResourceWithValidateConfig = (*domainZoneSoaResource)(nil)
+
+func NewDomainZoneSoaResource() resource.Resource {
+	return &domainZoneSoaResource{}
+}
+
+type domainZoneSoaResource struct {
+	config *Config
+}
+
+func (r *domainZoneSoaResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_domain_zone_soa"
+}
+
+func (r *domainZoneSoaResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.config = config
+}
+
+func domainZoneSoaTimerAttribute(description string, validators ...validator.Int64) schema.Int64Attribute {
+	return schema.Int64Attribute{
+		CustomType:          ovhtypes.TfInt64Type{},
+		Optional:            true,
+		Computed:            true,
+		Description:         description,
+		MarkdownDescription: description,
+		Validators:          validators,
+		PlanModifiers: []planmodifier.Int64{
+			int64planmodifier.UseStateForUnknown(),
+		},
+	}
+}
+
+func (r *domainZoneSoaResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Manage the SOA record of a DNS zone.",
+		MarkdownDescription: "Manage the SOA record of a DNS zone.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				CustomType:  ovhtypes.TfStringType{},
+				Computed:    true,
+				Description: "Unique identifier for the resource",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"zone_name": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Required:            true,
+				Description:         "Zone name",
+				MarkdownDescription: "Zone name",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"email": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Optional:            true,
+				Computed:            true,
+				Description:         "Email address of the person responsible for the zone",
+				MarkdownDescription: "Email address of the person responsible for the zone",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"ttl": domainZoneSoaTimerAttribute(
+				"Default TTL of the zone records, in seconds",
+				int64validator.Between(domainZoneSoaMinTimer, domainZoneSoaMaxTimer),
+			),
+			"refresh": domainZoneSoaTimerAttribute(
+				"Time in seconds after which secondary name servers should query the primary for the SOA record",
+				int64validator.Between(domainZoneSoaMinTimer, domainZoneSoaMaxTimer),
+			),
+			"retry": domainZoneSoaTimerAttribute(
+				"Time in seconds after which secondary name servers should retry a failed refresh. Must be lower than `refresh`",
+				int64validator.Between(domainZoneSoaMinTimer, domainZoneSoaMaxTimer),
+			),
+			"expire": domainZoneSoaTimerAttribute(
+				"Time in seconds after which secondary name servers stop answering for the zone if the primary is unreachable. Must be greater than `refresh` and `retry`",
+				int64validator.Between(domainZoneSoaMinTimer, domainZoneSoaMaxTimer),
+			),
+			"nx_domain_ttl": domainZoneSoaTimerAttribute(
+				"Negative caching TTL in seconds, i.e. how long resolvers cache NXDOMAIN answers",
+				int64validator.Between(0, domainZoneSoaMaxNegative),
+			),
+			"serial": schema.Int64Attribute{
+				CustomType:          ovhtypes.TfInt64Type{},
+				Computed:            true,
+				Description:         "Serial number of the zone",
+				MarkdownDescription: "Serial number of the zone",
+			},
+			"server": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Computed:            true,
+				Description:         "Primary name server of the zone",
+				MarkdownDescription: "Primary name server of the zone",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *domainZoneSoaResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data domainZoneSoaModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	known := func(v ovhtypes.TfInt64Value) bool {
+		return !v.IsNull() && !v.IsUnknown()
+	}
+
+	if known(data.Retry) && known(data.Refresh) && data.Retry.ValueInt64() >= data.Refresh.ValueInt64() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("retry"),
+			"Invalid SOA retry value",
+			fmt.Sprintf("retry (%d) must be lower than refresh (%d)", data.Retry.ValueInt64(), data.Refresh.ValueInt64()),
+		)
+	}
+
+	if known(data.Expire) && known(data.Refresh) && data.Expire.ValueInt64() <= data.Refresh.ValueInt64() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("expire"),
+			"Invalid SOA expire value",
+			fmt.Sprintf("expire (%d) must be greater than refresh (%d)", data.Expire.ValueInt64(), data.Refresh.ValueInt64()),
+		)
+	}
+
+	if known(data.Expire) && known(data.Retry) && data.Expire.ValueInt64() <= data.Retry.ValueInt64() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("expire"),
+			"Invalid SOA expire value",
+			fmt.Sprintf("expire (%d) must be greater than retry (%d)", data.Expire.ValueInt64(), data.Retry.ValueInt64()),
+		)
+	}
+}
+
+func (r *domainZoneSoaResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("zone_name"), req.ID)...)
+}
+
+func (r *domainZoneSoaResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data domainZoneSoaModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = data.ZoneName
+	if err := r.update(ctx, &data); err != nil {
+		resp.Diagnostics.AddError("Error updating SOA", err.Error())
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *domainZoneSoaResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var (
+		data         domainZoneSoaModel
+		responseData DomainZoneSoa
+	)
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	endpoint := "/domain/zone/" + url.PathEscape(data.ZoneName.ValueString()) + "/soa"
+	if err := r.config.OVHClient.GetWithContext(ctx, endpoint, &responseData); err != nil {
+		// The zone has been deleted, the SOA is gone with it
+		if isOvhApiNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Error calling Get %s", endpoint),
+			err.Error(),
+		)
+		return
+	}
+
+	data.MergeWith(&responseData)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *domainZoneSoaResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data domainZoneSoaModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.update(ctx, &data); err != nil {
+		resp.Diagnostics.AddError("Error updating SOA", err.Error())
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *domainZoneSoaResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// The SOA record cannot be deleted: removing the resource only removes it from the state
+	// and the zone keeps its current SOA values.
+}
+
+// update merges the configured values into the current SOA of the zone, applies it and
+// refreshes the zone so that the new values are served by the name servers.
+func (r *domainZoneSoaResource) update(ctx context.Context, data *domainZoneSoaModel) error {
+	var soa DomainZoneSoa
+
+	zoneName := data.ZoneName.ValueString()
+	endpoint := "/domain/zone/" + url.PathEscape(zoneName) + "/soa"
+
+	if err := r.config.OVHClient.GetWithContext(ctx, endpoint, &soa); err != nil {
+		return fmt.Errorf("error calling Get %s: %w", endpoint, err)
+	}
+
+	data.ApplyTo(&soa)
+
+	if err := r.config.OVHClient.PutWithContext(ctx, endpoint, &soa, nil); err != nil {
+		return fmt.Errorf("error calling Put %s: %w", endpoint, err)
+	}
+
+	refreshEndpoint := "/domain/zone/" + url.PathEscape(zoneName) + "/refresh"
+	if err := r.config.OVHClient.PostWithContext(ctx, refreshEndpoint, nil, nil); err != nil {
+		log.Printf("[WARN] OVH Domain zone refresh after SOA update failed: %s", err)
+	}
+
+	if err := r.config.OVHClient.GetWithContext(ctx, endpoint, &soa); err != nil {
+		return fmt.Errorf("error calling Get %s: %w", endpoint, err)
+	}
+
+	data.MergeWith(&soa)
+
+	return nil
+}
@@ -0,0 +1,85 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDomainZoneSoa_basic(t *testing.T) {
+	zoneName := os.Getenv("OVH_ZONE_TEST")
+
+	config := `
+	resource "ovh_domain_zone_soa" "soa" {
+		zone_name     = "%s"
+		ttl           = %d
+		refresh       = 86400
+		retry         = 3600
+		expire        = 3600000
+		nx_domain_ttl = %d
+	}
+	`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckDomain(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(config, zoneName, 3600, 300),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ovh_domain_zone_soa.soa", "zone_name", zoneName),
+					resource.TestCheckResourceAttr("ovh_domain_zone_soa.soa", "ttl", "3600"),
+					resource.TestCheckResourceAttr("ovh_domain_zone_soa.soa", "nx_domain_ttl", "300"),
+					resource.TestCheckResourceAttrSet("ovh_domain_zone_soa.soa", "email"),
+					resource.TestCheckResourceAttrSet("ovh_domain_zone_soa.soa", "serial"),
+					resource.TestCheckResourceAttrSet("ovh_domain_zone_soa.soa", "server"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(config, zoneName, 7200, 900),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ovh_domain_zone_soa.soa", "ttl", "7200"),
+					resource.TestCheckResourceAttr("ovh_domain_zone_soa.soa", "nx_domain_ttl", "900"),
+				),
+			},
+			{
+				ResourceName:            "ovh_domain_zone_soa.soa",
+				ImportState:             true,
+				ImportStateId:           zoneName,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"serial"},
+			},
+		},
+	})
+}
+
+func TestAccDomainZoneSoa_invalidTimers(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckDomain(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "ovh_domain_zone_soa" "soa" {
+					zone_name = "example.com"
+					refresh   = 3600
+					retry     = 7200
+				}`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("must be lower than refresh"),
+			},
+			{
+				Config: `
+				resource "ovh_domain_zone_soa" "soa" {
+					zone_name     = "example.com"
+					nx_domain_ttl = 172800
+				}`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Invalid Attribute Value"),
+			},
+		},
+	})
+}
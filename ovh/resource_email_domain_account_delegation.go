@@ -0,0 +1,197 @@
+package ovh
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	ovhtypes "github.com/ovh/terraform-provider-ovh/v2/ovh/types"
+)
+
+var _ resource.ResourceWithConfigure = (*emailDomainAccountDelegationResource)(nil)
+var _ resource.ResourceWithImportState = (*emailDomainAccountDelegationResource)(nil)
+
+func NewEmailDomainAccountDelegationResource() resource.Resource {
+	return &emailDomainAccountDelegationResource{}
+}
+
+type emailDomainAccountDelegationResource struct {
+	config *Config
+}
+
+func (r *emailDomainAccountDelegationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_email_domain_account_delegation"
+}
+
+func (r *emailDomainAccountDelegationResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.config = config
+}
+
+func (r *emailDomainAccountDelegationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Delegates the management of an email account of an OVHcloud email domain to another OVHcloud account.",
+		MarkdownDescription: "Delegates the management of an email account of an OVHcloud email domain to another OVHcloud account.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				CustomType:  ovhtypes.TfStringType{},
+				Computed:    true,
+				Description: "Unique identifier for the resource",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"domain": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Required:            true,
+				Description:         "Name of the email domain",
+				MarkdownDescription: "Name of the email domain",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"account_name": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Required:            true,
+				Description:         "Name of the email account (without the domain part)",
+				MarkdownDescription: "Name of the email account (without the domain part)",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"account_id": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Required:            true,
+				Description:         "OVHcloud account (NIC handle) the email account is delegated to",
+				MarkdownDescription: "OVHcloud account (NIC handle) the email account is delegated to",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *emailDomainAccountDelegationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	splits, ok := splitImportID(req.ID, 3)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Given ID is malformed",
+			"import ID must be formatted as domain/account_name/account_id",
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("domain"), splits[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("account_name"), splits[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("account_id"), splits[2])...)
+}
+
+func (r *emailDomainAccountDelegationResource) baseEndpoint(data *emailDomainAccountDelegationModel) string {
+	return "/email/domain/" + url.PathEscape(data.Domain.ValueString()) + "/account/" + url.PathEscape(data.AccountName.ValueString()) + "/delegation"
+}
+
+func (r *emailDomainAccountDelegationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var (
+		data emailDomainAccountDelegationModel
+		task EmailDomainTask
+	)
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	endpoint := r.baseEndpoint(&data)
+	if err := r.config.OVHClient.PostWithContext(ctx, endpoint, &EmailDomainDelegation{AccountId: data.AccountId.ValueString()}, &task); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error calling Post %s", endpoint), err.Error())
+		return
+	}
+
+	if err := waitEmailDomainTask(ctx, r.config, data.Domain.ValueString(), emailDomainTaskKindAccount, task.Id); err != nil {
+		resp.Diagnostics.AddError("Error waiting for delegation creation", err.Error())
+		return
+	}
+
+	data.ID = ovhtypes.NewTfStringValue(data.Domain.ValueString() + "/" + data.AccountName.ValueString() + "/" + data.AccountId.ValueString())
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *emailDomainAccountDelegationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var (
+		data       emailDomainAccountDelegationModel
+		delegation EmailDomainDelegation
+	)
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	endpoint := r.baseEndpoint(&data) + "/" + url.PathEscape(data.AccountId.ValueString())
+	if err := r.config.OVHClient.GetWithContext(ctx, endpoint, &delegation); err != nil {
+		if isOvhApiNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(fmt.Sprintf("Error calling Get %s", endpoint), err.Error())
+		return
+	}
+
+	data.AccountId = ovhtypes.NewTfStringValue(delegation.AccountId)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *emailDomainAccountDelegationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.AddError("update should never happen", "this code should be unreachable")
+}
+
+func (r *emailDomainAccountDelegationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var (
+		data emailDomainAccountDelegationModel
+		task EmailDomainTask
+	)
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	endpoint := r.baseEndpoint(&data) + "/" + url.PathEscape(data.AccountId.ValueString())
+	if err := r.config.OVHClient.DeleteWithContext(ctx, endpoint, &task); err != nil {
+		if isOvhApiNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError(fmt.Sprintf("Error calling Delete %s", endpoint), err.Error())
+		return
+	}
+
+	if err := waitEmailDomainTask(ctx, r.config, data.Domain.ValueString(), emailDomainTaskKindAccount, task.Id); err != nil {
+		resp.Diagnostics.AddError("Error waiting for delegation deletion", err.Error())
+	}
+}
@@ -0,0 +1,52 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccEmailDomainAccountDelegation_basic(t *testing.T) {
+	domain := os.Getenv("OVH_EMAIL_DOMAIN_TEST")
+	accountId := os.Getenv("OVH_EMAIL_DOMAIN_DELEGATION_ACCOUNT_TEST")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckEmailDomain(t)
+			checkEnvOrSkip(t, "OVH_EMAIL_DOMAIN_DELEGATION_ACCOUNT_TEST")
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEmailDomainAccountDelegationConfig(domain, accountId),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ovh_email_domain_account_delegation.test", "account_name", "tfacc-delegation"),
+					resource.TestCheckResourceAttr("ovh_email_domain_account_delegation.test", "account_id", accountId),
+					resource.TestCheckResourceAttr("ovh_email_domain_account_delegation.test", "id", domain+"/tfacc-delegation/"+accountId),
+				),
+			},
+			{
+				ResourceName:      "ovh_email_domain_account_delegation.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccEmailDomainAccountDelegationConfig(domain, accountId string) string {
+	return fmt.Sprintf(`
+resource "ovh_email_domain_account" "test" {
+  domain       = %q
+  account_name = "tfacc-delegation"
+  password     = "P@ssw0rd1234!"
+}
+
+resource "ovh_email_domain_account_delegation" "test" {
+  domain       = ovh_email_domain_account.test.domain
+  account_name = ovh_email_domain_account.test.account_name
+  account_id   = %q
+}`, domain, accountId)
+}
@@ -0,0 +1,356 @@
+package ovh
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	ovhtypes "github.com/ovh/terraform-provider-ovh/v2/ovh/types"
+)
+
+var _ resource.ResourceWithConfigure = (*emailDomainAccountFilterResource)(nil)
+var _ resource.ResourceWithImportState = (*emailDomainAccountFilterResource)(nil)
+
+func NewEmailDomainAccountFilterResource() resource.Resource {
+	return &emailDomainAccountFilterResource{}
+}
+
+type emailDomainAccountFilterResource struct {
+	config *Config
+}
+
+func (r *emailDomainAccountFilterResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_email_domain_account_filter"
+}
+
+func (r *emailDomainAccountFilterResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.config = config
+}
+
+func (r *emailDomainAccountFilterResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Manages a filter on an email account of an OVHcloud email domain.",
+		MarkdownDescription: "Manages a filter on an email account of an OVHcloud email domain.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				CustomType:  ovhtypes.TfStringType{},
+				Computed:    true,
+				Description: "Unique identifier for the resource",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"domain": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Required:            true,
+				Description:         "Name of the email domain",
+				MarkdownDescription: "Name of the email domain",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"account_name": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Required:            true,
+				Description:         "Name of the email account (without the domain part)",
+				MarkdownDescription: "Name of the email account (without the domain part)",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Required:            true,
+				Description:         "Name of the filter",
+				MarkdownDescription: "Name of the filter",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"action": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Required:            true,
+				Description:         "Action applied to the matching emails (accept, account, delete, redirect)",
+				MarkdownDescription: "Action applied to the matching emails (`accept`, `account`, `delete`, `redirect`)",
+				Validators: []validator.String{
+					stringvalidator.OneOf("accept", "account", "delete", "redirect"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"action_param": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Optional:            true,
+				Description:         "Parameter of the action, e.g. the target address of a redirect action",
+				MarkdownDescription: "Parameter of the action, e.g. the target address of a `redirect` action",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"active": schema.BoolAttribute{
+				CustomType:          ovhtypes.TfBoolType{},
+				Optional:            true,
+				Computed:            true,
+				Description:         "Whether the filter is active. Defaults to true",
+				MarkdownDescription: "Whether the filter is active. Defaults to `true`",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"priority": schema.Int64Attribute{
+				CustomType:          ovhtypes.TfInt64Type{},
+				Required:            true,
+				Description:         "Priority of the filter",
+				MarkdownDescription: "Priority of the filter",
+			},
+			"header": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Required:            true,
+				Description:         "Header the rule of the filter applies to",
+				MarkdownDescription: "Header the rule of the filter applies to",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"operand": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Required:            true,
+				Description:         "Operand of the rule of the filter (checkspf, contains, noContains)",
+				MarkdownDescription: "Operand of the rule of the filter (`checkspf`, `contains`, `noContains`)",
+				Validators: []validator.String{
+					stringvalidator.OneOf("checkspf", "contains", "noContains"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"value": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Required:            true,
+				Description:         "Value the header is compared to",
+				MarkdownDescription: "Value the header is compared to",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *emailDomainAccountFilterResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	splits, ok := splitImportID(req.ID, 3)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Given ID is malformed",
+			"import ID must be formatted as domain/account_name/filter_name",
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("domain"), splits[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("account_name"), splits[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), splits[2])...)
+}
+
+func (r *emailDomainAccountFilterResource) endpoint(data *emailDomainAccountFilterModel) string {
+	return "/email/domain/" + url.PathEscape(data.Domain.ValueString()) +
+		"/account/" + url.PathEscape(data.AccountName.ValueString()) +
+		"/filter/" + url.PathEscape(data.Name.ValueString())
+}
+
+// read fetches the filter and its rule. Filters managed by this resource hold a single rule.
+func (r *emailDomainAccountFilterResource) read(ctx context.Context, data *emailDomainAccountFilterModel) error {
+	var (
+		filter  EmailDomainFilter
+		ruleIds []int64
+		rule    *EmailDomainFilterRule
+	)
+
+	endpoint := r.endpoint(data)
+	if err := r.config.OVHClient.GetWithContext(ctx, endpoint, &filter); err != nil {
+		return err
+	}
+
+	rulesEndpoint := endpoint + "/rule"
+	if err := r.config.OVHClient.GetWithContext(ctx, rulesEndpoint, &ruleIds); err != nil {
+		return fmt.Errorf("error calling Get %s: %w", rulesEndpoint, err)
+	}
+
+	if len(ruleIds) > 0 {
+		rule = &EmailDomainFilterRule{}
+		ruleEndpoint := rulesEndpoint + "/" + strconv.FormatInt(ruleIds[0], 10)
+		if err := r.config.OVHClient.GetWithContext(ctx, ruleEndpoint, rule); err != nil {
+			return fmt.Errorf("error calling Get %s: %w", ruleEndpoint, err)
+		}
+	}
+
+	data.MergeWith(&filter, rule)
+
+	return nil
+}
+
+func (r *emailDomainAccountFilterResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var (
+		data emailDomainAccountFilterModel
+		task EmailDomainTask
+	)
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Active.IsUnknown() || data.Active.IsNull() {
+		data.Active = ovhtypes.NewTfBoolValue(true)
+	}
+
+	domain := data.Domain.ValueString()
+	endpoint := "/email/domain/" + url.PathEscape(domain) + "/account/" + url.PathEscape(data.AccountName.ValueString()) + "/filter"
+	if err := r.config.OVHClient.PostWithContext(ctx, endpoint, data.ToCreate(), &task); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error calling Post %s", endpoint), err.Error())
+		return
+	}
+
+	if err := waitEmailDomainTask(ctx, r.config, domain, emailDomainTaskKindFilter, task.Id); err != nil {
+		resp.Diagnostics.AddError("Error waiting for filter creation", err.Error())
+		return
+	}
+
+	data.ID = ovhtypes.NewTfStringValue(domain + "/" + data.AccountName.ValueString() + "/" + data.Name.ValueString())
+
+	if err := r.read(ctx, &data); err != nil {
+		resp.Diagnostics.AddError("Error reading filter", err.Error())
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *emailDomainAccountFilterResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data emailDomainAccountFilterModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.read(ctx, &data); err != nil {
+		if isOvhApiNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading filter", err.Error())
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *emailDomainAccountFilterResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, stateData emailDomainAccountFilterModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &stateData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	domain := data.Domain.ValueString()
+	endpoint := r.endpoint(&data)
+
+	if !data.Active.IsUnknown() && !data.Active.Equal(stateData.Active) {
+		var task EmailDomainTask
+
+		activityEndpoint := endpoint + "/changeActivity"
+		if err := r.config.OVHClient.PostWithContext(ctx, activityEndpoint, map[string]bool{"activity": data.Active.ValueBool()}, &task); err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Error calling Post %s", activityEndpoint), err.Error())
+			return
+		}
+
+		if err := waitEmailDomainTask(ctx, r.config, domain, emailDomainTaskKindFilter, task.Id); err != nil {
+			resp.Diagnostics.AddError("Error waiting for filter activity update", err.Error())
+			return
+		}
+	}
+
+	if !data.Priority.Equal(stateData.Priority) {
+		var task EmailDomainTask
+
+		priorityEndpoint := endpoint + "/changePriority"
+		if err := r.config.OVHClient.PostWithContext(ctx, priorityEndpoint, map[string]int64{"priority": data.Priority.ValueInt64()}, &task); err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Error calling Post %s", priorityEndpoint), err.Error())
+			return
+		}
+
+		if err := waitEmailDomainTask(ctx, r.config, domain, emailDomainTaskKindFilter, task.Id); err != nil {
+			resp.Diagnostics.AddError("Error waiting for filter priority update", err.Error())
+			return
+		}
+	}
+
+	if err := r.read(ctx, &data); err != nil {
+		resp.Diagnostics.AddError("Error reading filter", err.Error())
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *emailDomainAccountFilterResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var (
+		data  emailDomainAccountFilterModel
+		tasks []EmailDomainTask
+	)
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	endpoint := r.endpoint(&data)
+	if err := r.config.OVHClient.DeleteWithContext(ctx, endpoint, &tasks); err != nil {
+		if isOvhApiNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError(fmt.Sprintf("Error calling Delete %s", endpoint), err.Error())
+		return
+	}
+
+	for _, task := range tasks {
+		if err := waitEmailDomainTask(ctx, r.config, data.Domain.ValueString(), emailDomainTaskKindFilter, task.Id); err != nil {
+			resp.Diagnostics.AddError("Error waiting for filter deletion", err.Error())
+			return
+		}
+	}
+}
@@ -0,0 +1,64 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccEmailDomainAccountFilter_basic(t *testing.T) {
+	domain := os.Getenv("OVH_EMAIL_DOMAIN_TEST")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckEmailDomain(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEmailDomainAccountFilterConfig(domain, 1, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ovh_email_domain_account_filter.test", "name", "tfacc-filter"),
+					resource.TestCheckResourceAttr("ovh_email_domain_account_filter.test", "action", "delete"),
+					resource.TestCheckResourceAttr("ovh_email_domain_account_filter.test", "priority", "1"),
+					resource.TestCheckResourceAttr("ovh_email_domain_account_filter.test", "active", "true"),
+					resource.TestCheckResourceAttr("ovh_email_domain_account_filter.test", "operand", "contains"),
+					resource.TestCheckResourceAttr("ovh_email_domain_account_filter.test", "value", "spam"),
+				),
+			},
+			{
+				Config: testAccEmailDomainAccountFilterConfig(domain, 2, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ovh_email_domain_account_filter.test", "priority", "2"),
+					resource.TestCheckResourceAttr("ovh_email_domain_account_filter.test", "active", "false"),
+				),
+			},
+			{
+				ResourceName:      "ovh_email_domain_account_filter.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccEmailDomainAccountFilterConfig(domain string, priority int, active bool) string {
+	return fmt.Sprintf(`
+resource "ovh_email_domain_account" "test" {
+  domain       = %q
+  account_name = "tfacc-filter"
+  password     = "P@ssw0rd1234!"
+}
+
+resource "ovh_email_domain_account_filter" "test" {
+  domain       = ovh_email_domain_account.test.domain
+  account_name = ovh_email_domain_account.test.account_name
+  name         = "tfacc-filter"
+  priority     = %d
+  active       = %t
+  action       = "delete"
+  header       = "Subject"
+  operand      = "contains"
+  value        = "spam"
+}`, domain, priority, active)
+}
@@ -0,0 +1,307 @@
+package ovh
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	ovhtypes "github.com/ovh/terraform-provider-ovh/v2/ovh/types"
+)
+
+var _ resource.ResourceWithConfigure = (*emailDomainMailingListResource)(nil)
+var _ resource.ResourceWithImportState = (*emailDomainMailingListResource)(nil)
+
+func NewEmailDomainMailingListResource() resource.Resource {
+	return &emailDomainMailingListResource{}
+}
+
+type emailDomainMailingListResource struct {
+	config *Config
+}
+
+func (r *emailDomainMailingListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_email_domain_mailing_list"
+}
+
+func (r *emailDomainMailingListResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.config = config
+}
+
+func (r *emailDomainMailingListResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Manages a mailing list on an OVHcloud email domain.",
+		MarkdownDescription: "Manages a mailing list on an OVHcloud email domain.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				CustomType:  ovhtypes.TfStringType{},
+				Computed:    true,
+				Description: "Unique identifier for the resource",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"domain": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Required:            true,
+				Description:         "Name of the email domain",
+				MarkdownDescription: "Name of the email domain",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Required:            true,
+				Description:         "Name of the mailing list (without the domain part)",
+				MarkdownDescription: "Name of the mailing list (without the domain part)",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"language": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Required:            true,
+				Description:         "Language of the mailing list messages",
+				MarkdownDescription: "Language of the mailing list messages",
+				Validators: []validator.String{
+					stringvalidator.OneOf("de", "en", "es", "fr", "it", "nl", "pl", "pt"),
+				},
+			},
+			"owner_email": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Required:            true,
+				Description:         "Email address of the owner of the mailing list",
+				MarkdownDescription: "Email address of the owner of the mailing list",
+			},
+			"reply_to": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Optional:            true,
+				Computed:            true,
+				Description:         "Email address replies are sent to",
+				MarkdownDescription: "Email address replies are sent to",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"moderator_message": schema.BoolAttribute{
+				CustomType:          ovhtypes.TfBoolType{},
+				Optional:            true,
+				Computed:            true,
+				Description:         "Messages sent to the mailing list must be approved by a moderator",
+				MarkdownDescription: "Messages sent to the mailing list must be approved by a moderator",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"subscribe_by_moderator": schema.BoolAttribute{
+				CustomType:          ovhtypes.TfBoolType{},
+				Optional:            true,
+				Computed:            true,
+				Description:         "Subscriptions must be approved by a moderator",
+				MarkdownDescription: "Subscriptions must be approved by a moderator",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"users_post_only": schema.BoolAttribute{
+				CustomType:          ovhtypes.TfBoolType{},
+				Optional:            true,
+				Computed:            true,
+				Description:         "Only subscribers can send messages to the mailing list",
+				MarkdownDescription: "Only subscribers can send messages to the mailing list",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"nb_subscribers": schema.Int64Attribute{
+				CustomType:          ovhtypes.TfInt64Type{},
+				Computed:            true,
+				Description:         "Number of subscribers of the mailing list",
+				MarkdownDescription: "Number of subscribers of the mailing list",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *emailDomainMailingListResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	splits, ok := splitImportID(req.ID, 2)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Given ID is malformed",
+			"import ID must be formatted as domain/name",
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("domain"), splits[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), splits[1])...)
+}
+
+func (r *emailDomainMailingListResource) endpoint(data *emailDomainMailingListModel) string {
+	return "/email/domain/" + url.PathEscape(data.Domain.ValueString()) + "/mailingList/" + url.PathEscape(data.Name.ValueString())
+}
+
+func (r *emailDomainMailingListResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var (
+		data        emailDomainMailingListModel
+		task        EmailDomainTask
+		mailingList EmailDomainMailingList
+	)
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	domain := data.Domain.ValueString()
+	endpoint := "/email/domain/" + url.PathEscape(domain) + "/mailingList"
+	if err := r.config.OVHClient.PostWithContext(ctx, endpoint, data.ToCreate(), &task); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error calling Post %s", endpoint), err.Error())
+		return
+	}
+
+	if err := waitEmailDomainTask(ctx, r.config, domain, emailDomainTaskKindMailingList, task.Id); err != nil {
+		resp.Diagnostics.AddError("Error waiting for mailing list creation", err.Error())
+		return
+	}
+
+	endpoint = r.endpoint(&data)
+	if err := r.config.OVHClient.GetWithContext(ctx, endpoint, &mailingList); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error calling Get %s", endpoint), err.Error())
+		return
+	}
+
+	data.ID = ovhtypes.NewTfStringValue(domain + "/" + data.Name.ValueString())
+	data.MergeWith(&mailingList)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *emailDomainMailingListResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var (
+		data        emailDomainMailingListModel
+		mailingList EmailDomainMailingList
+	)
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	endpoint := r.endpoint(&data)
+	if err := r.config.OVHClient.GetWithContext(ctx, endpoint, &mailingList); err != nil {
+		if isOvhApiNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(fmt.Sprintf("Error calling Get %s", endpoint), err.Error())
+		return
+	}
+
+	data.MergeWith(&mailingList)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *emailDomainMailingListResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var (
+		data, stateData emailDomainMailingListModel
+		mailingList     EmailDomainMailingList
+	)
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &stateData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	endpoint := r.endpoint(&data)
+	if err := r.config.OVHClient.PutWithContext(ctx, endpoint, data.ToUpdate(), nil); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error calling Put %s", endpoint), err.Error())
+		return
+	}
+
+	// Options are updated through a dedicated asynchronous call
+	if data.Options() != stateData.Options() {
+		var task EmailDomainTask
+
+		optionsEndpoint := endpoint + "/changeOptions"
+		opts := &EmailDomainMailingListChangeOptionsOpts{Options: data.Options()}
+		if err := r.config.OVHClient.PostWithContext(ctx, optionsEndpoint, opts, &task); err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Error calling Post %s", optionsEndpoint), err.Error())
+			return
+		}
+
+		if err := waitEmailDomainTask(ctx, r.config, data.Domain.ValueString(), emailDomainTaskKindMailingList, task.Id); err != nil {
+			resp.Diagnostics.AddError("Error waiting for mailing list options update", err.Error())
+			return
+		}
+	}
+
+	if err := r.config.OVHClient.GetWithContext(ctx, endpoint, &mailingList); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error calling Get %s", endpoint), err.Error())
+		return
+	}
+
+	data.MergeWith(&mailingList)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *emailDomainMailingListResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var (
+		data emailDomainMailingListModel
+		task EmailDomainTask
+	)
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	endpoint := r.endpoint(&data)
+	if err := r.config.OVHClient.DeleteWithContext(ctx, endpoint, &task); err != nil {
+		if isOvhApiNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError(fmt.Sprintf("Error calling Delete %s", endpoint), err.Error())
+		return
+	}
+
+	if err := waitEmailDomainTask(ctx, r.config, data.Domain.ValueString(), emailDomainTaskKindMailingList, task.Id); err != nil {
+		resp.Diagnostics.AddError("Error waiting for mailing list deletion", err.Error())
+	}
+}
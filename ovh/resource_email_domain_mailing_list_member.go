@@ -0,0 +1,202 @@
+package ovh
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	ovhtypes "github.com/ovh/terraform-provider-ovh/v2/ovh/types"
+)
+
+var _ resource.ResourceWithConfigure = (*emailDomainMailingListMemberResource)(nil)
+var _ resource.ResourceWithImportState = (*emailDomainMailingListMemberResource)(nil)
+
+// emailDomainMailingListMemberResource implements both the moderator and the subscriber
+// resources of a mailing list, as they only differ by their API path.
+type emailDomainMailingListMemberResource struct {
+	config *Config
+	// Kind of member, either "moderator" or "subscriber"
+	kind string
+}
+
+func NewEmailDomainMailingListModeratorResource() resource.Resource {
+	return &emailDomainMailingListMemberResource{kind: "moderator"}
+}
+
+func NewEmailDomainMailingListSubscriberResource() resource.Resource {
+	return &emailDomainMailingListMemberResource{kind: "subscriber"}
+}
+
+func (r *emailDomainMailingListMemberResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_email_domain_mailing_list_" + r.kind
+}
+
+func (r *emailDomainMailingListMemberResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.config = config
+}
+
+func (r *emailDomainMailingListMemberResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	description := fmt.Sprintf("Manages a %s of a mailing list on an OVHcloud email domain.", r.kind)
+
+	resp.Schema = schema.Schema{
+		Description:         description,
+		MarkdownDescription: description,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				CustomType:  ovhtypes.TfStringType{},
+				Computed:    true,
+				Description: "Unique identifier for the resource",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"domain": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Required:            true,
+				Description:         "Name of the email domain",
+				MarkdownDescription: "Name of the email domain",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"mailing_list_name": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Required:            true,
+				Description:         "Name of the mailing list",
+				MarkdownDescription: "Name of the mailing list",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"email": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Required:            true,
+				Description:         fmt.Sprintf("Email address of the %s", r.kind),
+				MarkdownDescription: fmt.Sprintf("Email address of the %s", r.kind),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *emailDomainMailingListMemberResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	splits, ok := splitImportID(req.ID, 3)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Given ID is malformed",
+			"import ID must be formatted as domain/mailing_list_name/email",
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("domain"), splits[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("mailing_list_name"), splits[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("email"), splits[2])...)
+}
+
+func (r *emailDomainMailingListMemberResource) baseEndpoint(data *emailDomainMailingListMemberModel) string {
+	return "/email/domain/" + url.PathEscape(data.Domain.ValueString()) + "/mailingList/" + url.PathEscape(data.MailingListName.ValueString()) + "/" + r.kind
+}
+
+func (r *emailDomainMailingListMemberResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var (
+		data emailDomainMailingListMemberModel
+		task EmailDomainTask
+	)
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	endpoint := r.baseEndpoint(&data)
+	if err := r.config.OVHClient.PostWithContext(ctx, endpoint, map[string]string{"email": data.Email.ValueString()}, &task); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error calling Post %s", endpoint), err.Error())
+		return
+	}
+
+	if err := waitEmailDomainTask(ctx, r.config, data.Domain.ValueString(), emailDomainTaskKindMailingList, task.Id); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error waiting for mailing list %s creation", r.kind), err.Error())
+		return
+	}
+
+	data.ID = ovhtypes.NewTfStringValue(data.Domain.ValueString() + "/" + data.MailingListName.ValueString() + "/" + data.Email.ValueString())
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *emailDomainMailingListMemberResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data emailDomainMailingListMemberModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	endpoint := r.baseEndpoint(&data) + "/" + url.PathEscape(data.Email.ValueString())
+	if err := r.config.OVHClient.GetWithContext(ctx, endpoint, nil); err != nil {
+		if isOvhApiNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(fmt.Sprintf("Error calling Get %s", endpoint), err.Error())
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *emailDomainMailingListMemberResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.AddError("update should never happen", "this code should be unreachable")
+}
+
+func (r *emailDomainMailingListMemberResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var (
+		data emailDomainMailingListMemberModel
+		task EmailDomainTask
+	)
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	endpoint := r.baseEndpoint(&data) + "/" + url.PathEscape(data.Email.ValueString())
+	if err := r.config.OVHClient.DeleteWithContext(ctx, endpoint, &task); err != nil {
+		if isOvhApiNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError(fmt.Sprintf("Error calling Delete %s", endpoint), err.Error())
+		return
+	}
+
+	if err := waitEmailDomainTask(ctx, r.config, data.Domain.ValueString(), emailDomainTaskKindMailingList, task.Id); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error waiting for mailing list %s deletion", r.kind), err.Error())
+	}
+}
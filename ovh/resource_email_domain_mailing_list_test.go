@@ -0,0 +1,70 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccEmailDomainMailingList_basic(t *testing.T) {
+	domain := os.Getenv("OVH_EMAIL_DOMAIN_TEST")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckEmailDomain(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEmailDomainMailingListConfig(domain, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ovh_email_domain_mailing_list.test", "domain", domain),
+					resource.TestCheckResourceAttr("ovh_email_domain_mailing_list.test", "name", "tfacc-list"),
+					resource.TestCheckResourceAttr("ovh_email_domain_mailing_list.test", "language", "en"),
+					resource.TestCheckResourceAttr("ovh_email_domain_mailing_list.test", "users_post_only", "false"),
+					resource.TestCheckResourceAttr("ovh_email_domain_mailing_list_moderator.test", "email", "tfacc-moderator@example.com"),
+					resource.TestCheckResourceAttr("ovh_email_domain_mailing_list_subscriber.test", "email", "tfacc-subscriber@example.com"),
+				),
+			},
+			{
+				Config: testAccEmailDomainMailingListConfig(domain, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ovh_email_domain_mailing_list.test", "users_post_only", "true"),
+				),
+			},
+			{
+				ResourceName:      "ovh_email_domain_mailing_list.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "ovh_email_domain_mailing_list_subscriber.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccEmailDomainMailingListConfig(domain string, usersPostOnly bool) string {
+	return fmt.Sprintf(`
+resource "ovh_email_domain_mailing_list" "test" {
+  domain          = %q
+  name            = "tfacc-list"
+  language        = "en"
+  owner_email     = "tfacc-owner@example.com"
+  users_post_only = %t
+}
+
+resource "ovh_email_domain_mailing_list_moderator" "test" {
+  domain            = ovh_email_domain_mailing_list.test.domain
+  mailing_list_name = ovh_email_domain_mailing_list.test.name
+  email             = "tfacc-moderator@example.com"
+}
+
+resource "ovh_email_domain_mailing_list_subscriber" "test" {
+  domain            = ovh_email_domain_mailing_list.test.domain
+  mailing_list_name = ovh_email_domain_mailing_list.test.name
+  email             = "tfacc-subscriber@example.com"
+}`, domain, usersPostOnly)
+}
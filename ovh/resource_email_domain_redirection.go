@@ -0,0 +1,279 @@
+package ovh
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	ovhtypes "github.com/ovh/terraform-provider-ovh/v2/ovh/types"
+)
+
+var _ resource.ResourceWithConfigure = (*emailDomainRedirectionResource)(nil)
+var _ resource.ResourceWithImportState = (*emailDomainRedirectionResource)(nil)
+
+func NewEmailDomainRedirectionResource() resource.Resource {
+	return &emailDomainRedirectionResource{}
+}
+
+type emailDomainRedirectionResource struct {
+	config *Config
+}
+
+func (r *emailDomainRedirectionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_email_domain_redirection"
+}
+
+func (r *emailDomainRedirectionResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.config = config
+}
+
+func (r *emailDomainRedirectionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Manages an email redirection on an OVHcloud email domain.",
+		MarkdownDescription: "Manages an email redirection on an OVHcloud email domain.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Computed:            true,
+				Description:         "Identifier of the redirection",
+				MarkdownDescription: "Identifier of the redirection",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"domain": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Required:            true,
+				Description:         "Name of the email domain",
+				MarkdownDescription: "Name of the email domain",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"from": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Required:            true,
+				Description:         "Email address to redirect",
+				MarkdownDescription: "Email address to redirect",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"to": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Required:            true,
+				Description:         "Email address the emails are redirected to",
+				MarkdownDescription: "Email address the emails are redirected to",
+			},
+			"local_copy": schema.BoolAttribute{
+				CustomType:          ovhtypes.TfBoolType{},
+				Optional:            true,
+				Computed:            true,
+				Description:         "Keep a copy of the emails in the `from` mailbox",
+				MarkdownDescription: "Keep a copy of the emails in the `from` mailbox",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *emailDomainRedirectionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	splits, ok := splitImportID(req.ID, 2)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Given ID is malformed",
+			"import ID must be formatted as domain/redirection_id",
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("domain"), splits[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), splits[1])...)
+}
+
+func (r *emailDomainRedirectionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var (
+		data        emailDomainRedirectionModel
+		task        EmailDomainTask
+		ids         []string
+		redirection EmailDomainRedirection
+	)
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.LocalCopy.IsUnknown() {
+		data.LocalCopy = ovhtypes.NewTfBoolValue(false)
+	}
+
+	domain := data.Domain.ValueString()
+	endpoint := "/email/domain/" + url.PathEscape(domain) + "/redirection"
+	opts := &EmailDomainRedirectionCreateOpts{
+		From:      data.From.ValueString(),
+		To:        data.To.ValueString(),
+		LocalCopy: data.LocalCopy.ValueBool(),
+	}
+
+	if err := r.config.OVHClient.PostWithContext(ctx, endpoint, opts, &task); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error calling Post %s", endpoint), err.Error())
+		return
+	}
+
+	if err := waitEmailDomainTask(ctx, r.config, domain, emailDomainTaskKindRedirection, task.Id); err != nil {
+		resp.Diagnostics.AddError("Error waiting for redirection creation", err.Error())
+		return
+	}
+
+	// The creation task doesn't return the redirection ID, look it up
+	queryParams := url.Values{}
+	queryParams.Add("from", opts.From)
+	queryParams.Add("to", opts.To)
+	listEndpoint := endpoint + "?" + queryParams.Encode()
+	if err := r.config.OVHClient.GetWithContext(ctx, listEndpoint, &ids); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error calling Get %s", listEndpoint), err.Error())
+		return
+	}
+
+	if len(ids) != 1 {
+		resp.Diagnostics.AddError(
+			"Error retrieving created redirection",
+			fmt.Sprintf("expected one redirection from %s to %s, got %d", opts.From, opts.To, len(ids)),
+		)
+		return
+	}
+
+	endpoint = endpoint + "/" + url.PathEscape(ids[0])
+	if err := r.config.OVHClient.GetWithContext(ctx, endpoint, &redirection); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error calling Get %s", endpoint), err.Error())
+		return
+	}
+
+	data.MergeWith(&redirection)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *emailDomainRedirectionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var (
+		data        emailDomainRedirectionModel
+		redirection EmailDomainRedirection
+	)
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	endpoint := "/email/domain/" + url.PathEscape(data.Domain.ValueString()) + "/redirection/" + url.PathEscape(data.ID.ValueString())
+	if err := r.config.OVHClient.GetWithContext(ctx, endpoint, &redirection); err != nil {
+		if isOvhApiNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(fmt.Sprintf("Error calling Get %s", endpoint), err.Error())
+		return
+	}
+
+	data.MergeWith(&redirection)
+
+	// local_copy is not returned by the API
+	if data.LocalCopy.IsNull() || data.LocalCopy.IsUnknown() {
+		data.LocalCopy = ovhtypes.NewTfBoolValue(false)
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *emailDomainRedirectionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var (
+		data, stateData emailDomainRedirectionModel
+		task            EmailDomainTask
+		redirection     EmailDomainRedirection
+	)
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &stateData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	domain := data.Domain.ValueString()
+	endpoint := "/email/domain/" + url.PathEscape(domain) + "/redirection/" + url.PathEscape(stateData.ID.ValueString())
+
+	changeEndpoint := endpoint + "/changeRedirection"
+	if err := r.config.OVHClient.PostWithContext(ctx, changeEndpoint, map[string]string{"to": data.To.ValueString()}, &task); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error calling Post %s", changeEndpoint), err.Error())
+		return
+	}
+
+	if err := waitEmailDomainTask(ctx, r.config, domain, emailDomainTaskKindRedirection, task.Id); err != nil {
+		resp.Diagnostics.AddError("Error waiting for redirection update", err.Error())
+		return
+	}
+
+	if err := r.config.OVHClient.GetWithContext(ctx, endpoint, &redirection); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error calling Get %s", endpoint), err.Error())
+		return
+	}
+
+	data.MergeWith(&redirection)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *emailDomainRedirectionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var (
+		data emailDomainRedirectionModel
+		task EmailDomainTask
+	)
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	domain := data.Domain.ValueString()
+	endpoint := "/email/domain/" + url.PathEscape(domain) + "/redirection/" + url.PathEscape(data.ID.ValueString())
+	if err := r.config.OVHClient.DeleteWithContext(ctx, endpoint, &task); err != nil {
+		if isOvhApiNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError(fmt.Sprintf("Error calling Delete %s", endpoint), err.Error())
+		return
+	}
+
+	if err := waitEmailDomainTask(ctx, r.config, domain, emailDomainTaskKindRedirection, task.Id); err != nil {
+		resp.Diagnostics.AddError("Error waiting for redirection deletion", err.Error())
+	}
+}
@@ -0,0 +1,64 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccEmailDomainRedirection_basic(t *testing.T) {
+	domain := os.Getenv("OVH_EMAIL_DOMAIN_TEST")
+	from := "tfacc-redirection@" + domain
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckEmailDomain(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEmailDomainRedirectionConfig(domain, from, "tfacc-first@example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ovh_email_domain_redirection.test", "domain", domain),
+					resource.TestCheckResourceAttr("ovh_email_domain_redirection.test", "from", from),
+					resource.TestCheckResourceAttr("ovh_email_domain_redirection.test", "to", "tfacc-first@example.com"),
+					resource.TestCheckResourceAttr("ovh_email_domain_redirection.test", "local_copy", "false"),
+					resource.TestCheckResourceAttrSet("ovh_email_domain_redirection.test", "id"),
+				),
+			},
+			{
+				Config: testAccEmailDomainRedirectionConfig(domain, from, "tfacc-second@example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ovh_email_domain_redirection.test", "to", "tfacc-second@example.com"),
+				),
+			},
+			{
+				ResourceName:      "ovh_email_domain_redirection.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccEmailDomainRedirectionImportId("ovh_email_domain_redirection.test"),
+			},
+		},
+	})
+}
+
+func testAccEmailDomainRedirectionImportId(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		redirection, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("redirection not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s/%s", redirection.Primary.Attributes["domain"], redirection.Primary.ID), nil
+	}
+}
+
+func testAccEmailDomainRedirectionConfig(domain, from, to string) string {
+	return fmt.Sprintf(`
+resource "ovh_email_domain_redirection" "test" {
+  domain = %q
+  from   = %q
+  to     = %q
+}`, domain, from, to)
+}
@@ -0,0 +1,256 @@
+package ovh
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	ovhtypes "github.com/ovh/terraform-provider-ovh/v2/ovh/types"
+)
+
+var _ resource.ResourceWithConfigure = (*emailDomainResponderResource)(nil)
+var _ resource.ResourceWithImportState = (*emailDomainResponderResource)(nil)
+
+func NewEmailDomainResponderResource() resource.Resource {
+	return &emailDomainResponderResource{}
+}
+
+type emailDomainResponderResource struct {
+	config *Config
+}
+
+func (r *emailDomainResponderResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_email_domain_responder"
+}
+
+func (r *emailDomainResponderResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.config = config
+}
+
+func (r *emailDomainResponderResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Manages an auto-responder on an OVHcloud email domain.",
+		MarkdownDescription: "Manages an auto-responder on an OVHcloud email domain.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				CustomType:  ovhtypes.TfStringType{},
+				Computed:    true,
+				Description: "Unique identifier for the resource",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"domain": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Required:            true,
+				Description:         "Name of the email domain",
+				MarkdownDescription: "Name of the email domain",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"account_name": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Required:            true,
+				Description:         "Name of the account the responder answers for (without the domain part)",
+				MarkdownDescription: "Name of the account the responder answers for (without the domain part)",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"content": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Required:            true,
+				Description:         "Content of the automatic answer",
+				MarkdownDescription: "Content of the automatic answer",
+			},
+			"copy": schema.BoolAttribute{
+				CustomType:          ovhtypes.TfBoolType{},
+				Optional:            true,
+				Computed:            true,
+				Description:         "Keep a copy of the received emails",
+				MarkdownDescription: "Keep a copy of the received emails",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"copy_to": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Optional:            true,
+				Description:         "Email address receiving the copy of the emails",
+				MarkdownDescription: "Email address receiving the copy of the emails",
+			},
+			"from": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Optional:            true,
+				Description:         "Date from which the responder is active (RFC 3339). The responder is active immediately if unset",
+				MarkdownDescription: "Date from which the responder is active (RFC 3339). The responder is active immediately if unset",
+			},
+			"to": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Optional:            true,
+				Description:         "Date until which the responder is active (RFC 3339). The responder is permanent if unset",
+				MarkdownDescription: "Date until which the responder is active (RFC 3339). The responder is permanent if unset",
+			},
+		},
+	}
+}
+
+func (r *emailDomainResponderResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	splits, ok := splitImportID(req.ID, 2)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Given ID is malformed",
+			"import ID must be formatted as domain/account_name",
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("domain"), splits[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("account_name"), splits[1])...)
+}
+
+func (r *emailDomainResponderResource) endpoint(data *emailDomainResponderModel) string {
+	return "/email/domain/" + url.PathEscape(data.Domain.ValueString()) + "/responder/" + url.PathEscape(data.AccountName.ValueString())
+}
+
+func (r *emailDomainResponderResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var (
+		data      emailDomainResponderModel
+		task      EmailDomainTask
+		responder EmailDomainResponder
+	)
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	domain := data.Domain.ValueString()
+	endpoint := "/email/domain/" + url.PathEscape(domain) + "/responder"
+	if err := r.config.OVHClient.PostWithContext(ctx, endpoint, data.ToPayload(true), &task); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error calling Post %s", endpoint), err.Error())
+		return
+	}
+
+	if err := waitEmailDomainTask(ctx, r.config, domain, emailDomainTaskKindResponder, task.Id); err != nil {
+		resp.Diagnostics.AddError("Error waiting for responder creation", err.Error())
+		return
+	}
+
+	endpoint = r.endpoint(&data)
+	if err := r.config.OVHClient.GetWithContext(ctx, endpoint, &responder); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error calling Get %s", endpoint), err.Error())
+		return
+	}
+
+	data.ID = ovhtypes.NewTfStringValue(domain + "/" + data.AccountName.ValueString())
+	data.MergeWith(&responder)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *emailDomainResponderResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var (
+		data      emailDomainResponderModel
+		responder EmailDomainResponder
+	)
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	endpoint := r.endpoint(&data)
+	if err := r.config.OVHClient.GetWithContext(ctx, endpoint, &responder); err != nil {
+		if isOvhApiNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(fmt.Sprintf("Error calling Get %s", endpoint), err.Error())
+		return
+	}
+
+	data.MergeWith(&responder)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *emailDomainResponderResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var (
+		data      emailDomainResponderModel
+		responder EmailDomainResponder
+	)
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	endpoint := r.endpoint(&data)
+	if err := r.config.OVHClient.PutWithContext(ctx, endpoint, data.ToPayload(false), nil); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error calling Put %s", endpoint), err.Error())
+		return
+	}
+
+	if err := r.config.OVHClient.GetWithContext(ctx, endpoint, &responder); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error calling Get %s", endpoint), err.Error())
+		return
+	}
+
+	data.MergeWith(&responder)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *emailDomainResponderResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var (
+		data emailDomainResponderModel
+		task EmailDomainTask
+	)
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	endpoint := r.endpoint(&data)
+	if err := r.config.OVHClient.DeleteWithContext(ctx, endpoint, &task); err != nil {
+		if isOvhApiNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError(fmt.Sprintf("Error calling Delete %s", endpoint), err.Error())
+		return
+	}
+
+	if err := waitEmailDomainTask(ctx, r.config, data.Domain.ValueString(), emailDomainTaskKindResponder, task.Id); err != nil {
+		resp.Diagnostics.AddError("Error waiting for responder deletion", err.Error())
+	}
+}
@@ -0,0 +1,56 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccEmailDomainResponder_basic(t *testing.T) {
+	domain := os.Getenv("OVH_EMAIL_DOMAIN_TEST")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckEmailDomain(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEmailDomainResponderConfig(domain, "Out of office"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ovh_email_domain_responder.test", "domain", domain),
+					resource.TestCheckResourceAttr("ovh_email_domain_responder.test", "account_name", "tfacc-responder"),
+					resource.TestCheckResourceAttr("ovh_email_domain_responder.test", "content", "Out of office"),
+					resource.TestCheckResourceAttr("ovh_email_domain_responder.test", "id", domain+"/tfacc-responder"),
+				),
+			},
+			{
+				Config: testAccEmailDomainResponderConfig(domain, "Back next week"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ovh_email_domain_responder.test", "content", "Back next week"),
+				),
+			},
+			{
+				ResourceName:      "ovh_email_domain_responder.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccEmailDomainResponderConfig(domain, content string) string {
+	return fmt.Sprintf(`
+resource "ovh_email_domain_account" "test" {
+  domain       = %[1]q
+  account_name = "tfacc-responder"
+  password     = "P@ssw0rd1234!"
+}
+
+resource "ovh_email_domain_responder" "test" {
+  domain       = ovh_email_domain_account.test.domain
+  account_name = ovh_email_domain_account.test.account_name
+  content      = %[2]q
+  copy         = true
+}`, domain, content)
+}
@@ -0,0 +1,386 @@
+package ovh
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	ovhtypes "github.com/ovh/terraform-provider-ovh/v2/ovh/types"
+)
+
+var _ resource.ResourceWithConfigure = (*emailExchangeAccountResource)(nil)
+var _ resource.ResourceWithImportState = (*emailExchangeAccountResource)(nil)
+
+func NewEmailExchangeAccountResource() resource.Resource {
+	return &emailExchangeAccountResource{}
+}
+
+type emailExchangeAccountResource struct {
+	config *Config
+}
+
+func (r *emailExchangeAccountResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_email_exchange_account"
+}
+
+func (r *emailExchangeAccountResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.config = config
+}
+
+func (r *emailExchangeAccountResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Manages a user mailbox of an OVHcloud Exchange service.",
+		MarkdownDescription: "Manages a user mailbox of an OVHcloud Exchange service.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				CustomType:  ovhtypes.TfStringType{},
+				Computed:    true,
+				Description: "Unique identifier for the resource",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization_name": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Required:            true,
+				Description:         "Name of the Exchange organization",
+				MarkdownDescription: "Name of the Exchange organization",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"exchange_service": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Required:            true,
+				Description:         "Name of the Exchange service",
+				MarkdownDescription: "Name of the Exchange service",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"login": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Required:            true,
+				Description:         "Login of the account, i.e. the local part of its email address",
+				MarkdownDescription: "Login of the account, i.e. the local part of its email address",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"domain": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Required:            true,
+				Description:         "Domain of the account email address. The domain must be attached to the service",
+				MarkdownDescription: "Domain of the account email address. The domain must be attached to the service",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"license": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Required:            true,
+				Description:         "License of the account (basic, standard, enterprise)",
+				MarkdownDescription: "License of the account (`basic`, `standard`, `enterprise`)",
+				Validators: []validator.String{
+					stringvalidator.OneOf("basic", "standard", "enterprise"),
+				},
+			},
+			"password": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Required:            true,
+				Sensitive:           true,
+				Description:         "Password of the account",
+				MarkdownDescription: "Password of the account",
+			},
+			"display_name": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Optional:            true,
+				Description:         "Display name of the account",
+				MarkdownDescription: "Display name of the account",
+			},
+			"first_name": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Optional:            true,
+				Description:         "First name of the account user",
+				MarkdownDescription: "First name of the account user",
+			},
+			"last_name": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Optional:            true,
+				Description:         "Last name of the account user",
+				MarkdownDescription: "Last name of the account user",
+			},
+			"hidden_from_gal": schema.BoolAttribute{
+				CustomType:          ovhtypes.TfBoolType{},
+				Optional:            true,
+				Computed:            true,
+				Description:         "Hide the account from the Global Address List",
+				MarkdownDescription: "Hide the account from the Global Address List",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"aliases": schema.SetAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Description:         "Alias email addresses of the account",
+				MarkdownDescription: "Alias email addresses of the account",
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"primary_email_address": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Computed:            true,
+				Description:         "Primary email address of the account",
+				MarkdownDescription: "Primary email address of the account",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"quota": schema.Int64Attribute{
+				CustomType:          ovhtypes.TfInt64Type{},
+				Optional:            true,
+				Computed:            true,
+				Description:         "Size of the mailbox in MB, depending on the license",
+				MarkdownDescription: "Size of the mailbox in MB, depending on the license",
+			},
+			"current_usage": schema.Int64Attribute{
+				CustomType:          ovhtypes.TfInt64Type{},
+				Computed:            true,
+				Description:         "Space used by the mailbox in MB",
+				MarkdownDescription: "Space used by the mailbox in MB",
+			},
+			"state": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Computed:            true,
+				Description:         "State of the account",
+				MarkdownDescription: "State of the account",
+			},
+		},
+	}
+}
+
+func (r *emailExchangeAccountResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	splits, ok := splitImportID(req.ID, 3)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Given ID is malformed",
+			"import ID must be formatted as organization_name/exchange_service/primary_email_address",
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_name"), splits[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("exchange_service"), splits[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), splits[2])...)
+}
+
+func (r *emailExchangeAccountResource) read(ctx context.Context, data *emailExchangeAccountModel) error {
+	var account EmailExchangeAccount
+
+	endpoint := emailExchangeServiceEndpoint(data.OrganizationName.ValueString(), data.ExchangeService.ValueString()) +
+		"/account/" + url.PathEscape(data.ID.ValueString())
+	if err := r.config.OVHClient.GetWithContext(ctx, endpoint, &account); err != nil {
+		return err
+	}
+
+	aliases, err := emailExchangeGetAliases(ctx, r.config, endpoint)
+	if err != nil {
+		return err
+	}
+
+	data.MergeWith(&account, aliases)
+
+	return nil
+}
+
+func (r *emailExchangeAccountResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var (
+		data emailExchangeAccountModel
+		task EmailExchangeTask
+	)
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	aliases, diags := emailExchangeAliasesFromSet(ctx, data.Aliases)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serviceEndpoint := emailExchangeServiceEndpoint(data.OrganizationName.ValueString(), data.ExchangeService.ValueString())
+	endpoint := serviceEndpoint + "/account"
+	if err := r.config.OVHClient.PostWithContext(ctx, endpoint, data.ToCreate(), &task); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error calling Post %s", endpoint), err.Error())
+		return
+	}
+
+	if err := waitEmailExchangeTask(ctx, r.config, serviceEndpoint, task.Id); err != nil {
+		resp.Diagnostics.AddError("Error waiting for account creation", err.Error())
+		return
+	}
+
+	data.ID = ovhtypes.NewTfStringValue(data.Login.ValueString() + "@" + data.Domain.ValueString())
+
+	// Save the ID right away so that the account is tracked even if the aliases fail
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), data.ID)...)
+
+	accountEndpoint := endpoint + "/" + url.PathEscape(data.ID.ValueString())
+
+	// The quota can't be set when creating the account, it defaults to the one of the license
+	if !data.Quota.IsNull() && !data.Quota.IsUnknown() {
+		if err := r.config.OVHClient.PutWithContext(ctx, accountEndpoint, data.ToUpdate(), nil); err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Error calling Put %s", accountEndpoint), err.Error())
+			return
+		}
+	}
+
+	if err := emailExchangeSyncAliases(ctx, r.config, serviceEndpoint, accountEndpoint, nil, aliases); err != nil {
+		resp.Diagnostics.AddError("Error adding account aliases", err.Error())
+		return
+	}
+
+	if err := r.read(ctx, &data); err != nil {
+		resp.Diagnostics.AddError("Error reading account", err.Error())
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *emailExchangeAccountResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data emailExchangeAccountModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.read(ctx, &data); err != nil {
+		if isOvhApiNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading account", err.Error())
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *emailExchangeAccountResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, stateData emailExchangeAccountModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &stateData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serviceEndpoint := emailExchangeServiceEndpoint(data.OrganizationName.ValueString(), data.ExchangeService.ValueString())
+	endpoint := serviceEndpoint + "/account/" + url.PathEscape(stateData.ID.ValueString())
+
+	if err := r.config.OVHClient.PutWithContext(ctx, endpoint, data.ToUpdate(), nil); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error calling Put %s", endpoint), err.Error())
+		return
+	}
+
+	if !data.Password.Equal(stateData.Password) {
+		var task EmailExchangeTask
+
+		passwordEndpoint := endpoint + "/changePassword"
+		if err := r.config.OVHClient.PostWithContext(ctx, passwordEndpoint, map[string]string{"password": data.Password.ValueString()}, &task); err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Error calling Post %s", passwordEndpoint), err.Error())
+			return
+		}
+
+		if err := waitEmailExchangeTask(ctx, r.config, serviceEndpoint, task.Id); err != nil {
+			resp.Diagnostics.AddError("Error waiting for password update", err.Error())
+			return
+		}
+	}
+
+	if !data.Aliases.IsUnknown() {
+		current, diags := emailExchangeAliasesFromSet(ctx, stateData.Aliases)
+		resp.Diagnostics.Append(diags...)
+		wanted, diags := emailExchangeAliasesFromSet(ctx, data.Aliases)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if err := emailExchangeSyncAliases(ctx, r.config, serviceEndpoint, endpoint, current, wanted); err != nil {
+			resp.Diagnostics.AddError("Error updating account aliases", err.Error())
+			return
+		}
+	}
+
+	data.ID = stateData.ID
+	if err := r.read(ctx, &data); err != nil {
+		resp.Diagnostics.AddError("Error reading account", err.Error())
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *emailExchangeAccountResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var (
+		data emailExchangeAccountModel
+		task EmailExchangeTask
+	)
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serviceEndpoint := emailExchangeServiceEndpoint(data.OrganizationName.ValueString(), data.ExchangeService.ValueString())
+	endpoint := serviceEndpoint + "/account/" + url.PathEscape(data.ID.ValueString())
+	if err := r.config.OVHClient.DeleteWithContext(ctx, endpoint, &task); err != nil {
+		if isOvhApiNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError(fmt.Sprintf("Error calling Delete %s", endpoint), err.Error())
+		return
+	}
+
+	if err := waitEmailExchangeTask(ctx, r.config, serviceEndpoint, task.Id); err != nil {
+		resp.Diagnostics.AddError("Error waiting for account deletion", err.Error())
+	}
+}
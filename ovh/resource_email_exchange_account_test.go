@@ -0,0 +1,77 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccEmailExchangeAccount_basic(t *testing.T) {
+	organization := os.Getenv("OVH_EMAIL_EXCHANGE_ORGANIZATION_TEST")
+	service := os.Getenv("OVH_EMAIL_EXCHANGE_SERVICE_TEST")
+	domain := os.Getenv("OVH_EMAIL_EXCHANGE_DOMAIN_TEST")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckEmailExchange(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEmailExchangeAccountConfig(organization, service, domain, "Test Account", `"tfacc-alias1@`+domain+`"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ovh_email_exchange_account.test", "primary_email_address", "tfacc-account@"+domain),
+					resource.TestCheckResourceAttr("ovh_email_exchange_account.test", "license", "basic"),
+					resource.TestCheckResourceAttr("ovh_email_exchange_account.test", "display_name", "Test Account"),
+					resource.TestCheckResourceAttr("ovh_email_exchange_account.test", "aliases.#", "1"),
+					resource.TestCheckResourceAttrSet("ovh_email_exchange_account.test", "quota"),
+				),
+			},
+			{
+				Config: testAccEmailExchangeAccountConfig(organization, service, domain, "Updated Account", `"tfacc-alias1@`+domain+`", "tfacc-alias2@`+domain+`"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ovh_email_exchange_account.test", "display_name", "Updated Account"),
+					resource.TestCheckResourceAttr("ovh_email_exchange_account.test", "aliases.#", "2"),
+				),
+			},
+			{
+				ResourceName:            "ovh_email_exchange_account.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password"},
+				ImportStateIdFunc:       testAccEmailExchangeImportId("ovh_email_exchange_account.test"),
+			},
+		},
+	})
+}
+
+func testAccEmailExchangeImportId(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		res, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf(
+			"%s/%s/%s",
+			res.Primary.Attributes["organization_name"],
+			res.Primary.Attributes["exchange_service"],
+			res.Primary.ID,
+		), nil
+	}
+}
+
+func testAccEmailExchangeAccountConfig(organization, service, domain, displayName, aliases string) string {
+	return fmt.Sprintf(`
+resource "ovh_email_exchange_account" "test" {
+  organization_name = %q
+  exchange_service  = %q
+  login             = "tfacc-account"
+  domain            = %q
+  license           = "basic"
+  password          = "P@ssw0rd1234!"
+  display_name      = %q
+  aliases           = [%s]
+}`, organization, service, domain, displayName, aliases)
+}
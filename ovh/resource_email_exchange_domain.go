@@ -0,0 +1,262 @@
+package ovh
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	ovhtypes "github.com/ovh/terraform-provider-ovh/v2/ovh/types"
+)
+
+var _ resource.ResourceWithConfigure = (*emailExchangeDomainResource)(nil)
+var _ resource.ResourceWithImportState = (*emailExchangeDomainResource)(nil)
+
+func NewEmailExchangeDomainResource() resource.Resource {
+	return &emailExchangeDomainResource{}
+}
+
+type emailExchangeDomainResource struct {
+	config *Config
+}
+
+func (r *emailExchangeDomainResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_email_exchange_domain"
+}
+
+func (r *emailExchangeDomainResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.config = config
+}
+
+func (r *emailExchangeDomainResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Attaches a domain to an OVHcloud Exchange service.",
+		MarkdownDescription: "Attaches a domain to an OVHcloud Exchange service.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				CustomType:  ovhtypes.TfStringType{},
+				Computed:    true,
+				Description: "Unique identifier for the resource",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization_name": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Required:            true,
+				Description:         "Name of the Exchange organization",
+				MarkdownDescription: "Name of the Exchange organization",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"exchange_service": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Required:            true,
+				Description:         "Name of the Exchange service",
+				MarkdownDescription: "Name of the Exchange service",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Required:            true,
+				Description:         "Name of the domain to attach",
+				MarkdownDescription: "Name of the domain to attach",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Required:            true,
+				Description:         "Type of the domain (authoritative, nonAuthoritative). Emails to unknown addresses of a non authoritative domain are relayed to mx_relay",
+				MarkdownDescription: "Type of the domain (`authoritative`, `nonAuthoritative`). Emails to unknown addresses of a non authoritative domain are relayed to `mx_relay`",
+				Validators: []validator.String{
+					stringvalidator.OneOf("authoritative", "nonAuthoritative"),
+				},
+			},
+			"mx_relay": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Optional:            true,
+				Description:         "Server emails to unknown addresses are relayed to, for non authoritative domains",
+				MarkdownDescription: "Server emails to unknown addresses are relayed to, for non authoritative domains",
+			},
+			"configure_mx": schema.BoolAttribute{
+				CustomType:          ovhtypes.TfBoolType{},
+				Optional:            true,
+				Description:         "Configure the MX records of the domain when attaching it. The domain must be managed by the same OVHcloud account",
+				MarkdownDescription: "Configure the MX records of the domain when attaching it. The domain must be managed by the same OVHcloud account",
+			},
+			"configure_autodiscover": schema.BoolAttribute{
+				CustomType:          ovhtypes.TfBoolType{},
+				Optional:            true,
+				Description:         "Configure the autodiscover record of the domain when attaching it. The domain must be managed by the same OVHcloud account",
+				MarkdownDescription: "Configure the autodiscover record of the domain when attaching it. The domain must be managed by the same OVHcloud account",
+			},
+			"state": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Computed:            true,
+				Description:         "State of the domain",
+				MarkdownDescription: "State of the domain",
+			},
+		},
+	}
+}
+
+func (r *emailExchangeDomainResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	splits, ok := splitImportID(req.ID, 3)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Given ID is malformed",
+			"import ID must be formatted as organization_name/exchange_service/name",
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_name"), splits[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("exchange_service"), splits[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), splits[2])...)
+}
+
+func (r *emailExchangeDomainResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var (
+		data emailExchangeDomainModel
+		task EmailExchangeTask
+	)
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serviceEndpoint := emailExchangeServiceEndpoint(data.OrganizationName.ValueString(), data.ExchangeService.ValueString())
+	endpoint := serviceEndpoint + "/domain"
+	if err := r.config.OVHClient.PostWithContext(ctx, endpoint, data.ToCreate(), &task); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error calling Post %s", endpoint), err.Error())
+		return
+	}
+
+	if err := waitEmailExchangeTask(ctx, r.config, serviceEndpoint, task.Id); err != nil {
+		resp.Diagnostics.AddError("Error waiting for domain creation", err.Error())
+		return
+	}
+
+	var responseData EmailExchangeDomain
+	endpoint = endpoint + "/" + url.PathEscape(data.Name.ValueString())
+	if err := r.config.OVHClient.GetWithContext(ctx, endpoint, &responseData); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error calling Get %s", endpoint), err.Error())
+		return
+	}
+
+	data.MergeWith(&responseData)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *emailExchangeDomainResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var (
+		data         emailExchangeDomainModel
+		responseData EmailExchangeDomain
+	)
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	endpoint := emailExchangeServiceEndpoint(data.OrganizationName.ValueString(), data.ExchangeService.ValueString()) + "/domain/" + url.PathEscape(data.ID.ValueString())
+	if err := r.config.OVHClient.GetWithContext(ctx, endpoint, &responseData); err != nil {
+		if isOvhApiNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(fmt.Sprintf("Error calling Get %s", endpoint), err.Error())
+		return
+	}
+
+	data.MergeWith(&responseData)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *emailExchangeDomainResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var (
+		data, stateData emailExchangeDomainModel
+		responseData    EmailExchangeDomain
+	)
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &stateData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	endpoint := emailExchangeServiceEndpoint(data.OrganizationName.ValueString(), data.ExchangeService.ValueString()) + "/domain/" + url.PathEscape(stateData.ID.ValueString())
+	if err := r.config.OVHClient.PutWithContext(ctx, endpoint, data.ToUpdate(), nil); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error calling Put %s", endpoint), err.Error())
+		return
+	}
+
+	if err := r.config.OVHClient.GetWithContext(ctx, endpoint, &responseData); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error calling Get %s", endpoint), err.Error())
+		return
+	}
+
+	data.MergeWith(&responseData)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *emailExchangeDomainResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var (
+		data emailExchangeDomainModel
+		task EmailExchangeTask
+	)
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serviceEndpoint := emailExchangeServiceEndpoint(data.OrganizationName.ValueString(), data.ExchangeService.ValueString())
+	endpoint := serviceEndpoint + "/domain/" + url.PathEscape(data.ID.ValueString())
+	if err := r.config.OVHClient.DeleteWithContext(ctx, endpoint, &task); err != nil {
+		if isOvhApiNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError(fmt.Sprintf("Error calling Delete %s", endpoint), err.Error())
+		return
+	}
+
+	if err := waitEmailExchangeTask(ctx, r.config, serviceEndpoint, task.Id); err != nil {
+		resp.Diagnostics.AddError("Error waiting for domain deletion", err.Error())
+	}
+}
@@ -0,0 +1,44 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccEmailExchangeDomain_basic(t *testing.T) {
+	organization := os.Getenv("OVH_EMAIL_EXCHANGE_ORGANIZATION_TEST")
+	service := os.Getenv("OVH_EMAIL_EXCHANGE_SERVICE_TEST")
+	domain := os.Getenv("OVH_EMAIL_EXCHANGE_DOMAIN_TEST")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckEmailExchange(t)
+			checkEnvOrSkip(t, "OVH_EMAIL_EXCHANGE_EXTRA_DOMAIN_TEST")
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEmailExchangeDomainConfig(organization, service, os.Getenv("OVH_EMAIL_EXCHANGE_EXTRA_DOMAIN_TEST"), domain),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ovh_email_exchange_domain.test", "type", "nonAuthoritative"),
+					resource.TestCheckResourceAttr("ovh_email_exchange_domain.test", "mx_relay", "mx."+domain),
+					resource.TestCheckResourceAttrSet("ovh_email_exchange_domain.test", "state"),
+				),
+			},
+		},
+	})
+}
+
+func testAccEmailExchangeDomainConfig(organization, service, name, relayDomain string) string {
+	return fmt.Sprintf(`
+resource "ovh_email_exchange_domain" "test" {
+  organization_name = %q
+  exchange_service  = %q
+  name              = %q
+  type              = "nonAuthoritative"
+  mx_relay          = "mx.%s"
+}`, organization, service, name, relayDomain)
+}
@@ -0,0 +1,268 @@
+package ovh
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	ovhtypes "github.com/ovh/terraform-provider-ovh/v2/ovh/types"
+)
+
+var _ resource.ResourceWithConfigure = (*emailExchangeExternalContactResource)(nil)
+var _ resource.ResourceWithImportState = (*emailExchangeExternalContactResource)(nil)
+
+func NewEmailExchangeExternalContactResource() resource.Resource {
+	return &emailExchangeExternalContactResource{}
+}
+
+type emailExchangeExternalContactResource struct {
+	config *Config
+}
+
+func (r *emailExchangeExternalContactResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_email_exchange_external_contact"
+}
+
+func (r *emailExchangeExternalContactResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.config = config
+}
+
+func (r *emailExchangeExternalContactResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Manages an external contact of an OVHcloud Exchange service.",
+		MarkdownDescription: "Manages an external contact of an OVHcloud Exchange service.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				CustomType:  ovhtypes.TfStringType{},
+				Computed:    true,
+				Description: "Unique identifier for the resource",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization_name": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Required:            true,
+				Description:         "Name of the Exchange organization",
+				MarkdownDescription: "Name of the Exchange organization",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"exchange_service": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Required:            true,
+				Description:         "Name of the Exchange service",
+				MarkdownDescription: "Name of the Exchange service",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"external_email_address": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Required:            true,
+				Description:         "Email address of the external contact",
+				MarkdownDescription: "Email address of the external contact",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"display_name": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Optional:            true,
+				Description:         "Display name of the external contact",
+				MarkdownDescription: "Display name of the external contact",
+			},
+			"first_name": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Optional:            true,
+				Description:         "First name of the external contact",
+				MarkdownDescription: "First name of the external contact",
+			},
+			"last_name": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Optional:            true,
+				Description:         "Last name of the external contact",
+				MarkdownDescription: "Last name of the external contact",
+			},
+			"initials": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Optional:            true,
+				Description:         "Initials of the external contact",
+				MarkdownDescription: "Initials of the external contact",
+			},
+			"hidden_from_gal": schema.BoolAttribute{
+				CustomType:          ovhtypes.TfBoolType{},
+				Optional:            true,
+				Computed:            true,
+				Description:         "Hide the external contact from the Global Address List",
+				MarkdownDescription: "Hide the external contact from the Global Address List",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"state": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Computed:            true,
+				Description:         "State of the external contact",
+				MarkdownDescription: "State of the external contact",
+			},
+		},
+	}
+}
+
+func (r *emailExchangeExternalContactResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	splits, ok := splitImportID(req.ID, 3)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Given ID is malformed",
+			"import ID must be formatted as organization_name/exchange_service/external_email_address",
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_name"), splits[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("exchange_service"), splits[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), splits[2])...)
+}
+
+func (r *emailExchangeExternalContactResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var (
+		data emailExchangeExternalContactModel
+		task EmailExchangeTask
+	)
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serviceEndpoint := emailExchangeServiceEndpoint(data.OrganizationName.ValueString(), data.ExchangeService.ValueString())
+	endpoint := serviceEndpoint + "/externalContact"
+	if err := r.config.OVHClient.PostWithContext(ctx, endpoint, data.ToOpts(true), &task); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error calling Post %s", endpoint), err.Error())
+		return
+	}
+
+	if err := waitEmailExchangeTask(ctx, r.config, serviceEndpoint, task.Id); err != nil {
+		resp.Diagnostics.AddError("Error waiting for external contact creation", err.Error())
+		return
+	}
+
+	var responseData EmailExchangeExternalContact
+	endpoint = endpoint + "/" + url.PathEscape(data.ExternalEmailAddress.ValueString())
+	if err := r.config.OVHClient.GetWithContext(ctx, endpoint, &responseData); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error calling Get %s", endpoint), err.Error())
+		return
+	}
+
+	data.MergeWith(&responseData)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *emailExchangeExternalContactResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var (
+		data         emailExchangeExternalContactModel
+		responseData EmailExchangeExternalContact
+	)
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	endpoint := emailExchangeServiceEndpoint(data.OrganizationName.ValueString(), data.ExchangeService.ValueString()) + "/externalContact/" + url.PathEscape(data.ID.ValueString())
+	if err := r.config.OVHClient.GetWithContext(ctx, endpoint, &responseData); err != nil {
+		if isOvhApiNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(fmt.Sprintf("Error calling Get %s", endpoint), err.Error())
+		return
+	}
+
+	data.MergeWith(&responseData)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *emailExchangeExternalContactResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var (
+		data, stateData emailExchangeExternalContactModel
+		responseData    EmailExchangeExternalContact
+	)
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &stateData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	endpoint := emailExchangeServiceEndpoint(data.OrganizationName.ValueString(), data.ExchangeService.ValueString()) + "/externalContact/" + url.PathEscape(stateData.ID.ValueString())
+	if err := r.config.OVHClient.PutWithContext(ctx, endpoint, data.ToOpts(false), nil); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error calling Put %s", endpoint), err.Error())
+		return
+	}
+
+	if err := r.config.OVHClient.GetWithContext(ctx, endpoint, &responseData); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error calling Get %s", endpoint), err.Error())
+		return
+	}
+
+	data.MergeWith(&responseData)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *emailExchangeExternalContactResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var (
+		data emailExchangeExternalContactModel
+		task EmailExchangeTask
+	)
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serviceEndpoint := emailExchangeServiceEndpoint(data.OrganizationName.ValueString(), data.ExchangeService.ValueString())
+	endpoint := serviceEndpoint + "/externalContact/" + url.PathEscape(data.ID.ValueString())
+	if err := r.config.OVHClient.DeleteWithContext(ctx, endpoint, &task); err != nil {
+		if isOvhApiNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError(fmt.Sprintf("Error calling Delete %s", endpoint), err.Error())
+		return
+	}
+
+	if err := waitEmailExchangeTask(ctx, r.config, serviceEndpoint, task.Id); err != nil {
+		resp.Diagnostics.AddError("Error waiting for external contact deletion", err.Error())
+	}
+}
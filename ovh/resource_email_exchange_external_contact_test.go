@@ -0,0 +1,52 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccEmailExchangeExternalContact_basic(t *testing.T) {
+	organization := os.Getenv("OVH_EMAIL_EXCHANGE_ORGANIZATION_TEST")
+	service := os.Getenv("OVH_EMAIL_EXCHANGE_SERVICE_TEST")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckEmailExchange(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEmailExchangeExternalContactConfig(organization, service, "John"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ovh_email_exchange_external_contact.test", "external_email_address", "tfacc-contact@example.com"),
+					resource.TestCheckResourceAttr("ovh_email_exchange_external_contact.test", "first_name", "John"),
+				),
+			},
+			{
+				Config: testAccEmailExchangeExternalContactConfig(organization, service, "Jane"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ovh_email_exchange_external_contact.test", "first_name", "Jane"),
+				),
+			},
+			{
+				ResourceName:      "ovh_email_exchange_external_contact.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccEmailExchangeImportId("ovh_email_exchange_external_contact.test"),
+			},
+		},
+	})
+}
+
+func testAccEmailExchangeExternalContactConfig(organization, service, firstName string) string {
+	return fmt.Sprintf(`
+resource "ovh_email_exchange_external_contact" "test" {
+  organization_name      = %q
+  exchange_service       = %q
+  external_email_address = "tfacc-contact@example.com"
+  display_name           = "External contact"
+  first_name             = %q
+  last_name              = "Doe"
+}`, organization, service, firstName)
+}
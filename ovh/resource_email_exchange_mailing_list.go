@@ -0,0 +1,301 @@
+package ovh
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	ovhtypes "github.com/ovh/terraform-provider-ovh/v2/ovh/types"
+)
+
+var _ resource.ResourceWithConfigure = (*emailExchangeMailingListResource)(nil)
+var _ resource.ResourceWithImportState = (*emailExchangeMailingListResource)(nil)
+
+func NewEmailExchangeMailingListResource() resource.Resource {
+	return &emailExchangeMailingListResource{}
+}
+
+type emailExchangeMailingListResource struct {
+	config *Config
+}
+
+func (r *emailExchangeMailingListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_email_exchange_mailing_list"
+}
+
+func (r *emailExchangeMailingListResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.config = config
+}
+
+func (r *emailExchangeMailingListResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Manages a distribution group of an OVHcloud Exchange service.",
+		MarkdownDescription: "Manages a distribution group of an OVHcloud Exchange service.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				CustomType:  ovhtypes.TfStringType{},
+				Computed:    true,
+				Description: "Unique identifier for the resource",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization_name": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Required:            true,
+				Description:         "Name of the Exchange organization",
+				MarkdownDescription: "Name of the Exchange organization",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"exchange_service": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Required:            true,
+				Description:         "Name of the Exchange service",
+				MarkdownDescription: "Name of the Exchange service",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"mailing_list_address": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Required:            true,
+				Description:         "Email address of the distribution group",
+				MarkdownDescription: "Email address of the distribution group",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"display_name": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Optional:            true,
+				Description:         "Display name of the distribution group",
+				MarkdownDescription: "Display name of the distribution group",
+			},
+			"depart_restriction": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Required:            true,
+				Description:         "Whether members can leave the group on their own (open, closed)",
+				MarkdownDescription: "Whether members can leave the group on their own (`open`, `closed`)",
+				Validators: []validator.String{
+					stringvalidator.OneOf("open", "closed"),
+				},
+			},
+			"join_restriction": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Required:            true,
+				Description:         "Whether users can join the group on their own (open, closed, approvalRequired)",
+				MarkdownDescription: "Whether users can join the group on their own (`open`, `closed`, `approvalRequired`)",
+				Validators: []validator.String{
+					stringvalidator.OneOf("open", "closed", "approvalRequired"),
+				},
+			},
+			"max_receive_size": schema.Int64Attribute{
+				CustomType:          ovhtypes.TfInt64Type{},
+				Optional:            true,
+				Computed:            true,
+				Description:         "Maximum size in MB of the emails received by the group",
+				MarkdownDescription: "Maximum size in MB of the emails received by the group",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"max_send_size": schema.Int64Attribute{
+				CustomType:          ovhtypes.TfInt64Type{},
+				Optional:            true,
+				Computed:            true,
+				Description:         "Maximum size in MB of the emails sent by the group",
+				MarkdownDescription: "Maximum size in MB of the emails sent by the group",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"sender_authentification": schema.BoolAttribute{
+				CustomType:          ovhtypes.TfBoolType{},
+				Optional:            true,
+				Computed:            true,
+				Description:         "Only accept emails from authenticated senders",
+				MarkdownDescription: "Only accept emails from authenticated senders",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"hidden_from_gal": schema.BoolAttribute{
+				CustomType:          ovhtypes.TfBoolType{},
+				Optional:            true,
+				Computed:            true,
+				Description:         "Hide the distribution group from the Global Address List",
+				MarkdownDescription: "Hide the distribution group from the Global Address List",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"state": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Computed:            true,
+				Description:         "State of the distribution group",
+				MarkdownDescription: "State of the distribution group",
+			},
+		},
+	}
+}
+
+func (r *emailExchangeMailingListResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	splits, ok := splitImportID(req.ID, 3)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Given ID is malformed",
+			"import ID must be formatted as organization_name/exchange_service/mailing_list_address",
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_name"), splits[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("exchange_service"), splits[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), splits[2])...)
+}
+
+func (r *emailExchangeMailingListResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var (
+		data emailExchangeMailingListModel
+		task EmailExchangeTask
+	)
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serviceEndpoint := emailExchangeServiceEndpoint(data.OrganizationName.ValueString(), data.ExchangeService.ValueString())
+	endpoint := serviceEndpoint + "/mailingList"
+	if err := r.config.OVHClient.PostWithContext(ctx, endpoint, data.ToOpts(true), &task); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error calling Post %s", endpoint), err.Error())
+		return
+	}
+
+	if err := waitEmailExchangeTask(ctx, r.config, serviceEndpoint, task.Id); err != nil {
+		resp.Diagnostics.AddError("Error waiting for distribution group creation", err.Error())
+		return
+	}
+
+	var responseData EmailExchangeMailingList
+	endpoint = endpoint + "/" + url.PathEscape(data.MailingListAddress.ValueString())
+	if err := r.config.OVHClient.GetWithContext(ctx, endpoint, &responseData); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error calling Get %s", endpoint), err.Error())
+		return
+	}
+
+	data.MergeWith(&responseData)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *emailExchangeMailingListResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var (
+		data         emailExchangeMailingListModel
+		responseData EmailExchangeMailingList
+	)
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	endpoint := emailExchangeServiceEndpoint(data.OrganizationName.ValueString(), data.ExchangeService.ValueString()) + "/mailingList/" + url.PathEscape(data.ID.ValueString())
+	if err := r.config.OVHClient.GetWithContext(ctx, endpoint, &responseData); err != nil {
+		if isOvhApiNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(fmt.Sprintf("Error calling Get %s", endpoint), err.Error())
+		return
+	}
+
+	data.MergeWith(&responseData)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *emailExchangeMailingListResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var (
+		data, stateData emailExchangeMailingListModel
+		responseData    EmailExchangeMailingList
+	)
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &stateData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	endpoint := emailExchangeServiceEndpoint(data.OrganizationName.ValueString(), data.ExchangeService.ValueString()) + "/mailingList/" + url.PathEscape(stateData.ID.ValueString())
+	if err := r.config.OVHClient.PutWithContext(ctx, endpoint, data.ToOpts(false), nil); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error calling Put %s", endpoint), err.Error())
+		return
+	}
+
+	if err := r.config.OVHClient.GetWithContext(ctx, endpoint, &responseData); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error calling Get %s", endpoint), err.Error())
+		return
+	}
+
+	data.MergeWith(&responseData)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *emailExchangeMailingListResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var (
+		data emailExchangeMailingListModel
+		task EmailExchangeTask
+	)
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serviceEndpoint := emailExchangeServiceEndpoint(data.OrganizationName.ValueString(), data.ExchangeService.ValueString())
+	endpoint := serviceEndpoint + "/mailingList/" + url.PathEscape(data.ID.ValueString())
+	if err := r.config.OVHClient.DeleteWithContext(ctx, endpoint, &task); err != nil {
+		if isOvhApiNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError(fmt.Sprintf("Error calling Delete %s", endpoint), err.Error())
+		return
+	}
+
+	if err := waitEmailExchangeTask(ctx, r.config, serviceEndpoint, task.Id); err != nil {
+		resp.Diagnostics.AddError("Error waiting for distribution group deletion", err.Error())
+	}
+}
@@ -0,0 +1,53 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccEmailExchangeMailingList_basic(t *testing.T) {
+	organization := os.Getenv("OVH_EMAIL_EXCHANGE_ORGANIZATION_TEST")
+	service := os.Getenv("OVH_EMAIL_EXCHANGE_SERVICE_TEST")
+	domain := os.Getenv("OVH_EMAIL_EXCHANGE_DOMAIN_TEST")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckEmailExchange(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEmailExchangeMailingListConfig(organization, service, domain, "closed"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ovh_email_exchange_mailing_list.test", "mailing_list_address", "tfacc-group@"+domain),
+					resource.TestCheckResourceAttr("ovh_email_exchange_mailing_list.test", "join_restriction", "closed"),
+				),
+			},
+			{
+				Config: testAccEmailExchangeMailingListConfig(organization, service, domain, "approvalRequired"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ovh_email_exchange_mailing_list.test", "join_restriction", "approvalRequired"),
+				),
+			},
+			{
+				ResourceName:      "ovh_email_exchange_mailing_list.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccEmailExchangeImportId("ovh_email_exchange_mailing_list.test"),
+			},
+		},
+	})
+}
+
+func testAccEmailExchangeMailingListConfig(organization, service, domain, joinRestriction string) string {
+	return fmt.Sprintf(`
+resource "ovh_email_exchange_mailing_list" "test" {
+  organization_name    = %q
+  exchange_service     = %q
+  mailing_list_address = "tfacc-group@%s"
+  display_name         = "Test group"
+  depart_restriction   = "open"
+  join_restriction     = %q
+}`, organization, service, domain, joinRestriction)
+}
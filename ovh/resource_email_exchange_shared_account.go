@@ -0,0 +1,274 @@
+package ovh
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	ovhtypes "github.com/ovh/terraform-provider-ovh/v2/ovh/types"
+)
+
+var _ resource.ResourceWithConfigure = (*emailExchangeSharedAccountResource)(nil)
+var _ resource.ResourceWithImportState = (*emailExchangeSharedAccountResource)(nil)
+
+func NewEmailExchangeSharedAccountResource() resource.Resource {
+	return &emailExchangeSharedAccountResource{}
+}
+
+type emailExchangeSharedAccountResource struct {
+	config *Config
+}
+
+func (r *emailExchangeSharedAccountResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_email_exchange_shared_account"
+}
+
+func (r *emailExchangeSharedAccountResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.config = config
+}
+
+func (r *emailExchangeSharedAccountResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Manages a shared mailbox of an OVHcloud Exchange service.",
+		MarkdownDescription: "Manages a shared mailbox of an OVHcloud Exchange service.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				CustomType:  ovhtypes.TfStringType{},
+				Computed:    true,
+				Description: "Unique identifier for the resource",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization_name": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Required:            true,
+				Description:         "Name of the Exchange organization",
+				MarkdownDescription: "Name of the Exchange organization",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"exchange_service": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Required:            true,
+				Description:         "Name of the Exchange service",
+				MarkdownDescription: "Name of the Exchange service",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"shared_email_address": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Required:            true,
+				Description:         "Email address of the shared account",
+				MarkdownDescription: "Email address of the shared account",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"display_name": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Optional:            true,
+				Description:         "Display name of the shared account",
+				MarkdownDescription: "Display name of the shared account",
+			},
+			"first_name": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Optional:            true,
+				Description:         "First name of the shared account",
+				MarkdownDescription: "First name of the shared account",
+			},
+			"last_name": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Optional:            true,
+				Description:         "Last name of the shared account",
+				MarkdownDescription: "Last name of the shared account",
+			},
+			"hidden_from_gal": schema.BoolAttribute{
+				CustomType:          ovhtypes.TfBoolType{},
+				Optional:            true,
+				Computed:            true,
+				Description:         "Hide the shared account from the Global Address List",
+				MarkdownDescription: "Hide the shared account from the Global Address List",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"quota": schema.Int64Attribute{
+				CustomType:          ovhtypes.TfInt64Type{},
+				Required:            true,
+				Description:         "Size of the shared mailbox in MB",
+				MarkdownDescription: "Size of the shared mailbox in MB",
+			},
+			"current_usage": schema.Int64Attribute{
+				CustomType:          ovhtypes.TfInt64Type{},
+				Computed:            true,
+				Description:         "Space used by the shared mailbox in MB",
+				MarkdownDescription: "Space used by the shared mailbox in MB",
+			},
+			"state": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Computed:            true,
+				Description:         "State of the shared account",
+				MarkdownDescription: "State of the shared account",
+			},
+		},
+	}
+}
+
+func (r *emailExchangeSharedAccountResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	splits, ok := splitImportID(req.ID, 3)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Given ID is malformed",
+			"import ID must be formatted as organization_name/exchange_service/shared_email_address",
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_name"), splits[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("exchange_service"), splits[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), splits[2])...)
+}
+
+func (r *emailExchangeSharedAccountResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var (
+		data emailExchangeSharedAccountModel
+		task EmailExchangeTask
+	)
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serviceEndpoint := emailExchangeServiceEndpoint(data.OrganizationName.ValueString(), data.ExchangeService.ValueString())
+	endpoint := serviceEndpoint + "/sharedAccount"
+	if err := r.config.OVHClient.PostWithContext(ctx, endpoint, data.ToOpts(true), &task); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error calling Post %s", endpoint), err.Error())
+		return
+	}
+
+	if err := waitEmailExchangeTask(ctx, r.config, serviceEndpoint, task.Id); err != nil {
+		resp.Diagnostics.AddError("Error waiting for shared account creation", err.Error())
+		return
+	}
+
+	var responseData EmailExchangeSharedAccount
+	endpoint = endpoint + "/" + url.PathEscape(data.SharedEmailAddress.ValueString())
+	if err := r.config.OVHClient.GetWithContext(ctx, endpoint, &responseData); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error calling Get %s", endpoint), err.Error())
+		return
+	}
+
+	data.MergeWith(&responseData)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *emailExchangeSharedAccountResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var (
+		data         emailExchangeSharedAccountModel
+		responseData EmailExchangeSharedAccount
+	)
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	endpoint := emailExchangeServiceEndpoint(data.OrganizationName.ValueString(), data.ExchangeService.ValueString()) + "/sharedAccount/" + url.PathEscape(data.ID.ValueString())
+	if err := r.config.OVHClient.GetWithContext(ctx, endpoint, &responseData); err != nil {
+		if isOvhApiNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(fmt.Sprintf("Error calling Get %s", endpoint), err.Error())
+		return
+	}
+
+	data.MergeWith(&responseData)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *emailExchangeSharedAccountResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var (
+		data, stateData emailExchangeSharedAccountModel
+		responseData    EmailExchangeSharedAccount
+	)
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &stateData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	endpoint := emailExchangeServiceEndpoint(data.OrganizationName.ValueString(), data.ExchangeService.ValueString()) + "/sharedAccount/" + url.PathEscape(stateData.ID.ValueString())
+	if err := r.config.OVHClient.PutWithContext(ctx, endpoint, data.ToOpts(false), nil); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error calling Put %s", endpoint), err.Error())
+		return
+	}
+
+	if err := r.config.OVHClient.GetWithContext(ctx, endpoint, &responseData); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error calling Get %s", endpoint), err.Error())
+		return
+	}
+
+	data.MergeWith(&responseData)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *emailExchangeSharedAccountResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var (
+		data emailExchangeSharedAccountModel
+		task EmailExchangeTask
+	)
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serviceEndpoint := emailExchangeServiceEndpoint(data.OrganizationName.ValueString(), data.ExchangeService.ValueString())
+	endpoint := serviceEndpoint + "/sharedAccount/" + url.PathEscape(data.ID.ValueString())
+	if err := r.config.OVHClient.DeleteWithContext(ctx, endpoint, &task); err != nil {
+		if isOvhApiNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError(fmt.Sprintf("Error calling Delete %s", endpoint), err.Error())
+		return
+	}
+
+	if err := waitEmailExchangeTask(ctx, r.config, serviceEndpoint, task.Id); err != nil {
+		resp.Diagnostics.AddError("Error waiting for shared account deletion", err.Error())
+	}
+}
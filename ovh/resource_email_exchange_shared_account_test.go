@@ -0,0 +1,52 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccEmailExchangeSharedAccount_basic(t *testing.T) {
+	organization := os.Getenv("OVH_EMAIL_EXCHANGE_ORGANIZATION_TEST")
+	service := os.Getenv("OVH_EMAIL_EXCHANGE_SERVICE_TEST")
+	domain := os.Getenv("OVH_EMAIL_EXCHANGE_DOMAIN_TEST")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckEmailExchange(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEmailExchangeSharedAccountConfig(organization, service, domain, 1024),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ovh_email_exchange_shared_account.test", "shared_email_address", "tfacc-shared@"+domain),
+					resource.TestCheckResourceAttr("ovh_email_exchange_shared_account.test", "quota", "1024"),
+				),
+			},
+			{
+				Config: testAccEmailExchangeSharedAccountConfig(organization, service, domain, 2048),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ovh_email_exchange_shared_account.test", "quota", "2048"),
+				),
+			},
+			{
+				ResourceName:      "ovh_email_exchange_shared_account.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccEmailExchangeImportId("ovh_email_exchange_shared_account.test"),
+			},
+		},
+	})
+}
+
+func testAccEmailExchangeSharedAccountConfig(organization, service, domain string, quota int) string {
+	return fmt.Sprintf(`
+resource "ovh_email_exchange_shared_account" "test" {
+  organization_name    = %q
+  exchange_service     = %q
+  shared_email_address = "tfacc-shared@%s"
+  display_name         = "Shared mailbox"
+  quota                = %d
+}`, organization, service, domain, quota)
+}
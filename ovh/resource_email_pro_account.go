@@ -0,0 +1,468 @@
+package ovh
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	ovhtypes "github.com/ovh/terraform-provider-ovh/v2/ovh/types"
+)
+
+var _ resource.ResourceWithConfigure = (*emailProAccountResource)(nil)
+var _ resource.ResourceWithImportState = (*emailProAccountResource)(nil)
+
+func NewEmailProAccountResource() resource.Resource {
+	return &emailProAccountResource{}
+}
+
+type emailProAccountResource struct {
+	config *Config
+}
+
+func (r *emailProAccountResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_email_pro_account"
+}
+
+func (r *emailProAccountResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.config = config
+}
+
+func (r *emailProAccountResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Configures a mailbox of an OVHcloud Email Pro service.",
+		MarkdownDescription: "Configures a mailbox of an OVHcloud Email Pro service.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				CustomType:  ovhtypes.TfStringType{},
+				Computed:    true,
+				Description: "Unique identifier for the resource",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"service_name": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Required:            true,
+				Description:         "Name of the Email Pro service",
+				MarkdownDescription: "Name of the Email Pro service",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"login": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Required:            true,
+				Description:         "Login of the account, i.e. the local part of its email address",
+				MarkdownDescription: "Login of the account, i.e. the local part of its email address",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"domain": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Required:            true,
+				Description:         "Domain of the account email address. The domain must be attached to the service",
+				MarkdownDescription: "Domain of the account email address. The domain must be attached to the service",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"password": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Required:            true,
+				Sensitive:           true,
+				Description:         "Password of the account",
+				MarkdownDescription: "Password of the account",
+			},
+			"display_name": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Optional:            true,
+				Description:         "Display name of the account",
+				MarkdownDescription: "Display name of the account",
+			},
+			"first_name": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Optional:            true,
+				Description:         "First name of the account user",
+				MarkdownDescription: "First name of the account user",
+			},
+			"last_name": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Optional:            true,
+				Description:         "Last name of the account user",
+				MarkdownDescription: "Last name of the account user",
+			},
+			"hidden_from_gal": schema.BoolAttribute{
+				CustomType:          ovhtypes.TfBoolType{},
+				Optional:            true,
+				Computed:            true,
+				Description:         "Hide the account from the Global Address List",
+				MarkdownDescription: "Hide the account from the Global Address List",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"aliases": schema.SetAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Description:         "Alias email addresses of the account",
+				MarkdownDescription: "Alias email addresses of the account",
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"primary_email_address": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Computed:            true,
+				Description:         "Primary email address of the account",
+				MarkdownDescription: "Primary email address of the account",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"quota": schema.Int64Attribute{
+				CustomType:          ovhtypes.TfInt64Type{},
+				Optional:            true,
+				Computed:            true,
+				Description:         "Size of the mailbox in MB",
+				MarkdownDescription: "Size of the mailbox in MB",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"current_usage": schema.Int64Attribute{
+				CustomType:          ovhtypes.TfInt64Type{},
+				Computed:            true,
+				Description:         "Space used by the mailbox in MB",
+				MarkdownDescription: "Space used by the mailbox in MB",
+			},
+			"state": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Computed:            true,
+				Description:         "State of the account",
+				MarkdownDescription: "State of the account",
+			},
+		},
+	}
+}
+
+func (r *emailProAccountResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	splits, ok := splitImportID(req.ID, 2)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Given ID is malformed",
+			"import ID must be formatted as service_name/primary_email_address",
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("service_name"), splits[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), splits[1])...)
+}
+
+func (r *emailProAccountResource) read(ctx context.Context, data *emailProAccountModel) error {
+	var account EmailProAccount
+
+	endpoint := emailProServiceEndpoint(data.ServiceName.ValueString()) +
+		"/account/" + url.PathEscape(data.ID.ValueString())
+	if err := r.config.OVHClient.GetWithContext(ctx, endpoint, &account); err != nil {
+		return err
+	}
+
+	aliases, err := emailExchangeGetAliases(ctx, r.config, endpoint)
+	if err != nil {
+		return err
+	}
+
+	data.MergeWith(&account, aliases)
+
+	return nil
+}
+
+// emailProAccountSlots tracks the unconfigured accounts picked by the accounts being
+// created. An account keeps its unconfigured address until its configuration is done,
+// so concurrent creations on a service must not pick it again meanwhile.
+var emailProAccountSlots = &emailProAccountSlotClaims{claimed: make(map[string]struct{})}
+
+type emailProAccountSlotClaims struct {
+	sync.Mutex
+	claimed map[string]struct{}
+}
+
+// claim returns the first unconfigured address of the service that isn't claimed yet,
+// and claims it until it is released. The lock must be held.
+func (c *emailProAccountSlotClaims) claim(serviceEndpoint string, addresses []string) (string, bool) {
+	for _, address := range addresses {
+		if !isEmailProAccountUnconfigured(address) {
+			continue
+		}
+		key := serviceEndpoint + "/" + address
+		if _, ok := c.claimed[key]; ok {
+			continue
+		}
+		c.claimed[key] = struct{}{}
+		return address, true
+	}
+
+	return "", false
+}
+
+func (c *emailProAccountSlotClaims) release(serviceEndpoint, address string) {
+	c.Lock()
+	defer c.Unlock()
+	delete(c.claimed, serviceEndpoint+"/"+address)
+}
+
+// claimUnconfiguredAccount returns the address of an ordered account of the service
+// that is not configured yet nor being configured. It must be released once the
+// account is configured.
+func (r *emailProAccountResource) claimUnconfiguredAccount(ctx context.Context, serviceEndpoint string) (string, error) {
+	var addresses []string
+
+	// The accounts are listed under the lock so that an account released meanwhile
+	// is listed under its new address
+	emailProAccountSlots.Lock()
+	defer emailProAccountSlots.Unlock()
+
+	endpoint := serviceEndpoint + "/account"
+	if err := r.config.OVHClient.GetWithContext(ctx, endpoint, &addresses); err != nil {
+		return "", fmt.Errorf("error calling Get %s: %w", endpoint, err)
+	}
+
+	if address, ok := emailProAccountSlots.claim(serviceEndpoint, addresses); ok {
+		return address, nil
+	}
+
+	return "", fmt.Errorf("no unconfigured account left on service %s, order new accounts first", serviceEndpoint)
+}
+
+// waitAccountConfigured waits for a newly configured account to be available under its new address.
+func (r *emailProAccountResource) waitAccountConfigured(ctx context.Context, endpoint string) error {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{"pending"},
+		Target:  []string{"ok"},
+		Refresh: func() (interface{}, string, error) {
+			var account EmailProAccount
+			if err := r.config.OVHClient.GetWithContext(ctx, endpoint, &account); err != nil {
+				if isOvhApiNotFound(err) {
+					return account, "pending", nil
+				}
+				return nil, "error", err
+			}
+			if account.State != "ok" {
+				return account, "pending", nil
+			}
+			return account, "ok", nil
+		},
+		Timeout:    30 * time.Minute,
+		Delay:      10 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+	return err
+}
+
+func (r *emailProAccountResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var (
+		data emailProAccountModel
+		task EmailExchangeTask
+	)
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	aliases, diags := emailExchangeAliasesFromSet(ctx, data.Aliases)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Email Pro accounts can't be created through the API, they are ordered and then
+	// configured. Pick the first account that wasn't configured yet.
+	serviceEndpoint := emailProServiceEndpoint(data.ServiceName.ValueString())
+	slot, err := r.claimUnconfiguredAccount(ctx, serviceEndpoint)
+	if err != nil {
+		resp.Diagnostics.AddError("Error finding an account to configure", err.Error())
+		return
+	}
+	defer emailProAccountSlots.release(serviceEndpoint, slot)
+
+	endpoint := serviceEndpoint + "/account/" + url.PathEscape(slot)
+	if err := r.config.OVHClient.PutWithContext(ctx, endpoint, data.ToUpdate(true), nil); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error calling Put %s", endpoint), err.Error())
+		return
+	}
+
+	data.ID = ovhtypes.NewTfStringValue(data.Login.ValueString() + "@" + data.Domain.ValueString())
+
+	// Save the ID right away so that the account is tracked even if the next steps fail
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), data.ID)...)
+
+	endpoint = serviceEndpoint + "/account/" + url.PathEscape(data.ID.ValueString())
+	if err := r.waitAccountConfigured(ctx, endpoint); err != nil {
+		resp.Diagnostics.AddError("Error waiting for account configuration", err.Error())
+		return
+	}
+
+	passwordEndpoint := endpoint + "/changePassword"
+	if err := r.config.OVHClient.PostWithContext(ctx, passwordEndpoint, map[string]string{"password": data.Password.ValueString()}, &task); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error calling Post %s", passwordEndpoint), err.Error())
+		return
+	}
+
+	if err := waitEmailExchangeTask(ctx, r.config, serviceEndpoint, task.Id); err != nil {
+		resp.Diagnostics.AddError("Error waiting for password configuration", err.Error())
+		return
+	}
+
+	if err := emailExchangeSyncAliases(ctx, r.config, serviceEndpoint, endpoint, nil, aliases); err != nil {
+		resp.Diagnostics.AddError("Error adding account aliases", err.Error())
+		return
+	}
+
+	if err := r.read(ctx, &data); err != nil {
+		resp.Diagnostics.AddError("Error reading account", err.Error())
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *emailProAccountResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data emailProAccountModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.read(ctx, &data); err != nil {
+		if isOvhApiNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading account", err.Error())
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *emailProAccountResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, stateData emailProAccountModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &stateData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serviceEndpoint := emailProServiceEndpoint(data.ServiceName.ValueString())
+	endpoint := serviceEndpoint + "/account/" + url.PathEscape(stateData.ID.ValueString())
+
+	if err := r.config.OVHClient.PutWithContext(ctx, endpoint, data.ToUpdate(false), nil); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error calling Put %s", endpoint), err.Error())
+		return
+	}
+
+	if !data.Password.Equal(stateData.Password) {
+		var task EmailExchangeTask
+
+		passwordEndpoint := endpoint + "/changePassword"
+		if err := r.config.OVHClient.PostWithContext(ctx, passwordEndpoint, map[string]string{"password": data.Password.ValueString()}, &task); err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Error calling Post %s", passwordEndpoint), err.Error())
+			return
+		}
+
+		if err := waitEmailExchangeTask(ctx, r.config, serviceEndpoint, task.Id); err != nil {
+			resp.Diagnostics.AddError("Error waiting for password update", err.Error())
+			return
+		}
+	}
+
+	if !data.Aliases.IsUnknown() {
+		current, diags := emailExchangeAliasesFromSet(ctx, stateData.Aliases)
+		resp.Diagnostics.Append(diags...)
+		wanted, diags := emailExchangeAliasesFromSet(ctx, data.Aliases)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if err := emailExchangeSyncAliases(ctx, r.config, serviceEndpoint, endpoint, current, wanted); err != nil {
+			resp.Diagnostics.AddError("Error updating account aliases", err.Error())
+			return
+		}
+	}
+
+	data.ID = stateData.ID
+	if err := r.read(ctx, &data); err != nil {
+		resp.Diagnostics.AddError("Error reading account", err.Error())
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *emailProAccountResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var (
+		data emailProAccountModel
+		task EmailExchangeTask
+	)
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serviceEndpoint := emailProServiceEndpoint(data.ServiceName.ValueString())
+	endpoint := serviceEndpoint + "/account/" + url.PathEscape(data.ID.ValueString())
+	// Deleting an Email Pro account resets it to an unconfigured account, it is not cancelled
+	if err := r.config.OVHClient.DeleteWithContext(ctx, endpoint, &task); err != nil {
+		if isOvhApiNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError(fmt.Sprintf("Error calling Delete %s", endpoint), err.Error())
+		return
+	}
+
+	if err := waitEmailExchangeTask(ctx, r.config, serviceEndpoint, task.Id); err != nil {
+		resp.Diagnostics.AddError("Error waiting for account reset", err.Error())
+	}
+}
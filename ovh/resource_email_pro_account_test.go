@@ -0,0 +1,119 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestEmailProAccountSlotClaims(t *testing.T) {
+	slots := &emailProAccountSlotClaims{claimed: make(map[string]struct{})}
+	addresses := []string{
+		"configured@example.com",
+		"abc123@configureme.me",
+		"def456@configureme.me",
+		"ghi789@configureme.me",
+	}
+
+	// Concurrent creations on a service pick distinct accounts
+	var (
+		wg      sync.WaitGroup
+		mu      sync.Mutex
+		claimed = map[string]int{}
+	)
+	for i := 0; i < 3; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			slots.Lock()
+			address, ok := slots.claim("/email/pro/service-1", addresses)
+			slots.Unlock()
+			if !ok {
+				t.Error("expected an unconfigured account to be claimed")
+				return
+			}
+			mu.Lock()
+			claimed[address]++
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+
+	if len(claimed) != 3 {
+		t.Fatalf("expected 3 distinct accounts to be claimed, got %v", claimed)
+	}
+	if _, ok := claimed["configured@example.com"]; ok {
+		t.Fatal("expected a configured account not to be claimed")
+	}
+
+	slots.Lock()
+	_, ok := slots.claim("/email/pro/service-1", addresses)
+	slots.Unlock()
+	if ok {
+		t.Fatal("expected no account left to claim")
+	}
+
+	// Accounts of another service are claimed independently
+	slots.Lock()
+	address, ok := slots.claim("/email/pro/service-2", addresses)
+	slots.Unlock()
+	if !ok || address != "abc123@configureme.me" {
+		t.Fatalf("expected the first account of another service to be claimed, got %q", address)
+	}
+
+	// A released account can be claimed again
+	slots.release("/email/pro/service-1", "def456@configureme.me")
+	slots.Lock()
+	address, ok = slots.claim("/email/pro/service-1", addresses)
+	slots.Unlock()
+	if !ok || address != "def456@configureme.me" {
+		t.Fatalf("expected the released account to be claimed again, got %q", address)
+	}
+}
+
+func TestAccEmailProAccount_basic(t *testing.T) {
+	service := os.Getenv("OVH_EMAIL_PRO_SERVICE_TEST")
+	domain := os.Getenv("OVH_EMAIL_PRO_DOMAIN_TEST")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckEmailPro(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEmailProAccountConfig(service, domain, "Test Account", "P@ssw0rd1234!"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ovh_email_pro_account.test", "primary_email_address", "tfacc-pro@"+domain),
+					resource.TestCheckResourceAttr("ovh_email_pro_account.test", "display_name", "Test Account"),
+					resource.TestCheckResourceAttr("ovh_email_pro_account.test", "state", "ok"),
+				),
+			},
+			{
+				Config: testAccEmailProAccountConfig(service, domain, "Updated Account", "N3wP@ssw0rd5678!"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ovh_email_pro_account.test", "display_name", "Updated Account"),
+				),
+			},
+			{
+				ResourceName:            "ovh_email_pro_account.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password"},
+				ImportStateId:           service + "/tfacc-pro@" + domain,
+			},
+		},
+	})
+}
+
+func testAccEmailProAccountConfig(service, domain, displayName, password string) string {
+	return fmt.Sprintf(`
+resource "ovh_email_pro_account" "test" {
+  service_name = %q
+  login        = "tfacc-pro"
+  domain       = %q
+  password     = %q
+  display_name = %q
+}`, service, domain, password, displayName)
+}
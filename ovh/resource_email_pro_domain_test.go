@@ -0,0 +1,56 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccEmailProDomain_basic(t *testing.T) {
+	service := os.Getenv("OVH_EMAIL_PRO_SERVICE_TEST")
+	domain := os.Getenv("OVH_EMAIL_PRO_DOMAIN_TEST")
+	extraDomain := os.Getenv("OVH_EMAIL_PRO_EXTRA_DOMAIN_TEST")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckEmailPro(t)
+			checkEnvOrSkip(t, "OVH_EMAIL_PRO_EXTRA_DOMAIN_TEST")
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEmailProDomainConfig(service, extraDomain, "mx."+domain),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ovh_email_pro_domain.test", "name", extraDomain),
+					resource.TestCheckResourceAttr("ovh_email_pro_domain.test", "type", "nonAuthoritative"),
+					resource.TestCheckResourceAttr("ovh_email_pro_domain.test", "mx_relay", "mx."+domain),
+					resource.TestCheckResourceAttrSet("ovh_email_pro_domain.test", "state"),
+				),
+			},
+			{
+				Config: testAccEmailProDomainConfig(service, extraDomain, "mx2."+domain),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ovh_email_pro_domain.test", "mx_relay", "mx2."+domain),
+				),
+			},
+			{
+				ResourceName:      "ovh_email_pro_domain.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     service + "/" + extraDomain,
+			},
+		},
+	})
+}
+
+func testAccEmailProDomainConfig(service, name, mxRelay string) string {
+	return fmt.Sprintf(`
+resource "ovh_email_pro_domain" "test" {
+  service_name = %q
+  name         = %q
+  type         = "nonAuthoritative"
+  mx_relay     = %q
+}`, service, name, mxRelay)
+}
@@ -0,0 +1,50 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccEmailProExternalContact_basic(t *testing.T) {
+	service := os.Getenv("OVH_EMAIL_PRO_SERVICE_TEST")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckEmailPro(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEmailProExternalContactConfig(service, "John"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ovh_email_pro_external_contact.test", "external_email_address", "tfacc-contact@example.com"),
+					resource.TestCheckResourceAttr("ovh_email_pro_external_contact.test", "first_name", "John"),
+				),
+			},
+			{
+				Config: testAccEmailProExternalContactConfig(service, "Jane"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ovh_email_pro_external_contact.test", "first_name", "Jane"),
+				),
+			},
+			{
+				ResourceName:      "ovh_email_pro_external_contact.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     service + "/tfacc-contact@example.com",
+			},
+		},
+	})
+}
+
+func testAccEmailProExternalContactConfig(service, firstName string) string {
+	return fmt.Sprintf(`
+resource "ovh_email_pro_external_contact" "test" {
+  service_name           = %q
+  external_email_address = "tfacc-contact@example.com"
+  display_name           = "External contact"
+  first_name             = %q
+  last_name              = "Doe"
+}`, service, firstName)
+}
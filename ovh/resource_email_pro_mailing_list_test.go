@@ -0,0 +1,51 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccEmailProMailingList_basic(t *testing.T) {
+	service := os.Getenv("OVH_EMAIL_PRO_SERVICE_TEST")
+	domain := os.Getenv("OVH_EMAIL_PRO_DOMAIN_TEST")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckEmailPro(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEmailProMailingListConfig(service, domain, "closed"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ovh_email_pro_mailing_list.test", "mailing_list_address", "tfacc-group@"+domain),
+					resource.TestCheckResourceAttr("ovh_email_pro_mailing_list.test", "join_restriction", "closed"),
+				),
+			},
+			{
+				Config: testAccEmailProMailingListConfig(service, domain, "approvalRequired"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ovh_email_pro_mailing_list.test", "join_restriction", "approvalRequired"),
+				),
+			},
+			{
+				ResourceName:      "ovh_email_pro_mailing_list.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     service + "/tfacc-group@" + domain,
+			},
+		},
+	})
+}
+
+func testAccEmailProMailingListConfig(service, domain, joinRestriction string) string {
+	return fmt.Sprintf(`
+resource "ovh_email_pro_mailing_list" "test" {
+  service_name         = %q
+  mailing_list_address = "tfacc-group@%s"
+  display_name         = "Test group"
+  depart_restriction   = "open"
+  join_restriction     = %q
+}`, service, domain, joinRestriction)
+}
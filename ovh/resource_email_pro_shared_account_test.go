@@ -0,0 +1,50 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccEmailProSharedAccount_basic(t *testing.T) {
+	service := os.Getenv("OVH_EMAIL_PRO_SERVICE_TEST")
+	domain := os.Getenv("OVH_EMAIL_PRO_DOMAIN_TEST")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckEmailPro(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEmailProSharedAccountConfig(service, domain, 1024),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ovh_email_pro_shared_account.test", "shared_email_address", "tfacc-shared@"+domain),
+					resource.TestCheckResourceAttr("ovh_email_pro_shared_account.test", "quota", "1024"),
+				),
+			},
+			{
+				Config: testAccEmailProSharedAccountConfig(service, domain, 2048),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ovh_email_pro_shared_account.test", "quota", "2048"),
+				),
+			},
+			{
+				ResourceName:      "ovh_email_pro_shared_account.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     service + "/tfacc-shared@" + domain,
+			},
+		},
+	})
+}
+
+func testAccEmailProSharedAccountConfig(service, domain string, quota int) string {
+	return fmt.Sprintf(`
+resource "ovh_email_pro_shared_account" "test" {
+  service_name         = %q
+  shared_email_address = "tfacc-shared@%s"
+  display_name         = "Shared mailbox"
+  quota                = %d
+}`, service, domain, quota)
+}
@@ -105,20 +105,7 @@ func (r *ipFirewallRuleResource) Create(ctx context.Context, req resource.Create
 	)
 
 	// Wait for state to be ok
-	err := retry.RetryContext(ctx, 10*time.Minute, func() *retry.RetryError {
-		readErr := r.config.OVHClient.Get(endpoint, &responseData)
-		if readErr != nil {
-			return retry.NonRetryableError(readErr)
-		}
-
-		if responseData.State.ValueString() == "ok" {
-			return nil
-		}
-
-		return retry.RetryableError(errors.New("waiting for resource state to be ok"))
-	})
-
-	if err != nil {
+	if err := ipFirewallRuleWaitOk(ctx, r.config, endpoint, &responseData); err != nil {
 		resp.Diagnostics.AddError("error waiting status to be ok", err.Error())
 		return
 	}
@@ -159,23 +146,11 @@ func (r *ipFirewallRuleResource) Read(ctx context.Context, req resource.ReadRequ
 
 	// In case the resource is being imported, the fields `source_port` and `destination_port` are not returned
 	// by the API, so we must use fields `source_port_desc` and `destination_port_desc` to retrieve the values.
-	if responseData.SourcePort.IsNull() && strings.HasPrefix(responseData.SourcePortDesc.ValueString(), "eq ") {
-		port := strings.TrimPrefix(responseData.SourcePortDesc.ValueString(), "eq ")
-		portNumber, err := strconv.ParseInt(port, 10, 64)
-		if err != nil {
-			tflog.Warn(ctx, fmt.Sprintf("failed to parse source port from desc: %s", err))
-		} else {
-			responseData.SourcePort = ovhtypes.NewTfInt64Value(portNumber)
-		}
+	if responseData.SourcePort.IsNull() {
+		responseData.SourcePort = ipFirewallRulePortFromDesc(ctx, responseData.SourcePortDesc)
 	}
-	if responseData.DestinationPort.IsNull() && strings.HasPrefix(responseData.DestinationPortDesc.ValueString(), "eq ") {
-		port := strings.TrimPrefix(responseData.DestinationPortDesc.ValueString(), "eq ")
-		portNumber, err := strconv.ParseInt(port, 10, 64)
-		if err != nil {
-			tflog.Warn(ctx, fmt.Sprintf("failed to parse destination port from desc: %s", err))
-		} else {
-			responseData.DestinationPort = ovhtypes.NewTfInt64Value(portNumber)
-		}
+	if responseData.DestinationPort.IsNull() {
+		responseData.DestinationPort = ipFirewallRulePortFromDesc(ctx, responseData.DestinationPortDesc)
 	}
 
 	// Save updated data into Terraform state
@@ -210,8 +185,33 @@ func (r *ipFirewallRuleResource) Delete(ctx context.Context, req resource.Delete
 	}
 
 	// Wait for rule to be removed
-	err := retry.RetryContext(ctx, 10*time.Minute, func() *retry.RetryError {
-		readErr := r.config.OVHClient.Get(endpoint, nil)
+	if err := ipFirewallRuleWaitDeleted(ctx, r.config, endpoint); err != nil {
+		resp.Diagnostics.AddError("error verifying that resource was deleted", err.Error())
+	}
+}
+
+// ipFirewallRuleWaitOk waits for the firewall rule at the given endpoint to be
+// in state ok, and reads it in responseData
+func ipFirewallRuleWaitOk(ctx context.Context, config *Config, endpoint string, responseData *IpFirewallRuleResponseModel) error {
+	return retry.RetryContext(ctx, 10*time.Minute, func() *retry.RetryError {
+		readErr := config.OVHClient.Get(endpoint, responseData)
+		if readErr != nil {
+			return retry.NonRetryableError(readErr)
+		}
+
+		if responseData.State.ValueString() == "ok" {
+			return nil
+		}
+
+		return retry.RetryableError(errors.New("waiting for resource state to be ok"))
+	})
+}
+
+// ipFirewallRuleWaitDeleted waits for the firewall rule at the given endpoint
+// to be removed
+func ipFirewallRuleWaitDeleted(ctx context.Context, config *Config, endpoint string) error {
+	return retry.RetryContext(ctx, 10*time.Minute, func() *retry.RetryError {
+		readErr := config.OVHClient.Get(endpoint, nil)
 		if readErr != nil {
 			if errOvh, ok := readErr.(*ovh.APIError); ok && errOvh.Code == 404 {
 				return nil
@@ -222,8 +222,21 @@ func (r *ipFirewallRuleResource) Delete(ctx context.Context, req resource.Delete
 
 		return retry.RetryableError(errors.New("waiting for resource to be removed"))
 	})
+}
 
+// ipFirewallRulePortFromDesc returns the port described by the given port
+// description, when it targets a single port (e.g. "eq 22")
+func ipFirewallRulePortFromDesc(ctx context.Context, desc ovhtypes.TfStringValue) ovhtypes.TfInt64Value {
+	if !strings.HasPrefix(desc.ValueString(), "eq ") {
+		return ovhtypes.NewTfInt64ValueNull()
+	}
+
+	port := strings.TrimPrefix(desc.ValueString(), "eq ")
+	portNumber, err := strconv.ParseInt(port, 10, 64)
 	if err != nil {
-		resp.Diagnostics.AddError("error verifying that resource was deleted", err.Error())
+		tflog.Warn(ctx, fmt.Sprintf("failed to parse port from desc: %s", err))
+		return ovhtypes.NewTfInt64ValueNull()
 	}
+
+	return ovhtypes.NewTfInt64Value(portNumber)
 }
@@ -0,0 +1,627 @@
+package ovh
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	ovhtypes "github.com/ovh/terraform-provider-ovh/v2/ovh/types"
+)
+
+// Maximum number of rules of an IP on firewall
+const ipFirewallMaxRules = 20
+
+var _ resource.ResourceWithConfigure = (*ipFirewallRulesetResource)(nil)
+var _ resource.ResourceWithImportState = (*ipFirewallRulesetResource)(nil)
+var _ resource.ResourceWithValidateConfig = (*ipFirewallRulesetResource)(nil)
+
+func NewIpFirewallRulesetResource() resource.Resource {
+	return &ipFirewallRulesetResource{}
+}
+
+type ipFirewallRulesetResource struct {
+	config *Config
+}
+
+type ipFirewallRulesetModel struct {
+	ID                          ovhtypes.TfStringValue       `tfsdk:"id"`
+	Ip                          ovhtypes.TfStringValue       `tfsdk:"ip"`
+	IpOnFirewall                ovhtypes.TfStringValue       `tfsdk:"ip_on_firewall"`
+	DisableFirewallDuringUpdate ovhtypes.TfBoolValue         `tfsdk:"disable_firewall_during_update"`
+	Rules                       []ipFirewallRulesetRuleModel `tfsdk:"rules"`
+}
+
+type ipFirewallRulesetRuleModel struct {
+	Sequence        ovhtypes.TfInt64Value  `tfsdk:"sequence"`
+	Action          ovhtypes.TfStringValue `tfsdk:"action"`
+	Protocol        ovhtypes.TfStringValue `tfsdk:"protocol"`
+	Source          ovhtypes.TfStringValue `tfsdk:"source"`
+	SourcePort      ovhtypes.TfInt64Value  `tfsdk:"source_port"`
+	DestinationPort ovhtypes.TfInt64Value  `tfsdk:"destination_port"`
+	TcpOption       ovhtypes.TfStringValue `tfsdk:"tcp_option"`
+	Fragments       ovhtypes.TfBoolValue   `tfsdk:"fragments"`
+}
+
+func (r *ipFirewallRulesetResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ip_firewall_ruleset"
+}
+
+func (r *ipFirewallRulesetResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.config = config
+}
+
+func (r *ipFirewallRulesetResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manage all the rules of an IP on the firewall as a whole",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				CustomType:  ovhtypes.TfStringType{},
+				Computed:    true,
+				Description: "Unique identifier for the resource",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"ip": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Required:            true,
+				Description:         "IP (v4 or v6) CIDR notation (e.g., 192.0.2.0/24)",
+				MarkdownDescription: "IP (v4 or v6) CIDR notation (e.g., 192.0.2.0/24)",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"ip_on_firewall": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Required:            true,
+				Description:         "IPv4 address (e.g., 192.0.2.0)",
+				MarkdownDescription: "IPv4 address (e.g., 192.0.2.0)",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"disable_firewall_during_update": schema.BoolAttribute{
+				CustomType:          ovhtypes.TfBoolType{},
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				Description:         "Disable the firewall while rules are replaced, so that the IP is never filtered by a partial set of rules. The firewall is enabled again once all the rules are applied",
+				MarkdownDescription: "Disable the firewall while rules are replaced, so that the IP is never filtered by a partial set of rules. The firewall is enabled again once all the rules are applied",
+			},
+			"rules": schema.ListNestedAttribute{
+				Required:            true,
+				Description:         "Rules of the IP on firewall. Rules that are not listed are removed",
+				MarkdownDescription: "Rules of the IP on firewall. Rules that are not listed are removed",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(ipFirewallMaxRules),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"sequence": schema.Int64Attribute{
+							CustomType:          ovhtypes.TfInt64Type{},
+							Required:            true,
+							Description:         "Sequence number of the rule, rules are evaluated by ascending sequence",
+							MarkdownDescription: "Sequence number of the rule, rules are evaluated by ascending sequence",
+							Validators: []validator.Int64{
+								int64validator.Between(0, ipFirewallMaxRules-1),
+							},
+						},
+						"action": schema.StringAttribute{
+							CustomType:          ovhtypes.TfStringType{},
+							Required:            true,
+							Description:         "Possible values for action",
+							MarkdownDescription: "Possible values for action",
+							Validators: []validator.String{
+								stringvalidator.OneOf("deny", "permit"),
+							},
+						},
+						"protocol": schema.StringAttribute{
+							CustomType:          ovhtypes.TfStringType{},
+							Required:            true,
+							Description:         "Possible values for protocol",
+							MarkdownDescription: "Possible values for protocol",
+							Validators: []validator.String{
+								stringvalidator.OneOf("ah", "esp", "gre", "icmp", "ipv4", "tcp", "udp"),
+							},
+						},
+						"source": schema.StringAttribute{
+							CustomType:          ovhtypes.TfStringType{},
+							Optional:            true,
+							Computed:            true,
+							Default:             stringdefault.StaticString("any"),
+							Description:         "IPv4 CIDR notation (e.g., 192.0.2.0/24)",
+							MarkdownDescription: "IPv4 CIDR notation (e.g., 192.0.2.0/24)",
+						},
+						"source_port": schema.Int64Attribute{
+							CustomType:          ovhtypes.TfInt64Type{},
+							Optional:            true,
+							Description:         "Source port for your rule. Only with TCP/UDP protocol",
+							MarkdownDescription: "Source port for your rule. Only with TCP/UDP protocol",
+						},
+						"destination_port": schema.Int64Attribute{
+							CustomType:          ovhtypes.TfInt64Type{},
+							Optional:            true,
+							Description:         "Destination port for your rule. Only with TCP/UDP protocol",
+							MarkdownDescription: "Destination port for your rule. Only with TCP/UDP protocol",
+						},
+						"tcp_option": schema.StringAttribute{
+							CustomType:          ovhtypes.TfStringType{},
+							Optional:            true,
+							Description:         "TCP option on your rule",
+							MarkdownDescription: "TCP option on your rule",
+							Validators: []validator.String{
+								stringvalidator.OneOf("established", "syn"),
+							},
+						},
+						"fragments": schema.BoolAttribute{
+							CustomType:          ovhtypes.TfBoolType{},
+							Optional:            true,
+							Computed:            true,
+							Default:             booldefault.StaticBool(false),
+							Description:         "Fragments option",
+							MarkdownDescription: "Fragments option",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *ipFirewallRulesetResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var (
+		rulesList types.List
+		rules     []ipFirewallRulesetRuleModel
+	)
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("rules"), &rulesList)...)
+	if resp.Diagnostics.HasError() || rulesList.IsNull() || rulesList.IsUnknown() {
+		return
+	}
+
+	resp.Diagnostics.Append(rulesList.ElementsAs(ctx, &rules, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(ipFirewallRulesetValidateRules(rules)...)
+}
+
+// ipFirewallRulesetValidateRules checks that the sequences of the rules are
+// unique, and that their options match their protocol
+func ipFirewallRulesetValidateRules(rules []ipFirewallRulesetRuleModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	sequences := make(map[int64]int)
+	for i, rule := range rules {
+		if !rule.Sequence.IsNull() && !rule.Sequence.IsUnknown() {
+			sequence := rule.Sequence.ValueInt64()
+			if previous, ok := sequences[sequence]; ok {
+				diags.AddAttributeError(
+					path.Root("rules").AtListIndex(i).AtName("sequence"),
+					"Duplicate firewall rule sequence",
+					fmt.Sprintf("sequence %d is already used by rule %d", sequence, previous),
+				)
+			} else {
+				sequences[sequence] = i
+			}
+		}
+
+		if rule.Protocol.IsUnknown() {
+			continue
+		}
+		protocol := rule.Protocol.ValueString()
+
+		if protocol != "tcp" && protocol != "udp" {
+			ports := []struct {
+				name  string
+				value ovhtypes.TfInt64Value
+			}{
+				{"source_port", rule.SourcePort},
+				{"destination_port", rule.DestinationPort},
+			}
+			for _, port := range ports {
+				if !port.value.IsNull() {
+					diags.AddAttributeError(
+						path.Root("rules").AtListIndex(i).AtName(port.name),
+						"Invalid firewall rule",
+						fmt.Sprintf("%s can only be used with tcp and udp protocols", port.name),
+					)
+				}
+			}
+		}
+
+		if !rule.TcpOption.IsNull() && protocol != "tcp" {
+			diags.AddAttributeError(
+				path.Root("rules").AtListIndex(i).AtName("tcp_option"),
+				"Invalid firewall rule",
+				"tcp_option can only be used with tcp protocol",
+			)
+		}
+	}
+
+	return diags
+}
+
+func (r *ipFirewallRulesetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	splits := strings.Split(req.ID, "|")
+	if len(splits) != 2 {
+		resp.Diagnostics.AddError("Given ID is malformed", "ID must be formatted like the following: <ip>|<ip_on_firewall>")
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("ip"), splits[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("ip_on_firewall"), splits[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), splits[0]+"/"+splits[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("disable_firewall_during_update"), false)...)
+}
+
+func (r *ipFirewallRulesetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ipFirewallRulesetModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The resource owns all the rules of the IP on firewall, so the rules
+	// that already exist are replaced by the planned ones
+	current, err := r.readRules(ctx, data.Ip.ValueString(), data.IpOnFirewall.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading firewall rules", err.Error())
+		return
+	}
+
+	if err := r.applyRules(ctx, &data, current); err != nil {
+		resp.Diagnostics.AddError("Error applying firewall rules", err.Error())
+		return
+	}
+
+	data.ID = ovhtypes.NewTfStringValue(data.Ip.ValueString() + "/" + data.IpOnFirewall.ValueString())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ipFirewallRulesetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ipFirewallRulesetModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, err := r.readRules(ctx, data.Ip.ValueString(), data.IpOnFirewall.ValueString())
+	if err != nil {
+		// The IP is not on the firewall anymore
+		if isOvhApiNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading firewall rules", err.Error())
+		return
+	}
+
+	// Keep the rules as written in the state, in the same order, when they are
+	// equivalent to the remote ones to avoid diffs on the notation of the source.
+	// Rules that are unknown to the state are appended by sequence.
+	currentBySequence := make(map[int64]ipFirewallRulesetRuleModel, len(current))
+	for _, rule := range current {
+		currentBySequence[rule.Sequence.ValueInt64()] = rule
+	}
+
+	rules := make([]ipFirewallRulesetRuleModel, 0, len(current))
+	for _, prev := range data.Rules {
+		sequence := prev.Sequence.ValueInt64()
+		rule, ok := currentBySequence[sequence]
+		if !ok {
+			continue
+		}
+		if prev.equals(rule) {
+			rule = prev
+		}
+		rules = append(rules, rule)
+		delete(currentBySequence, sequence)
+	}
+	for _, rule := range current {
+		if _, ok := currentBySequence[rule.Sequence.ValueInt64()]; ok {
+			rules = append(rules, rule)
+		}
+	}
+	data.Rules = rules
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ipFirewallRulesetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, planData ipFirewallRulesetModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &planData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.applyRules(ctx, &planData, data.Rules); err != nil {
+		resp.Diagnostics.AddError("Error applying firewall rules", err.Error())
+		return
+	}
+
+	planData.ID = data.ID
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &planData)...)
+}
+
+func (r *ipFirewallRulesetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ipFirewallRulesetModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rules := data.Rules
+	data.Rules = nil
+	if err := r.applyRules(ctx, &data, rules); err != nil {
+		resp.Diagnostics.AddError("Error removing firewall rules", err.Error())
+	}
+}
+
+// readRules returns the rules of the IP on firewall, sorted by sequence
+func (r *ipFirewallRulesetResource) readRules(ctx context.Context, ip, ipOnFirewall string) ([]ipFirewallRulesetRuleModel, error) {
+	var sequences []int64
+
+	endpoint := fmt.Sprintf("/ip/%s/firewall/%s/rule", url.PathEscape(ip), url.PathEscape(ipOnFirewall))
+	if err := r.config.OVHClient.GetWithContext(ctx, endpoint, &sequences); err != nil {
+		return nil, fmt.Errorf("calling Get %s: %w", endpoint, err)
+	}
+
+	sort.Slice(sequences, func(i, j int) bool { return sequences[i] < sequences[j] })
+
+	rules := make([]ipFirewallRulesetRuleModel, 0, len(sequences))
+	for _, sequence := range sequences {
+		var responseData IpFirewallRuleResponseModel
+
+		ruleEndpoint := fmt.Sprintf("%s/%d", endpoint, sequence)
+		if err := r.config.OVHClient.GetWithContext(ctx, ruleEndpoint, &responseData); err != nil {
+			return nil, fmt.Errorf("calling Get %s: %w", ruleEndpoint, err)
+		}
+
+		rules = append(rules, ipFirewallRulesetRuleModel{
+			Sequence:        ovhtypes.NewTfInt64Value(sequence),
+			Action:          responseData.Action,
+			Protocol:        responseData.Protocol,
+			Source:          responseData.Source,
+			SourcePort:      ipFirewallRulePortFromDesc(ctx, responseData.SourcePortDesc),
+			DestinationPort: ipFirewallRulePortFromDesc(ctx, responseData.DestinationPortDesc),
+			TcpOption:       responseData.TcpOption,
+			Fragments:       ovhtypes.NewTfBoolValue(responseData.Fragments.ValueBool()),
+		})
+	}
+
+	return rules, nil
+}
+
+// applyRules replaces the current rules of the IP on firewall by the ones of
+// the given model. Only the rules whose sequence is not used anymore or whose
+// content changed are removed, and only new or changed rules are created.
+func (r *ipFirewallRulesetResource) applyRules(ctx context.Context, data *ipFirewallRulesetModel, current []ipFirewallRulesetRuleModel) (err error) {
+	toDelete, toCreate := ipFirewallRulesetDiff(current, data.Rules)
+	if len(toDelete) == 0 && len(toCreate) == 0 {
+		return nil
+	}
+
+	ip := data.Ip.ValueString()
+	ipOnFirewall := data.IpOnFirewall.ValueString()
+	endpoint := fmt.Sprintf("/ip/%s/firewall/%s/rule", url.PathEscape(ip), url.PathEscape(ipOnFirewall))
+
+	if data.DisableFirewallDuringUpdate.ValueBool() {
+		enabled, err := r.setFirewallEnabled(ctx, ip, ipOnFirewall, false)
+		if err != nil {
+			return err
+		}
+
+		if enabled {
+			defer func() {
+				if _, enableErr := r.setFirewallEnabled(ctx, ip, ipOnFirewall, true); enableErr != nil {
+					err = errors.Join(err, enableErr)
+				}
+			}()
+		}
+	}
+
+	// Removals are done first to free the sequences of the changed rules
+	for _, rule := range toDelete {
+		ruleEndpoint := fmt.Sprintf("%s/%d", endpoint, rule.Sequence.ValueInt64())
+
+		tflog.Debug(ctx, fmt.Sprintf("removing firewall rule %d of %s", rule.Sequence.ValueInt64(), ipOnFirewall))
+		if err := r.config.OVHClient.DeleteWithContext(ctx, ruleEndpoint, nil); err != nil {
+			return fmt.Errorf("calling Delete %s: %w", ruleEndpoint, err)
+		}
+		if err := ipFirewallRuleWaitDeleted(ctx, r.config, ruleEndpoint); err != nil {
+			return fmt.Errorf("waiting for rule %d to be removed: %w", rule.Sequence.ValueInt64(), err)
+		}
+	}
+
+	for _, rule := range toCreate {
+		var responseData IpFirewallRuleResponseModel
+
+		tflog.Debug(ctx, fmt.Sprintf("creating firewall rule %d of %s", rule.Sequence.ValueInt64(), ipOnFirewall))
+		if err := r.config.OVHClient.PostWithContext(ctx, endpoint, rule.ToCreate(), &responseData); err != nil {
+			return fmt.Errorf("calling Post %s: %w", endpoint, err)
+		}
+
+		ruleEndpoint := fmt.Sprintf("%s/%d", endpoint, rule.Sequence.ValueInt64())
+		if err := ipFirewallRuleWaitOk(ctx, r.config, ruleEndpoint, &responseData); err != nil {
+			return fmt.Errorf("waiting for rule %d to be ok: %w", rule.Sequence.ValueInt64(), err)
+		}
+	}
+
+	return nil
+}
+
+// setFirewallEnabled enables or disables the firewall of the IP, and returns
+// whether it was enabled beforehand
+func (r *ipFirewallRulesetResource) setFirewallEnabled(ctx context.Context, ip, ipOnFirewall string, enabled bool) (bool, error) {
+	var firewall IpFirewallModel
+
+	endpoint := "/ip/" + url.PathEscape(ip) + "/firewall/" + url.PathEscape(ipOnFirewall)
+	if err := r.config.OVHClient.GetWithContext(ctx, endpoint, &firewall); err != nil {
+		return false, fmt.Errorf("calling Get %s: %w", endpoint, err)
+	}
+
+	wasEnabled := firewall.Enabled.ValueBool()
+	if wasEnabled == enabled {
+		return wasEnabled, nil
+	}
+
+	update := IpFirewallModel{Enabled: ovhtypes.NewTfBoolValue(enabled)}
+	if err := r.config.OVHClient.PutWithContext(ctx, endpoint, update.ToUpdate(), nil); err != nil {
+		return wasEnabled, fmt.Errorf("calling Put %s: %w", endpoint, err)
+	}
+
+	err := retry.RetryContext(ctx, 10*time.Minute, func() *retry.RetryError {
+		if err := r.config.OVHClient.GetWithContext(ctx, endpoint, &firewall); err != nil {
+			return retry.NonRetryableError(err)
+		}
+
+		if firewall.State.ValueString() == "ok" {
+			return nil
+		}
+
+		return retry.RetryableError(errors.New("waiting for state to be OK"))
+	})
+	if err != nil {
+		return wasEnabled, fmt.Errorf("waiting for firewall %s to be ok: %w", ipOnFirewall, err)
+	}
+
+	return wasEnabled, nil
+}
+
+// ipFirewallRulesetDiff computes the minimal changes to go from the current
+// rules to the desired ones. A rule can't be updated, so a rule whose content
+// changed is removed and created again.
+func ipFirewallRulesetDiff(current, desired []ipFirewallRulesetRuleModel) (toDelete, toCreate []ipFirewallRulesetRuleModel) {
+	currentBySequence := make(map[int64]ipFirewallRulesetRuleModel, len(current))
+	for _, rule := range current {
+		currentBySequence[rule.Sequence.ValueInt64()] = rule
+	}
+
+	desiredBySequence := make(map[int64]ipFirewallRulesetRuleModel, len(desired))
+	for _, rule := range desired {
+		desiredBySequence[rule.Sequence.ValueInt64()] = rule
+	}
+
+	for _, rule := range current {
+		if wanted, ok := desiredBySequence[rule.Sequence.ValueInt64()]; !ok || !wanted.equals(rule) {
+			toDelete = append(toDelete, rule)
+		}
+	}
+
+	for _, rule := range desired {
+		if existing, ok := currentBySequence[rule.Sequence.ValueInt64()]; !ok || !existing.equals(rule) {
+			toCreate = append(toCreate, rule)
+		}
+	}
+
+	sortBySequence := func(rules []ipFirewallRulesetRuleModel) {
+		sort.Slice(rules, func(i, j int) bool { return rules[i].Sequence.ValueInt64() < rules[j].Sequence.ValueInt64() })
+	}
+	sortBySequence(toDelete)
+	sortBySequence(toCreate)
+
+	return toDelete, toCreate
+}
+
+// equals returns whether both rules filter the same traffic
+func (v ipFirewallRulesetRuleModel) equals(other ipFirewallRulesetRuleModel) bool {
+	return v.Sequence.ValueInt64() == other.Sequence.ValueInt64() &&
+		v.Action.ValueString() == other.Action.ValueString() &&
+		v.Protocol.ValueString() == other.Protocol.ValueString() &&
+		ipFirewallNormalizeSource(v.Source.ValueString()) == ipFirewallNormalizeSource(other.Source.ValueString()) &&
+		v.SourcePort.Equal(other.SourcePort) &&
+		v.DestinationPort.Equal(other.DestinationPort) &&
+		v.TcpOption.ValueString() == other.TcpOption.ValueString() &&
+		v.Fragments.ValueBool() == other.Fragments.ValueBool()
+}
+
+// ipFirewallNormalizeSource returns the CIDR notation of a rule source, the
+// API returning single IPs as /32 networks
+func ipFirewallNormalizeSource(source string) string {
+	if source == "" {
+		return "any"
+	}
+	if source != "any" && !strings.Contains(source, "/") {
+		return source + "/32"
+	}
+	return source
+}
+
+func (v ipFirewallRulesetRuleModel) ToCreate() *IpFirewallRuleWritableModel {
+	res := &IpFirewallRuleWritableModel{
+		Action:   &v.Action,
+		Protocol: &v.Protocol,
+		Sequence: &v.Sequence,
+	}
+
+	if !v.Source.IsNull() && v.Source.ValueString() != "any" {
+		res.Source = &v.Source
+	}
+
+	if !v.SourcePort.IsNull() {
+		res.SourcePort = &v.SourcePort
+	}
+
+	if !v.DestinationPort.IsNull() {
+		res.DestinationPort = &v.DestinationPort
+	}
+
+	if !v.TcpOption.IsNull() || v.Fragments.ValueBool() {
+		option := TcpOptionWritableValue{
+			Fragments: &v.Fragments,
+		}
+
+		if !v.TcpOption.IsNull() {
+			option.Option = &v.TcpOption
+		}
+
+		res.TcpOption = &option
+	}
+
+	return res
+}
@@ -0,0 +1,172 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	ovhtypes "github.com/ovh/terraform-provider-ovh/v2/ovh/types"
+)
+
+func testIpFirewallRulesetRule(sequence int64, action, source string) ipFirewallRulesetRuleModel {
+	return ipFirewallRulesetRuleModel{
+		Sequence:        ovhtypes.NewTfInt64Value(sequence),
+		Action:          ovhtypes.NewTfStringValue(action),
+		Protocol:        ovhtypes.NewTfStringValue("tcp"),
+		Source:          ovhtypes.NewTfStringValue(source),
+		SourcePort:      ovhtypes.NewTfInt64ValueNull(),
+		DestinationPort: ovhtypes.NewTfInt64Value(22),
+		TcpOption:       ovhtypes.NewTfStringNull(),
+		Fragments:       ovhtypes.NewTfBoolValue(false),
+	}
+}
+
+func TestIpFirewallRulesetDiff(t *testing.T) {
+	current := []ipFirewallRulesetRuleModel{
+		testIpFirewallRulesetRule(0, "permit", "192.0.2.1/32"),
+		testIpFirewallRulesetRule(1, "permit", "192.0.2.2/32"),
+		testIpFirewallRulesetRule(19, "deny", "any"),
+	}
+	desired := []ipFirewallRulesetRuleModel{
+		testIpFirewallRulesetRule(19, "deny", "any"),
+		testIpFirewallRulesetRule(0, "permit", "192.0.2.1"),
+		testIpFirewallRulesetRule(1, "permit", "192.0.2.3/32"),
+		testIpFirewallRulesetRule(2, "permit", "192.0.2.4/32"),
+	}
+
+	toDelete, toCreate := ipFirewallRulesetDiff(current, desired)
+
+	sequences := func(rules []ipFirewallRulesetRuleModel) []int64 {
+		res := make([]int64, 0, len(rules))
+		for _, rule := range rules {
+			res = append(res, rule.Sequence.ValueInt64())
+		}
+		return res
+	}
+
+	if got := fmt.Sprint(sequences(toDelete)); got != "[1]" {
+		t.Errorf("expected rules [1] to be deleted, got %s", got)
+	}
+	if got := fmt.Sprint(sequences(toCreate)); got != "[1 2]" {
+		t.Errorf("expected rules [1 2] to be created, got %s", got)
+	}
+
+	toDelete, toCreate = ipFirewallRulesetDiff(current, nil)
+	if len(toDelete) != 3 || len(toCreate) != 0 {
+		t.Errorf("expected all rules to be deleted, got %d deletions and %d creations", len(toDelete), len(toCreate))
+	}
+}
+
+func TestAccIPFirewallRuleset_basic(t *testing.T) {
+	ip := os.Getenv("OVH_IP_FIREWALL_TEST")
+
+	config := func(sshSource string) string {
+		return fmt.Sprintf(`
+		resource "ovh_ip_firewall" "firewall" {
+			ip             = "%s"
+			ip_on_firewall = "%s"
+			enabled        = true
+		}
+
+		resource "ovh_ip_firewall_ruleset" "rules" {
+			ip             = ovh_ip_firewall.firewall.ip
+			ip_on_firewall = ovh_ip_firewall.firewall.ip_on_firewall
+
+			disable_firewall_during_update = true
+
+			rules = [
+				{
+					sequence         = 0
+					action           = "permit"
+					protocol         = "tcp"
+					source           = "%s"
+					destination_port = 22
+				},
+				{
+					sequence   = 1
+					action     = "permit"
+					protocol   = "tcp"
+					tcp_option = "established"
+				},
+				{
+					sequence = 19
+					action   = "deny"
+					protocol = "ipv4"
+				},
+			]
+		}
+	`, ip, ip, sshSource)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckIp(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config("192.0.2.0/24"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ovh_ip_firewall_ruleset.rules", "rules.#", "3"),
+					resource.TestCheckResourceAttr("ovh_ip_firewall_ruleset.rules", "rules.0.source", "192.0.2.0/24"),
+					resource.TestCheckResourceAttr("ovh_ip_firewall_ruleset.rules", "rules.1.source", "any"),
+					resource.TestCheckResourceAttr("ovh_ip_firewall_ruleset.rules", "rules.2.action", "deny"),
+				),
+			},
+			{
+				Config: config("198.51.100.0/24"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ovh_ip_firewall_ruleset.rules", "rules.#", "3"),
+					resource.TestCheckResourceAttr("ovh_ip_firewall_ruleset.rules", "rules.0.source", "198.51.100.0/24"),
+				),
+			},
+			{
+				ImportStateId:                        fmt.Sprintf("%s|%s", ip, ip),
+				ResourceName:                         "ovh_ip_firewall_ruleset.rules",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "ip_on_firewall",
+				ImportStateVerifyIgnore:              []string{"disable_firewall_during_update"},
+			},
+		},
+	})
+}
+
+func TestIpFirewallRulesetValidateRules(t *testing.T) {
+	udpWithTcpOption := testIpFirewallRulesetRule(1, "permit", "any")
+	udpWithTcpOption.Protocol = ovhtypes.NewTfStringValue("udp")
+	udpWithTcpOption.TcpOption = ovhtypes.NewTfStringValue("established")
+
+	icmpWithPort := testIpFirewallRulesetRule(2, "permit", "any")
+	icmpWithPort.Protocol = ovhtypes.NewTfStringValue("icmp")
+
+	icmpWithTcpOption := testIpFirewallRulesetRule(3, "permit", "any")
+	icmpWithTcpOption.Protocol = ovhtypes.NewTfStringValue("icmp")
+	icmpWithTcpOption.DestinationPort = ovhtypes.NewTfInt64ValueNull()
+	icmpWithTcpOption.TcpOption = ovhtypes.NewTfStringValue("syn")
+
+	tcpWithTcpOption := testIpFirewallRulesetRule(4, "permit", "any")
+	tcpWithTcpOption.TcpOption = ovhtypes.NewTfStringValue("established")
+
+	udpWithPort := testIpFirewallRulesetRule(5, "permit", "any")
+	udpWithPort.Protocol = ovhtypes.NewTfStringValue("udp")
+
+	tests := []struct {
+		name   string
+		rules  []ipFirewallRulesetRuleModel
+		errors int
+	}{
+		{name: "valid", rules: []ipFirewallRulesetRuleModel{tcpWithTcpOption, udpWithPort}, errors: 0},
+		{name: "duplicate sequence", rules: []ipFirewallRulesetRuleModel{testIpFirewallRulesetRule(0, "permit", "any"), testIpFirewallRulesetRule(0, "deny", "any")}, errors: 1},
+		{name: "tcp_option on udp", rules: []ipFirewallRulesetRuleModel{udpWithTcpOption}, errors: 1},
+		{name: "port on icmp", rules: []ipFirewallRulesetRuleModel{icmpWithPort}, errors: 1},
+		{name: "tcp_option on icmp", rules: []ipFirewallRulesetRuleModel{icmpWithTcpOption}, errors: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if diags := ipFirewallRulesetValidateRules(tt.rules); diags.ErrorsCount() != tt.errors {
+				t.Errorf("expected %d errors, got %v", tt.errors, diags)
+			}
+		})
+	}
+}
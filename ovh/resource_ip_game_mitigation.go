@@ -0,0 +1,228 @@
+package ovh
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/ovh/go-ovh/ovh"
+	ovhtypes "github.com/ovh/terraform-provider-ovh/v2/ovh/types"
+)
+
+var _ resource.ResourceWithConfigure = (*ipGameMitigationResource)(nil)
+var _ resource.ResourceWithImportState = (*ipGameMitigationResource)(nil)
+
+func NewIpGameMitigationResource() resource.Resource {
+	return &ipGameMitigationResource{}
+}
+
+type ipGameMitigationResource struct {
+	config *Config
+}
+
+func (r *ipGameMitigationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ip_game_mitigation"
+}
+
+func (r *ipGameMitigationResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.config = config
+}
+
+func (r *ipGameMitigationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manage the firewall mode of the game DDoS protection of an IP",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				CustomType:  ovhtypes.TfStringType{},
+				Computed:    true,
+				Description: "Unique identifier for the resource",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"ip": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Required:            true,
+				Description:         "IP (v4 or v6) CIDR notation (e.g., 192.0.2.0/24)",
+				MarkdownDescription: "IP (v4 or v6) CIDR notation (e.g., 192.0.2.0/24)",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"ip_on_game": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Required:            true,
+				Description:         "IPv4 address of the game server (e.g., 192.0.2.0)",
+				MarkdownDescription: "IPv4 address of the game server (e.g., 192.0.2.0)",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"firewall_mode_enabled": schema.BoolAttribute{
+				CustomType:          ovhtypes.TfBoolType{},
+				Required:            true,
+				Description:         "Whether traffic that doesn't match a game rule is dropped",
+				MarkdownDescription: "Whether traffic that doesn't match a game rule is dropped",
+			},
+			"state": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Computed:            true,
+				Description:         "Current state of the game DDoS protection",
+				MarkdownDescription: "Current state of the game DDoS protection",
+			},
+			"supported_protocols": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Computed:            true,
+				Description:         "Game protocols that can be used in the rules of the IP",
+				MarkdownDescription: "Game protocols that can be used in the rules of the IP",
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *ipGameMitigationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	splits := strings.Split(req.ID, "|")
+	if len(splits) != 2 {
+		resp.Diagnostics.AddError("Given ID is malformed", "ID must be formatted like the following: <ip>|<ip_on_game>")
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("ip"), splits[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("ip_on_game"), splits[1])...)
+}
+
+func (r *ipGameMitigationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ipGameMitigationModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The game DDoS protection exists as soon as the IP is routed to a game
+	// server, creating the resource only sets its firewall mode
+	game, err := r.update(ctx, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating game DDoS protection", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(data.MergeWith(ctx, game)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ipGameMitigationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var (
+		data ipGameMitigationModel
+		game IpGameMitigation
+	)
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	endpoint := "/ip/" + url.PathEscape(data.Ip.ValueString()) + "/game/" + url.PathEscape(data.IpOnGame.ValueString())
+	if err := r.config.OVHClient.GetWithContext(ctx, endpoint, &game); err != nil {
+		if errOvh, ok := err.(*ovh.APIError); ok && errOvh.Code == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Error calling Get %s", endpoint),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(data.MergeWith(ctx, &game)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ipGameMitigationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ipGameMitigationModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	game, err := r.update(ctx, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating game DDoS protection", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(data.MergeWith(ctx, game)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ipGameMitigationResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+	// The game DDoS protection can't be removed, it is only dropped from the state
+}
+
+// update sets the firewall mode of the game DDoS protection when it differs
+// from the planned one, and waits for the change to be applied
+func (r *ipGameMitigationResource) update(ctx context.Context, data *ipGameMitigationModel) (*IpGameMitigation, error) {
+	game := &IpGameMitigation{}
+
+	endpoint := "/ip/" + url.PathEscape(data.Ip.ValueString()) + "/game/" + url.PathEscape(data.IpOnGame.ValueString())
+	if err := r.config.OVHClient.GetWithContext(ctx, endpoint, game); err != nil {
+		return nil, fmt.Errorf("calling Get %s: %w", endpoint, err)
+	}
+
+	if game.FirewallModeEnabled == data.FirewallModeEnabled.ValueBool() {
+		return game, nil
+	}
+
+	opts := &IpGameMitigationUpdateOpts{FirewallModeEnabled: data.FirewallModeEnabled.ValueBool()}
+	if err := r.config.OVHClient.PutWithContext(ctx, endpoint, opts, nil); err != nil {
+		return nil, fmt.Errorf("calling Put %s: %w", endpoint, err)
+	}
+
+	err := retry.RetryContext(ctx, 10*time.Minute, func() *retry.RetryError {
+		if err := r.config.OVHClient.GetWithContext(ctx, endpoint, game); err != nil {
+			return retry.NonRetryableError(err)
+		}
+
+		if game.State == "ok" {
+			return nil
+		}
+
+		return retry.RetryableError(errors.New("waiting for state to be OK"))
+	})
+	if err != nil {
+		return nil, fmt.Errorf("waiting for game DDoS protection of %s to be ok: %w", data.IpOnGame.ValueString(), err)
+	}
+
+	return game, nil
+}
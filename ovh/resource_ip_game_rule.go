@@ -0,0 +1,320 @@
+package ovh
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/ovh/go-ovh/ovh"
+	"github.com/ovh/terraform-provider-ovh/v2/ovh/helpers"
+	ovhtypes "github.com/ovh/terraform-provider-ovh/v2/ovh/types"
+)
+
+var _ resource.ResourceWithConfigure = (*ipGameRuleResource)(nil)
+var _ resource.ResourceWithImportState = (*ipGameRuleResource)(nil)
+var _ resource.ResourceWithModifyPlan = (*ipGameRuleResource)(nil)
+
+func NewIpGameRuleResource() resource.Resource {
+	return &ipGameRuleResource{}
+}
+
+type ipGameRuleResource struct {
+	config *Config
+}
+
+func (r *ipGameRuleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ip_game_rule"
+}
+
+func (r *ipGameRuleResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.config = config
+}
+
+func (r *ipGameRuleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manage a rule of the game DDoS protection of an IP",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				CustomType:  ovhtypes.TfInt64Type{},
+				Computed:    true,
+				Description: "Id of the rule",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"ip": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Required:            true,
+				Description:         "IP (v4 or v6) CIDR notation (e.g., 192.0.2.0/24)",
+				MarkdownDescription: "IP (v4 or v6) CIDR notation (e.g., 192.0.2.0/24)",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"ip_on_game": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Required:            true,
+				Description:         "IPv4 address of the game server (e.g., 192.0.2.0)",
+				MarkdownDescription: "IPv4 address of the game server (e.g., 192.0.2.0)",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"protocol": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Required:            true,
+				Description:         "Game protocol of the rule, one of the supported_protocols of the game server",
+				MarkdownDescription: "Game protocol of the rule, one of the `supported_protocols` of the game server",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"port_from": schema.Int64Attribute{
+				CustomType:          ovhtypes.TfInt64Type{},
+				Required:            true,
+				Description:         "First port of the range of the rule",
+				MarkdownDescription: "First port of the range of the rule",
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65535),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"port_to": schema.Int64Attribute{
+				CustomType:          ovhtypes.TfInt64Type{},
+				Optional:            true,
+				Computed:            true,
+				Description:         "Last port of the range of the rule, defaults to port_from",
+				MarkdownDescription: "Last port of the range of the rule, defaults to `port_from`",
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65535),
+					int64validator.AtLeastSumOf(path.MatchRoot("port_from")),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplaceIfConfigured(),
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"state": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Computed:            true,
+				Description:         "Current state of the rule",
+				MarkdownDescription: "Current state of the rule",
+			},
+		},
+	}
+}
+
+// ModifyPlan checks that the protocol of the rule is supported by the game
+// server the IP is routed to
+func (r *ipGameRuleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var (
+		data ipGameRuleModel
+		game IpGameMitigation
+	)
+
+	// Nothing to check on destroy, or before the provider is configured
+	if req.Plan.Raw.IsNull() || r.config == nil {
+		return
+	}
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Ip.IsUnknown() || data.IpOnGame.IsUnknown() || data.Protocol.IsUnknown() {
+		return
+	}
+
+	endpoint := "/ip/" + url.PathEscape(data.Ip.ValueString()) + "/game/" + url.PathEscape(data.IpOnGame.ValueString())
+	if err := r.config.OVHClient.GetWithContext(ctx, endpoint, &game); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error calling Get %s", endpoint), err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(ipGameRuleValidateProtocol(data.IpOnGame.ValueString(), data.Protocol.ValueString(), game.SupportedProtocols)...)
+}
+
+// ipGameRuleValidateProtocol checks that the protocol is one of the protocols
+// supported by the game server, the list depends on the server and evolves
+// with the API so it is not hardcoded
+func ipGameRuleValidateProtocol(ipOnGame, protocol string, supportedProtocols []string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if err := helpers.ValidateStringEnum(protocol, supportedProtocols); err != nil {
+		diags.AddAttributeError(
+			path.Root("protocol"),
+			"Unsupported game protocol",
+			fmt.Sprintf("protocol is not supported by %s: %s", ipOnGame, err),
+		)
+	}
+
+	return diags
+}
+
+func (r *ipGameRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	splits := strings.Split(req.ID, "|")
+	if len(splits) != 3 {
+		resp.Diagnostics.AddError("Given ID is malformed", "ID must be formatted like the following: <ip>|<ip_on_game>|<id>")
+		return
+	}
+
+	id, err := strconv.ParseInt(splits[2], 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Given rule id must be an integer", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("ip"), splits[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("ip_on_game"), splits[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+func (r *ipGameRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var (
+		data ipGameRuleModel
+		rule IpGameMitigationRule
+	)
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	endpoint := "/ip/" + url.PathEscape(data.Ip.ValueString()) + "/game/" + url.PathEscape(data.IpOnGame.ValueString()) + "/rule"
+	if err := r.config.OVHClient.PostWithContext(ctx, endpoint, data.ToCreate(), &rule); err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Error calling Post %s", endpoint),
+			err.Error(),
+		)
+		return
+	}
+
+	// Wait for state to be ok
+	endpoint = fmt.Sprintf("%s/%d", endpoint, rule.Id)
+	err := retry.RetryContext(ctx, 10*time.Minute, func() *retry.RetryError {
+		if err := r.config.OVHClient.GetWithContext(ctx, endpoint, &rule); err != nil {
+			return retry.NonRetryableError(err)
+		}
+
+		if rule.State == "ok" {
+			return nil
+		}
+
+		return retry.RetryableError(errors.New("waiting for resource state to be ok"))
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("error waiting status to be ok", err.Error())
+		return
+	}
+
+	data.MergeWith(&rule)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ipGameRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var (
+		data ipGameRuleModel
+		rule IpGameMitigationRule
+	)
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	endpoint := fmt.Sprintf("/ip/%s/game/%s/rule/%d",
+		url.PathEscape(data.Ip.ValueString()),
+		url.PathEscape(data.IpOnGame.ValueString()),
+		data.ID.ValueInt64(),
+	)
+	if err := r.config.OVHClient.GetWithContext(ctx, endpoint, &rule); err != nil {
+		if errOvh, ok := err.(*ovh.APIError); ok && errOvh.Code == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Error calling Get %s", endpoint),
+			err.Error(),
+		)
+		return
+	}
+
+	data.MergeWith(&rule)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ipGameRuleResource) Update(_ context.Context, _ resource.UpdateRequest, _ *resource.UpdateResponse) {
+	// No update on API side, all the arguments require a replacement
+}
+
+func (r *ipGameRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ipGameRuleModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	endpoint := fmt.Sprintf("/ip/%s/game/%s/rule/%d",
+		url.PathEscape(data.Ip.ValueString()),
+		url.PathEscape(data.IpOnGame.ValueString()),
+		data.ID.ValueInt64(),
+	)
+	if err := r.config.OVHClient.DeleteWithContext(ctx, endpoint, nil); err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Error calling Delete %s", endpoint),
+			err.Error(),
+		)
+		return
+	}
+
+	// Wait for rule to be removed
+	err := retry.RetryContext(ctx, 10*time.Minute, func() *retry.RetryError {
+		readErr := r.config.OVHClient.GetWithContext(ctx, endpoint, nil)
+		if readErr != nil {
+			if errOvh, ok := readErr.(*ovh.APIError); ok && errOvh.Code == http.StatusNotFound {
+				return nil
+			}
+			return retry.NonRetryableError(readErr)
+		}
+
+		return retry.RetryableError(errors.New("waiting for resource to be removed"))
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("error verifying that resource was deleted", err.Error())
+	}
+}
@@ -0,0 +1,102 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccIPGameRule_basic(t *testing.T) {
+	ip := os.Getenv("OVH_IP_GAME_TEST")
+	config := fmt.Sprintf(`
+		resource "ovh_ip_game_mitigation" "game" {
+			ip                    = "%s"
+			ip_on_game            = "%s"
+			firewall_mode_enabled = true
+		}
+
+		resource "ovh_ip_game_rule" "minecraft" {
+			ip         = ovh_ip_game_mitigation.game.ip
+			ip_on_game = ovh_ip_game_mitigation.game.ip_on_game
+			protocol   = "minecraftQuery"
+			port_from  = 25565
+		}
+
+		resource "ovh_ip_game_rule" "teamspeak" {
+			ip         = ovh_ip_game_mitigation.game.ip
+			ip_on_game = ovh_ip_game_mitigation.game.ip_on_game
+			protocol   = "teamspeak3"
+			port_from  = 9987
+			port_to    = 9990
+		}
+	`, ip, ip)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckIpGame(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ovh_ip_game_mitigation.game", "firewall_mode_enabled", "true"),
+					resource.TestCheckResourceAttr("ovh_ip_game_mitigation.game", "state", "ok"),
+					resource.TestCheckResourceAttrSet("ovh_ip_game_mitigation.game", "supported_protocols.#"),
+					resource.TestCheckResourceAttr("ovh_ip_game_rule.minecraft", "port_to", "25565"),
+					resource.TestCheckResourceAttr("ovh_ip_game_rule.minecraft", "state", "ok"),
+					resource.TestCheckResourceAttr("ovh_ip_game_rule.teamspeak", "port_from", "9987"),
+					resource.TestCheckResourceAttr("ovh_ip_game_rule.teamspeak", "port_to", "9990"),
+				),
+			},
+			{
+				ResourceName:      "ovh_ip_game_rule.teamspeak",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccIpGameRuleImportId("ovh_ip_game_rule.teamspeak"),
+			},
+			{
+				ResourceName:      "ovh_ip_game_mitigation.game",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     fmt.Sprintf("%s|%s", ip, ip),
+			},
+		},
+	})
+}
+
+func testAccIpGameRuleImportId(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s|%s|%s", rs.Primary.Attributes["ip"], rs.Primary.Attributes["ip_on_game"], rs.Primary.Attributes["id"]), nil
+	}
+}
+
+func TestIpGameRuleValidateProtocol(t *testing.T) {
+	supported := []string{"minecraftQuery", "teamspeak3", "valheim"}
+
+	tests := []struct {
+		protocol string
+		wantErr  bool
+	}{
+		{protocol: "minecraftQuery"},
+		// Protocols added by the API are accepted as soon as the server supports them
+		{protocol: "valheim"},
+		{protocol: "rust", wantErr: true},
+		{protocol: "unknown", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.protocol, func(t *testing.T) {
+			diags := ipGameRuleValidateProtocol("192.0.2.1", tt.protocol, supported)
+			if diags.HasError() != tt.wantErr {
+				t.Errorf("ipGameRuleValidateProtocol(%s) = %v, wantErr %v", tt.protocol, diags, tt.wantErr)
+			}
+		})
+	}
+}
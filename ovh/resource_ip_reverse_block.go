@@ -0,0 +1,473 @@
+package ovh
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/ovh/go-ovh/ovh"
+	"github.com/ovh/terraform-provider-ovh/v2/ovh/helpers"
+)
+
+// Largest range of addresses a reverse template can be expanded to
+const ipReverseBlockMaxTemplateSize = 4096
+
+func resourceIpReverseBlock() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIpReverseBlockCreate,
+		ReadContext:   resourceIpReverseBlockRead,
+		UpdateContext: resourceIpReverseBlockUpdate,
+		DeleteContext: resourceIpReverseBlockDelete,
+		CustomizeDiff: resourceIpReverseBlockCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"ip": {
+				Type:        schema.TypeString,
+				Description: "The IP block the reverses belong to",
+				Required:    true,
+				ForceNew:    true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := helpers.ValidateIpBlock(v.(string))
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+			"cidr": {
+				Type:        schema.TypeString,
+				Description: "Range of addresses of the IP block the template applies to, defaults to the whole IP block",
+				Optional:    true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := helpers.ValidateIpBlock(v.(string))
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+			"template": {
+				Type: schema.TypeString,
+				Description: "Template of the reverse of each IPv4 address of the range. " +
+					"{a}, {b}, {c} and {d} are replaced by the bytes of the address, and {ip} by the address with dashes",
+				Optional:     true,
+				AtLeastOneOf: []string{"template", "reverses"},
+			},
+			"reverses": {
+				Type:         schema.TypeMap,
+				Description:  "Reverse of each IP address, overriding the template",
+				Optional:     true,
+				AtLeastOneOf: []string{"template", "reverses"},
+				Elem:         &schema.Schema{Type: schema.TypeString},
+			},
+			"check_forward": {
+				Type:        schema.TypeBool,
+				Description: "Check that each reverse resolves to its IP address before setting it",
+				Optional:    true,
+				Default:     true,
+			},
+			"resolver": {
+				Type:        schema.TypeString,
+				Description: "Address of the DNS resolver used to check the forward resolution of the reverses, defaults to the system resolver",
+				Optional:    true,
+			},
+			"parallelism": {
+				Type:         schema.TypeInt,
+				Description:  "Number of reverses set or removed concurrently",
+				Optional:     true,
+				Default:      10,
+				ValidateFunc: validation.IntBetween(1, 50),
+			},
+			"readiness_timeout_duration": {
+				Type:        schema.TypeString,
+				Description: "How long to retry setting a reverse rejected because its forward resolution isn't visible by the API yet",
+				Optional:    true,
+				Default:     "1m",
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					if _, err := time.ParseDuration(v.(string)); err != nil {
+						errors = append(errors, fmt.Errorf("%s is not a valid duration: %s", k, err))
+					}
+					return
+				},
+			},
+
+			//computed
+			"records": {
+				Type:        schema.TypeMap,
+				Description: "Reverses set on the IP addresses of the range",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+// ipReverseBlockDesired returns the reverses requested by the resource,
+// indexed by IP address
+func ipReverseBlockDesired(d interface{ Get(string) interface{} }) (map[string]string, error) {
+	cidr := d.Get("cidr").(string)
+	if cidr == "" {
+		cidr = d.Get("ip").(string)
+	}
+
+	reverses := make(map[string]string)
+	for ip, reverse := range d.Get("reverses").(map[string]interface{}) {
+		reverses[ip] = reverse.(string)
+	}
+
+	return ipReverseBlockExpand(d.Get("ip").(string), cidr, d.Get("template").(string), reverses)
+}
+
+// ipReverseBlockExpand expands the template on the addresses of the cidr, and
+// merges the explicit reverses. Reverses are returned as fully qualified names.
+func ipReverseBlockExpand(block, cidr, template string, reverses map[string]string) (map[string]string, error) {
+	_, blockNet, err := net.ParseCIDR(block)
+	if err != nil {
+		return nil, fmt.Errorf("invalid IP block %s: %w", block, err)
+	}
+
+	res := make(map[string]string)
+
+	if template != "" {
+		_, cidrNet, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid cidr %s: %w", cidr, err)
+		}
+
+		first := cidrNet.IP.To4()
+		if first == nil {
+			return nil, fmt.Errorf("template can only be used on IPv4 ranges, use reverses for IPv6 addresses")
+		}
+
+		ones, bits := cidrNet.Mask.Size()
+		blockOnes, _ := blockNet.Mask.Size()
+		if !blockNet.Contains(first) || ones < blockOnes {
+			return nil, fmt.Errorf("cidr %s is not part of the IP block %s", cidr, block)
+		}
+
+		size := 1 << (bits - ones)
+		if size > ipReverseBlockMaxTemplateSize {
+			return nil, fmt.Errorf("cidr %s has %d addresses, the template can be applied on at most %d addresses", cidr, size, ipReverseBlockMaxTemplateSize)
+		}
+
+		start := uint32(first[0])<<24 | uint32(first[1])<<16 | uint32(first[2])<<8 | uint32(first[3])
+		for i := 0; i < size; i++ {
+			// Skip the network and broadcast addresses of ranges larger than /31
+			if size > 2 && (i == 0 || i == size-1) {
+				continue
+			}
+
+			n := start + uint32(i)
+			ip := net.IPv4(byte(n>>24), byte(n>>16), byte(n>>8), byte(n))
+			octets := strings.Split(ip.String(), ".")
+			reverse := strings.NewReplacer(
+				"{ip}", strings.Join(octets, "-"),
+				"{a}", octets[0],
+				"{b}", octets[1],
+				"{c}", octets[2],
+				"{d}", octets[3],
+			).Replace(template)
+
+			res[ip.String()] = ipReverseFqdn(reverse)
+		}
+	}
+
+	for ipStr, reverse := range reverses {
+		ip := net.ParseIP(ipStr)
+		if ip == nil {
+			return nil, fmt.Errorf("%s is not a valid IP address", ipStr)
+		}
+		if !blockNet.Contains(ip) {
+			return nil, fmt.Errorf("%s is not part of the IP block %s", ipStr, block)
+		}
+
+		res[ip.String()] = ipReverseFqdn(reverse)
+	}
+
+	return res, nil
+}
+
+// ipReverseFqdn returns the reverse as a fully qualified name, as returned by the API
+func ipReverseFqdn(reverse string) string {
+	if strings.HasSuffix(reverse, ".") {
+		return reverse
+	}
+	return reverse + "."
+}
+
+func resourceIpReverseBlockCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	for _, key := range []string{"ip", "cidr", "template", "reverses"} {
+		if !d.NewValueKnown(key) {
+			return d.SetNewComputed("records")
+		}
+	}
+
+	desired, err := ipReverseBlockDesired(d)
+	if err != nil {
+		return err
+	}
+
+	records := make(map[string]string)
+	for ip, reverse := range d.Get("records").(map[string]interface{}) {
+		records[ip] = reverse.(string)
+	}
+
+	if ipReverseBlockEqual(desired, records) {
+		return nil
+	}
+
+	return d.SetNew("records", desired)
+}
+
+func ipReverseBlockEqual(a, b map[string]string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for k, v := range a {
+		if other, ok := b[k]; !ok || other != v {
+			return false
+		}
+	}
+	return true
+}
+
+// ipReverseBlockRun calls fn on each of the IP addresses, running at most
+// parallelism calls at once, and returns the errors of all the calls
+func ipReverseBlockRun(ips []string, parallelism int, fn func(ip string) error) error {
+	var (
+		wg   sync.WaitGroup
+		mu   sync.Mutex
+		errs []error
+	)
+
+	sem := make(chan struct{}, parallelism)
+	for _, ip := range ips {
+		wg.Add(1)
+		sem <- struct{}{}
+
+		go func(ip string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			if err := fn(ip); err != nil {
+				mu.Lock()
+				errs = append(errs, err)
+				mu.Unlock()
+			}
+		}(ip)
+	}
+	wg.Wait()
+
+	return errors.Join(errs...)
+}
+
+// ipReverseCheckForward checks that the reverse resolves to the IP address
+func ipReverseCheckForward(ctx context.Context, resolver *net.Resolver, ip, reverse string) error {
+	addrs, err := resolver.LookupIPAddr(ctx, strings.TrimSuffix(reverse, "."))
+	if err != nil {
+		return fmt.Errorf("forward resolution of %s failed: %w", reverse, err)
+	}
+
+	expected := net.ParseIP(ip)
+	for _, addr := range addrs {
+		if addr.IP.Equal(expected) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%s doesn't resolve to %s", reverse, ip)
+}
+
+func ipReverseBlockResolver(address string) *net.Resolver {
+	if address == "" {
+		return net.DefaultResolver
+	}
+
+	if _, _, err := net.SplitHostPort(address); err != nil {
+		address = net.JoinHostPort(address, "53")
+	}
+
+	return &net.Resolver{
+		PreferGo: true,
+		Dial: func(ctx context.Context, network, _ string) (net.Conn, error) {
+			var dialer net.Dialer
+			return dialer.DialContext(ctx, network, address)
+		},
+	}
+}
+
+func resourceIpReverseBlockCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId(d.Get("ip").(string))
+
+	if err := resourceIpReverseBlockApply(ctx, d, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceIpReverseBlockRead(ctx, d, meta)
+}
+
+func resourceIpReverseBlockUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := resourceIpReverseBlockApply(ctx, d, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceIpReverseBlockRead(ctx, d, meta)
+}
+
+// resourceIpReverseBlockApply sets the reverses that are missing or changed,
+// and removes the ones that are not requested anymore
+func resourceIpReverseBlockApply(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	block := d.Get("ip").(string)
+	parallelism := d.Get("parallelism").(int)
+	retryDuration, _ := time.ParseDuration(d.Get("readiness_timeout_duration").(string))
+
+	desired, err := ipReverseBlockDesired(d)
+	if err != nil {
+		return err
+	}
+
+	// The records of the state hold the reverses currently set, the planned
+	// ones being the desired reverses
+	oldRecords, _ := d.GetChange("records")
+	current := make(map[string]string)
+	for ip, reverse := range oldRecords.(map[string]interface{}) {
+		current[ip] = reverse.(string)
+	}
+
+	var toSet, toDelete []string
+	for ip, reverse := range desired {
+		if current[ip] != reverse {
+			toSet = append(toSet, ip)
+		}
+	}
+	for ip := range current {
+		if _, ok := desired[ip]; !ok {
+			toDelete = append(toDelete, ip)
+		}
+	}
+	sort.Strings(toSet)
+	sort.Strings(toDelete)
+
+	if d.Get("check_forward").(bool) && len(toSet) > 0 {
+		resolver := ipReverseBlockResolver(d.Get("resolver").(string))
+		err := ipReverseBlockRun(toSet, parallelism, func(ip string) error {
+			return ipReverseCheckForward(ctx, resolver, ip, desired[ip])
+		})
+		if err != nil {
+			return fmt.Errorf("reverses are not confirmed by their forward resolution, no reverse was changed:\n%w", err)
+		}
+	}
+
+	endpoint := fmt.Sprintf("/ip/%s/reverse", url.PathEscape(block))
+
+	log.Printf("[DEBUG] Will set %d and remove %d reverses of %s", len(toSet), len(toDelete), block)
+	err = ipReverseBlockRun(toSet, parallelism, func(ip string) error {
+		opts := &IpReverseCreateOpts{IpReverse: ip, Reverse: desired[ip]}
+		if err := postIpReverseWithRetry(ctx, *config.OVHClient, endpoint, opts, &IpReverse{}, retryDuration); err != nil {
+			return fmt.Errorf("setting reverse %s of %s: %w", desired[ip], ip, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return ipReverseBlockRun(toDelete, parallelism, func(ip string) error {
+		reverseEndpoint := endpoint + "/" + url.PathEscape(ip)
+		if err := config.OVHClient.DeleteWithContext(ctx, reverseEndpoint, nil); err != nil {
+			if errOvh, ok := err.(*ovh.APIError); ok && errOvh.Code == 404 {
+				return nil
+			}
+			return fmt.Errorf("calling Delete %s: %w", reverseEndpoint, err)
+		}
+		return nil
+	})
+}
+
+func resourceIpReverseBlockRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	block := d.Get("ip").(string)
+
+	// Read the reverses of both the addresses requested and the ones that
+	// are known to have a reverse
+	ips := make(map[string]struct{})
+	for ip := range d.Get("records").(map[string]interface{}) {
+		ips[ip] = struct{}{}
+	}
+	if desired, err := ipReverseBlockDesired(d); err == nil {
+		for ip := range desired {
+			ips[ip] = struct{}{}
+		}
+	}
+
+	sortedIps := make([]string, 0, len(ips))
+	for ip := range ips {
+		sortedIps = append(sortedIps, ip)
+	}
+	sort.Strings(sortedIps)
+
+	var mu sync.Mutex
+	records := make(map[string]string)
+	err := ipReverseBlockRun(sortedIps, d.Get("parallelism").(int), func(ip string) error {
+		res := &IpReverse{}
+		endpoint := fmt.Sprintf("/ip/%s/reverse/%s", url.PathEscape(block), url.PathEscape(ip))
+		if err := config.OVHClient.GetWithContext(ctx, endpoint, res); err != nil {
+			if errOvh, ok := err.(*ovh.APIError); ok && errOvh.Code == 404 {
+				return nil
+			}
+			return fmt.Errorf("calling Get %s: %w", endpoint, err)
+		}
+
+		mu.Lock()
+		records[ip] = res.Reverse
+		mu.Unlock()
+		return nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("records", records)
+
+	return nil
+}
+
+func resourceIpReverseBlockDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	block := d.Get("ip").(string)
+
+	ips := make([]string, 0)
+	for ip := range d.Get("records").(map[string]interface{}) {
+		ips = append(ips, ip)
+	}
+	sort.Strings(ips)
+
+	log.Printf("[INFO] Deleting %d reverses of %s", len(ips), block)
+	err := ipReverseBlockRun(ips, d.Get("parallelism").(int), func(ip string) error {
+		endpoint := fmt.Sprintf("/ip/%s/reverse/%s", url.PathEscape(block), url.PathEscape(ip))
+		if err := config.OVHClient.DeleteWithContext(ctx, endpoint, nil); err != nil {
+			if errOvh, ok := err.(*ovh.APIError); ok && errOvh.Code == 404 {
+				return nil
+			}
+			return fmt.Errorf("calling Delete %s: %w", endpoint, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
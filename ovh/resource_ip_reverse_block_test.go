@@ -0,0 +1,121 @@
+package ovh
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestIpReverseBlockExpand(t *testing.T) {
+	reverses, err := ipReverseBlockExpand("192.0.2.0/24", "192.0.2.0/29", "mail-{d}.example.com", map[string]string{
+		"192.0.2.3":   "mx.example.com.",
+		"192.0.2.200": "other.example.com",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := map[string]string{
+		"192.0.2.1":   "mail-1.example.com.",
+		"192.0.2.2":   "mail-2.example.com.",
+		"192.0.2.3":   "mx.example.com.",
+		"192.0.2.4":   "mail-4.example.com.",
+		"192.0.2.5":   "mail-5.example.com.",
+		"192.0.2.6":   "mail-6.example.com.",
+		"192.0.2.200": "other.example.com.",
+	}
+	if !ipReverseBlockEqual(reverses, expected) {
+		t.Errorf("expected %v, got %v", expected, reverses)
+	}
+
+	reverses, err = ipReverseBlockExpand("192.0.2.4/31", "192.0.2.4/31", "{ip}.example.com", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(reverses) != 2 || reverses["192.0.2.4"] != "192-0-2-4.example.com." {
+		t.Errorf("expected both addresses of a /31 to be expanded, got %v", reverses)
+	}
+
+	for name, tc := range map[string]struct {
+		block, cidr, template string
+		reverses              map[string]string
+	}{
+		"cidr outside block":     {"192.0.2.0/24", "198.51.100.0/24", "{d}.example.com", nil},
+		"cidr larger than block": {"192.0.2.0/24", "192.0.0.0/16", "{d}.example.com", nil},
+		"ipv6 template":          {"2001:db8::/64", "2001:db8::/120", "{d}.example.com", nil},
+		"too many addresses":     {"10.0.0.0/8", "10.0.0.0/16", "{d}.example.com", nil},
+		"reverse outside block":  {"192.0.2.0/24", "", "", map[string]string{"198.51.100.1": "a.example.com"}},
+		"invalid address":        {"192.0.2.0/24", "", "", map[string]string{"192.0.2.x": "a.example.com"}},
+	} {
+		if _, err := ipReverseBlockExpand(tc.block, tc.cidr, tc.template, tc.reverses); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
+
+func TestIpReverseBlockRun(t *testing.T) {
+	var running, maxRunning int32
+
+	ips := []string{"192.0.2.1", "192.0.2.2", "192.0.2.3", "192.0.2.4", "192.0.2.5", "192.0.2.6"}
+	err := ipReverseBlockRun(ips, 2, func(ip string) error {
+		current := atomic.AddInt32(&running, 1)
+		defer atomic.AddInt32(&running, -1)
+
+		for {
+			previous := atomic.LoadInt32(&maxRunning)
+			if current <= previous || atomic.CompareAndSwapInt32(&maxRunning, previous, current) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+
+		if ip == "192.0.2.2" || ip == "192.0.2.5" {
+			return fmt.Errorf("failed on %s", ip)
+		}
+		return nil
+	})
+
+	if maxRunning > 2 {
+		t.Errorf("expected at most 2 concurrent calls, got %d", maxRunning)
+	}
+
+	var joined interface{ Unwrap() []error }
+	if !errors.As(err, &joined) || len(joined.Unwrap()) != 2 {
+		t.Errorf("expected the 2 errors to be returned, got %v", err)
+	}
+}
+
+func TestAccIpReverseBlock_basic(t *testing.T) {
+	block := os.Getenv("OVH_IP_BLOCK_TEST")
+	ip := os.Getenv("OVH_IP_TEST")
+	reverse := os.Getenv("OVH_IP_REVERSE_TEST")
+
+	config := fmt.Sprintf(`
+		resource "ovh_ip_reverse_block" "block" {
+			ip = "%s"
+
+			reverses = {
+				"%s" = "%s"
+			}
+		}
+	`, block, ip, reverse)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckIp(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ovh_ip_reverse_block.block", "records.%", "1"),
+					resource.TestCheckResourceAttr("ovh_ip_reverse_block.block", "records."+ip, ipReverseFqdn(reverse)),
+				),
+			},
+		},
+	})
+}
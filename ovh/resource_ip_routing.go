@@ -0,0 +1,524 @@
+package ovh
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/ovh/go-ovh/ovh"
+	"github.com/ovh/terraform-provider-ovh/v2/ovh/helpers"
+)
+
+func resourceIpRouting() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIpRoutingCreate,
+		ReadContext:   resourceIpRoutingRead,
+		UpdateContext: resourceIpRoutingUpdate,
+		DeleteContext: resourceIpRoutingDelete,
+		CustomizeDiff: resourceIpRoutingCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceIpRoutingImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"ip": {
+				Type:        schema.TypeString,
+				Description: "The failover or additional IP block to route",
+				Required:    true,
+				ForceNew:    true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := helpers.ValidateIpBlock(v.(string))
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+			"destination_urn": {
+				Type:        schema.TypeString,
+				Description: "URN of the service the IP block is routed to (dedicatedServer, vps, publicCloudProject instance or vrack)",
+				Required:    true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					if _, err := ParseIpRoutingDestination(v.(string)); err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+
+			// computed
+			"routed_to": {
+				Type:        schema.TypeString,
+				Description: "URN of the service the IP block is currently routed to, empty if the IP block is parked or if the kind of the service is not supported",
+				Computed:    true,
+			},
+			"service_name": {
+				Type:        schema.TypeString,
+				Description: "Name of the service the IP block is currently routed to",
+				Computed:    true,
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Description: "Type of the IP block",
+				Computed:    true,
+			},
+			"arp_blocked_ips": {
+				Type:        schema.TypeList,
+				Description: "IP addresses of the block currently blocked by the ARP protection",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"virtual_mac": {
+				Type:        schema.TypeString,
+				Description: "Virtual MAC of the destination dedicated server holding an address of the IP block, empty if none",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceIpRoutingImportState(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	splits := strings.SplitN(d.Id(), "|", 2)
+	if len(splits) != 2 {
+		return nil, fmt.Errorf("Import Id is not ip|destination_urn formatted")
+	}
+
+	d.SetId(splits[0])
+	d.Set("ip", splits[0])
+	d.Set("destination_urn", splits[1])
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// resourceIpRoutingCustomizeDiff plans an update when the IP block was routed
+// to another service outside of Terraform
+func resourceIpRoutingCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if d.Id() == "" || d.HasChange("destination_urn") {
+		return nil
+	}
+
+	if d.Get("routed_to").(string) != d.Get("destination_urn").(string) {
+		return d.SetNewComputed("routed_to")
+	}
+
+	return nil
+}
+
+func resourceIpRoutingCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := resourceIpRoutingApply(ctx, d, meta, nil); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(d.Get("ip").(string))
+
+	return resourceIpRoutingRead(ctx, d, meta)
+}
+
+func resourceIpRoutingUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	oldRoutedTo, _ := d.GetChange("routed_to")
+
+	// routed_to is empty when the IP block is parked, there is nothing to
+	// detach in that case
+	previous, _ := ParseIpRoutingDestination(oldRoutedTo.(string))
+
+	if err := resourceIpRoutingApply(ctx, d, meta, previous); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceIpRoutingRead(ctx, d, meta)
+}
+
+// resourceIpRoutingApply routes the IP block to its destination with the API
+// matching the kind of destination, detaching it from the previous vRack first
+func resourceIpRoutingApply(ctx context.Context, d *schema.ResourceData, meta interface{}, previous *IpRoutingDestination) error {
+	config := meta.(*Config)
+	ip := d.Get("ip").(string)
+
+	dest, err := ParseIpRoutingDestination(d.Get("destination_urn").(string))
+	if err != nil {
+		return err
+	}
+
+	arpBlocked, err := ipRoutingArpBlocked(ctx, config, ip)
+	if err != nil {
+		return err
+	}
+	if len(arpBlocked) > 0 {
+		return fmt.Errorf("IP addresses %s of %s are blocked by the ARP protection, unblock them before routing the IP block", strings.Join(arpBlocked, ", "), ip)
+	}
+
+	current, err := ipRoutingCurrentService(ctx, config, ip)
+	if err != nil {
+		return err
+	}
+
+	// An IP block announced in a vRack has to leave it before being moved
+	if previous != nil && previous.Kind == IpRoutingKindVrack && current == previous.ServiceName &&
+		(dest.Kind != IpRoutingKindVrack || dest.ServiceName != previous.ServiceName) {
+		if err := ipRoutingVrackDetach(ctx, config, previous.ServiceName, ip); err != nil {
+			return err
+		}
+		current = ""
+	}
+
+	switch dest.Kind {
+	case IpRoutingKindVrack:
+		if current == dest.ServiceName {
+			return nil
+		}
+
+		log.Printf("[DEBUG] Will add ip %s to vrack %s", ip, dest.ServiceName)
+		task := &VrackTask{}
+		endpoint := fmt.Sprintf("/vrack/%s/ip", url.PathEscape(dest.ServiceName))
+		if err := config.OVHClient.PostWithContext(ctx, endpoint, &VrackIpCreateOpts{Block: ip}, task); err != nil {
+			return fmt.Errorf("calling Post %s: %q", endpoint, err)
+		}
+
+		if err := waitForVrackTask(task, config.OVHClient); err != nil {
+			return fmt.Errorf("waiting for vrack %s to attach ip %s: %s", dest.ServiceName, ip, err)
+		}
+
+		return nil
+
+	case IpRoutingKindPublicCloudProject:
+		// The failover IP has to be routed to the project before being
+		// attached to one of its instances
+		if current != dest.ServiceName {
+			if err := ipRoutingMove(ctx, config, ip, dest.ServiceName); err != nil {
+				return err
+			}
+		}
+
+		return ipRoutingCloudAttach(ctx, config, ip, dest)
+
+	default:
+		if current == dest.ServiceName {
+			return nil
+		}
+
+		return ipRoutingMove(ctx, config, ip, dest.ServiceName)
+	}
+}
+
+func resourceIpRoutingRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	ip := d.Get("ip").(string)
+
+	r := &Ip{}
+	endpoint := fmt.Sprintf("/ip/%s", url.PathEscape(ip))
+	if err := config.OVHClient.GetWithContext(ctx, endpoint, r); err != nil {
+		return diag.FromErr(helpers.CheckDeleted(d, err, endpoint))
+	}
+
+	d.Set("type", r.Type)
+
+	arpBlocked, err := ipRoutingArpBlocked(ctx, config, ip)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set("arp_blocked_ips", arpBlocked)
+
+	dest, err := ParseIpRoutingDestination(d.Get("destination_urn").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var routedTo, virtualMac string
+	if r.RoutedTo != nil && r.RoutedTo.ServiceName != "" {
+		routed := &IpRoutingDestination{
+			Plate:       dest.Plate,
+			Kind:        dest.Kind,
+			ServiceName: r.RoutedTo.ServiceName,
+		}
+
+		// The API only returns the name of the service, its kind has to be
+		// looked up when it isn't the configured destination
+		if routed.ServiceName != dest.ServiceName {
+			routed.Kind, err = ipRoutingServiceKind(ctx, config, routed.ServiceName)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+		}
+
+		if dest.Kind == IpRoutingKindPublicCloudProject && routed.ServiceName == dest.ServiceName {
+			fip, err := ipRoutingCloudFailoverIp(ctx, config, dest.ServiceName, ip)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			routed.InstanceId = fip.RoutedTo
+		}
+
+		if dest.Kind == IpRoutingKindDedicatedServer && routed.ServiceName == dest.ServiceName {
+			virtualMac, err = ipRoutingVirtualMac(ctx, config, dest.ServiceName, ip)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+		}
+
+		// routed_to is left empty when the kind of the service is unknown
+		if routed.Kind != "" {
+			routedTo = routed.URN()
+		}
+	}
+
+	d.Set("service_name", "")
+	if r.RoutedTo != nil {
+		d.Set("service_name", r.RoutedTo.ServiceName)
+	}
+	d.Set("routed_to", routedTo)
+	d.Set("virtual_mac", virtualMac)
+
+	return nil
+}
+
+func resourceIpRoutingDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	ip := d.Get("ip").(string)
+
+	current, err := ipRoutingCurrentService(ctx, config, ip)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Nothing to do, the IP block is already parked
+	if current == "" {
+		d.SetId("")
+		return nil
+	}
+
+	if dest, err := ParseIpRoutingDestination(d.Get("destination_urn").(string)); err == nil &&
+		dest.Kind == IpRoutingKindVrack && dest.ServiceName == current {
+		if err := ipRoutingVrackDetach(ctx, config, current, ip); err != nil {
+			return diag.FromErr(err)
+		}
+		d.SetId("")
+		return nil
+	}
+
+	log.Printf("[DEBUG] Will move ip %s from service %s to IP parking", ip, current)
+	ipTask := &IpTask{}
+	endpoint := fmt.Sprintf("/ip/%s/park", url.PathEscape(ip))
+	if err := config.OVHClient.PostWithContext(ctx, endpoint, nil, ipTask); err != nil {
+		return diag.Errorf("calling Post %s: %q", endpoint, err)
+	}
+
+	if err := ipRoutingWaitTask(ctx, config, ip, ipTask); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// ipRoutingCurrentService returns the name of the service the IP block is
+// routed to, or an empty string if it is parked
+func ipRoutingCurrentService(ctx context.Context, config *Config, ip string) (string, error) {
+	r := &Ip{}
+	endpoint := fmt.Sprintf("/ip/%s", url.PathEscape(ip))
+	if err := config.OVHClient.GetWithContext(ctx, endpoint, r); err != nil {
+		return "", fmt.Errorf("calling Get %s: %q", endpoint, err)
+	}
+
+	if r.RoutedTo == nil {
+		return "", nil
+	}
+
+	return r.RoutedTo.ServiceName, nil
+}
+
+// ipRoutingServiceKind returns the kind of destination of a service the IP
+// block is routed to, or an empty string if it isn't a known kind of service
+func ipRoutingServiceKind(ctx context.Context, config *Config, serviceName string) (string, error) {
+	for _, kind := range IpRoutingKinds {
+		endpoint := fmt.Sprintf(ipRoutingKindEndpoints[kind], url.PathEscape(serviceName))
+		if err := config.OVHClient.GetWithContext(ctx, endpoint, nil); err != nil {
+			if isOvhApiNotFound(err) {
+				continue
+			}
+			return "", fmt.Errorf("calling Get %s: %q", endpoint, err)
+		}
+
+		return kind, nil
+	}
+
+	return "", nil
+}
+
+// ipRoutingArpBlocked returns the addresses of the IP block blocked by the
+// ARP protection
+func ipRoutingArpBlocked(ctx context.Context, config *Config, ip string) ([]string, error) {
+	blocked := []string{}
+	endpoint := fmt.Sprintf("/ip/%s/arp?state=blocked", url.PathEscape(ip))
+	if err := config.OVHClient.GetWithContext(ctx, endpoint, &blocked); err != nil {
+		return nil, fmt.Errorf("calling Get %s: %q", endpoint, err)
+	}
+
+	return blocked, nil
+}
+
+// ipRoutingMove moves the IP block to a service and waits for the move to be done
+func ipRoutingMove(ctx context.Context, config *Config, ip, serviceName string) error {
+	log.Printf("[DEBUG] Will move ip %s to service %s", ip, serviceName)
+
+	ipTask := &IpTask{}
+	endpoint := fmt.Sprintf("/ip/%s/move", url.PathEscape(ip))
+	if err := config.OVHClient.PostWithContext(ctx, endpoint, &IpMoveOpts{To: &serviceName}, ipTask); err != nil {
+		return fmt.Errorf("calling Post %s: %q", endpoint, err)
+	}
+
+	return ipRoutingWaitTask(ctx, config, ip, ipTask)
+}
+
+// ipRoutingWaitTask waits for an IP task to reach a terminal state
+func ipRoutingWaitTask(ctx context.Context, config *Config, ip string, ipTask *IpTask) error {
+	endpoint := fmt.Sprintf("/ip/%s/task/%d", url.PathEscape(ip), ipTask.TaskId)
+
+	return retry.RetryContext(ctx, 30*time.Minute, func() *retry.RetryError {
+		switch ipTask.Status {
+		case IpTaskStatusDone:
+			return nil
+		case IpTaskStatusCancelled, IpTaskStatusOvhError, IpTaskStatusCustomerError:
+			return retry.NonRetryableError(fmt.Errorf("task %d on ip %s ended with status %s", ipTask.TaskId, ip, ipTask.Status))
+		}
+
+		if err := config.OVHClient.GetWithContext(ctx, endpoint, ipTask); err != nil {
+			return retry.NonRetryableError(fmt.Errorf("calling Get %s: %q", endpoint, err))
+		}
+
+		return retry.RetryableError(fmt.Errorf("waiting for task %d on ip %s to be done", ipTask.TaskId, ip))
+	})
+}
+
+// ipRoutingVrackDetach removes the IP block from a vRack
+func ipRoutingVrackDetach(ctx context.Context, config *Config, vrack, ip string) error {
+	log.Printf("[DEBUG] Will remove ip %s from vrack %s", ip, vrack)
+
+	task := &VrackTask{}
+	endpoint := fmt.Sprintf("/vrack/%s/ip/%s", url.PathEscape(vrack), url.PathEscape(ip))
+	if err := config.OVHClient.DeleteWithContext(ctx, endpoint, task); err != nil {
+		return fmt.Errorf("calling Delete %s: %q", endpoint, err)
+	}
+
+	if err := waitForVrackTask(task, config.OVHClient); err != nil {
+		return fmt.Errorf("waiting for vrack %s to detach ip %s: %s", vrack, ip, err)
+	}
+
+	return nil
+}
+
+// ipRoutingCloudFailoverIp returns the failover IP of a Public Cloud project
+// matching the IP block
+func ipRoutingCloudFailoverIp(ctx context.Context, config *Config, projectId, ip string) (*FailoverIp, error) {
+	ips := []FailoverIp{}
+	endpoint := fmt.Sprintf("/cloud/project/%s/ip/failover", url.PathEscape(projectId))
+	if err := config.OVHClient.GetWithContext(ctx, endpoint, &ips); err != nil {
+		return nil, fmt.Errorf("calling Get %s: %q", endpoint, err)
+	}
+
+	for i := range ips {
+		if ips[i].Block == ip || ips[i].Ip == ip || ips[i].Ip+"/32" == ip {
+			return &ips[i], nil
+		}
+	}
+
+	return nil, fmt.Errorf("failover IP %s cannot be found in cloud project %s", ip, projectId)
+}
+
+// ipRoutingCloudAttach attaches the failover IP to the instance of the
+// destination, and waits for the operation to be done
+func ipRoutingCloudAttach(ctx context.Context, config *Config, ip string, dest *IpRoutingDestination) error {
+	var fip *FailoverIp
+
+	// The failover IP may take some time to show up in the project after a move
+	err := retry.RetryContext(ctx, 5*time.Minute, func() *retry.RetryError {
+		var err error
+		if fip, err = ipRoutingCloudFailoverIp(ctx, config, dest.ServiceName, ip); err != nil {
+			return retry.RetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if fip.RoutedTo == dest.InstanceId {
+		return nil
+	}
+
+	log.Printf("[DEBUG] Will attach failover ip %s to instance %s", ip, dest.InstanceId)
+	endpoint := fmt.Sprintf("/cloud/project/%s/ip/failover/%s/attach",
+		url.PathEscape(dest.ServiceName),
+		url.PathEscape(fip.Id),
+	)
+	opts := &ProjectIpFailoverAttachCreation{InstanceId: &dest.InstanceId}
+
+	return retry.RetryContext(ctx, 10*time.Minute, func() *retry.RetryError {
+		if fip.RoutedTo == dest.InstanceId && fip.Status != "operationPending" {
+			return nil
+		}
+
+		if fip.RoutedTo != dest.InstanceId && fip.Status != "operationPending" {
+			if err := config.OVHClient.PostWithContext(ctx, endpoint, opts, fip); err != nil {
+				// 400 errors can mean that the instance is not ready yet
+				if errOvh, ok := err.(*ovh.APIError); ok && errOvh.Code == http.StatusBadRequest {
+					return retry.RetryableError(fmt.Errorf("calling Post %s: %q", endpoint, err))
+				}
+				return retry.NonRetryableError(fmt.Errorf("calling Post %s: %q", endpoint, err))
+			}
+		}
+
+		var err error
+		if fip, err = ipRoutingCloudFailoverIp(ctx, config, dest.ServiceName, ip); err != nil {
+			return retry.NonRetryableError(err)
+		}
+
+		return retry.RetryableError(errors.New("waiting for failover IP to be attached"))
+	})
+}
+
+// ipRoutingVirtualMac returns the virtual MAC of the dedicated server that
+// holds an address of the IP block, if any
+func ipRoutingVirtualMac(ctx context.Context, config *Config, serviceName, ip string) (string, error) {
+	block := ip
+	if !strings.Contains(block, "/") {
+		block += "/32"
+	}
+	_, network, err := net.ParseCIDR(block)
+	if err != nil {
+		return "", err
+	}
+
+	macs := []string{}
+	endpoint := fmt.Sprintf("/dedicated/server/%s/virtualMac", url.PathEscape(serviceName))
+	if err := config.OVHClient.GetWithContext(ctx, endpoint, &macs); err != nil {
+		return "", fmt.Errorf("calling Get %s: %q", endpoint, err)
+	}
+
+	for _, mac := range macs {
+		addresses := []string{}
+		endpoint := fmt.Sprintf("/dedicated/server/%s/virtualMac/%s/virtualAddress",
+			url.PathEscape(serviceName),
+			url.PathEscape(mac),
+		)
+		if err := config.OVHClient.GetWithContext(ctx, endpoint, &addresses); err != nil {
+			return "", fmt.Errorf("calling Get %s: %q", endpoint, err)
+		}
+
+		for _, address := range addresses {
+			if network.Contains(net.ParseIP(address)) {
+				return mac, nil
+			}
+		}
+	}
+
+	return "", nil
+}
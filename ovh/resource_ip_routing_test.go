@@ -0,0 +1,120 @@
+package ovh
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestParseIpRoutingDestination(t *testing.T) {
+	tests := []struct {
+		urn     string
+		want    IpRoutingDestination
+		wantErr bool
+	}{
+		{
+			urn:  "urn:v1:eu:resource:dedicatedServer:ns1234.ip-192-0-2.eu",
+			want: IpRoutingDestination{Plate: "eu", Kind: "dedicatedServer", ServiceName: "ns1234.ip-192-0-2.eu"},
+		},
+		{
+			urn:  "urn:v1:ca:resource:vps:vps-abcdef.vps.ovh.ca",
+			want: IpRoutingDestination{Plate: "ca", Kind: "vps", ServiceName: "vps-abcdef.vps.ovh.ca"},
+		},
+		{
+			urn:  "urn:v1:eu:resource:vrack:pn-12345",
+			want: IpRoutingDestination{Plate: "eu", Kind: "vrack", ServiceName: "pn-12345"},
+		},
+		{
+			urn:  "urn:v1:eu:resource:publicCloudProject:0123456789abcdef/instance/11111111-2222-3333-4444-555555555555",
+			want: IpRoutingDestination{Plate: "eu", Kind: "publicCloudProject", ServiceName: "0123456789abcdef", InstanceId: "11111111-2222-3333-4444-555555555555"},
+		},
+		{urn: "urn:v1:eu:resource:publicCloudProject:0123456789abcdef", wantErr: true},
+		{urn: "urn:v1:eu:resource:publicCloudProject:0123456789abcdef/instance/", wantErr: true},
+		{urn: "urn:v1:eu:resource:vrack:pn-12345/ip/192.0.2.0", wantErr: true},
+		{urn: "urn:v1:eu:resource:dbaasLogs:ldp-ab-12345", wantErr: true},
+		{urn: "urn:v1:eu:identity:user:xx1111-ovh/user", wantErr: true},
+		{urn: "ns1234.ip-192-0-2.eu", wantErr: true},
+		{urn: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseIpRoutingDestination(tt.urn)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("ParseIpRoutingDestination(%q) expected an error, got %+v", tt.urn, got)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("ParseIpRoutingDestination(%q) unexpected error: %s", tt.urn, err)
+			continue
+		}
+
+		if *got != tt.want {
+			t.Errorf("ParseIpRoutingDestination(%q) = %+v, want %+v", tt.urn, *got, tt.want)
+		}
+
+		if got.URN() != tt.urn {
+			t.Errorf("URN() = %q, want %q", got.URN(), tt.urn)
+		}
+	}
+}
+
+func TestAccIpRouting_dedicatedServer(t *testing.T) {
+	ipBlock := os.Getenv("OVH_IP_BLOCK_MOVE_TEST")
+	serviceName := os.Getenv("OVH_IP_MOVE_SERVICE_NAME_TEST")
+	urn := fmt.Sprintf("urn:v1:eu:resource:dedicatedServer:%s", serviceName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckIpMove(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIpRoutingDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "ovh_ip_routing" "routing" {
+						ip              = "%s"
+						destination_urn = "%s"
+					}`,
+					ipBlock, urn),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ovh_ip_routing.routing", "routed_to", urn),
+					resource.TestCheckResourceAttr("ovh_ip_routing.routing", "service_name", serviceName),
+					resource.TestCheckResourceAttr("ovh_ip_routing.routing", "arp_blocked_ips.#", "0"),
+				),
+			},
+			{
+				ResourceName:      "ovh_ip_routing.routing",
+				ImportState:       true,
+				ImportStateId:     ipBlock + "|" + urn,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIpRoutingDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ovh_ip_routing" {
+			continue
+		}
+
+		current, err := ipRoutingCurrentService(context.Background(), config, rs.Primary.Attributes["ip"])
+		if err != nil {
+			return err
+		}
+
+		if current != "" {
+			return fmt.Errorf("IP %s still routed to a service: %s", rs.Primary.Attributes["ip"], current)
+		}
+	}
+
+	return nil
+}
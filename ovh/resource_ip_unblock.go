@@ -0,0 +1,211 @@
+package ovh
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/ovh/go-ovh/ovh"
+	ovhtypes "github.com/ovh/terraform-provider-ovh/v2/ovh/types"
+)
+
+var _ resource.ResourceWithConfigure = (*ipUnblockResource)(nil)
+
+func NewIpUnblockResource() resource.Resource {
+	return &ipUnblockResource{}
+}
+
+type ipUnblockResource struct {
+	config *Config
+}
+
+func (r *ipUnblockResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ip_unblock"
+}
+
+func (r *ipUnblockResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.config = config
+}
+
+func (r *ipUnblockResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Request the unblocking of an IP address blocked by the anti-spam or anti-hack protection",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				CustomType:  ovhtypes.TfStringType{},
+				Computed:    true,
+				Description: "Unique identifier for the resource",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"ip": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Required:            true,
+				Description:         "IP block (e.g., 192.0.2.0/24)",
+				MarkdownDescription: "IP block (e.g., 192.0.2.0/24)",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"ip_blocked": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Required:            true,
+				Description:         "IPv4 address of the block to unblock (e.g., 192.0.2.1)",
+				MarkdownDescription: "IPv4 address of the block to unblock (e.g., 192.0.2.1)",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Required:            true,
+				Description:         "Protection that blocked the IP address (spam or antihack)",
+				MarkdownDescription: "Protection that blocked the IP address (`spam` or `antihack`)",
+				Validators: []validator.String{
+					stringvalidator.OneOf("spam", "antihack"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				Description:         "Arbitrary map of values that, when changed, requests the unblocking again",
+				MarkdownDescription: "Arbitrary map of values that, when changed, requests the unblocking again",
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"state": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Computed:            true,
+				Description:         "State of the IP address after the unblocking request, empty if it was never blocked",
+				MarkdownDescription: "State of the IP address after the unblocking request, empty if it was never blocked",
+			},
+		},
+	}
+}
+
+func (r *ipUnblockResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ipUnblockModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = ovhtypes.NewTfStringValue(data.Ip.ValueString() + "/" + data.Type.ValueString() + "/" + data.IpBlocked.ValueString())
+
+	state, err := r.getState(ctx, &data)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error calling Get %s", data.endpoint()), err.Error())
+		return
+	}
+
+	// Nothing to unblock, the IP address is not blocked anymore
+	if state != data.blockedState() {
+		data.State = ovhtypes.NewTfStringValue(state)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	endpoint := data.endpoint() + "/unblock"
+	if err := r.config.OVHClient.PostWithContext(ctx, endpoint, nil, nil); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error calling Post %s", endpoint), err.Error())
+		return
+	}
+
+	// Wait for the unblocking request to be taken into account
+	err = retry.RetryContext(ctx, 10*time.Minute, func() *retry.RetryError {
+		state, err = r.getState(ctx, &data)
+		if err != nil {
+			return retry.NonRetryableError(err)
+		}
+
+		if state != data.blockedState() {
+			return nil
+		}
+
+		return retry.RetryableError(errors.New("waiting for IP address to be unblocked"))
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("error waiting for IP address to be unblocked", err.Error())
+		return
+	}
+
+	data.State = ovhtypes.NewTfStringValue(state)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ipUnblockResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ipUnblockModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The resource is an action, it is kept in the state even if the IP
+	// address gets blocked again, only its current state is refreshed
+	state, err := r.getState(ctx, &data)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error calling Get %s", data.endpoint()), err.Error())
+		return
+	}
+
+	data.State = ovhtypes.NewTfStringValue(state)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ipUnblockResource) Update(_ context.Context, _ resource.UpdateRequest, _ *resource.UpdateResponse) {
+	// No update on API side, all the arguments require a replacement
+}
+
+func (r *ipUnblockResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+	// An unblocking request can't be reverted, the resource is only dropped from the state
+}
+
+// getState returns the state of the blocked IP address, or an empty string
+// if it is unknown to the protection
+func (r *ipUnblockResource) getState(ctx context.Context, data *ipUnblockModel) (string, error) {
+	var blocked struct {
+		State string `json:"state"`
+	}
+
+	if err := r.config.OVHClient.GetWithContext(ctx, data.endpoint(), &blocked); err != nil {
+		if errOvh, ok := err.(*ovh.APIError); ok && errOvh.Code == http.StatusNotFound {
+			return "", nil
+		}
+		return "", err
+	}
+
+	return blocked.State, nil
+}
@@ -0,0 +1,39 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const testAccIPUnblockConfig = `
+resource "ovh_ip_unblock" "unblock" {
+	ip         = "%s"
+	ip_blocked = "%s"
+	type       = "spam"
+}
+`
+
+// The tested IP is not blocked, the resource only records its state
+func TestAccIPUnblock_notBlocked(t *testing.T) {
+	ip := os.Getenv("OVH_IP_TEST")
+
+	config := fmt.Sprintf(testAccIPUnblockConfig, ip, ip)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckIp(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ovh_ip_unblock.unblock", "ip_blocked", ip),
+					resource.TestCheckResourceAttr("ovh_ip_unblock.unblock", "type", "spam"),
+					resource.TestCheckResourceAttrSet("ovh_ip_unblock.unblock", "id"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,366 @@
+package ovh
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/ovh/go-ovh/ovh"
+	ovhtypes "github.com/ovh/terraform-provider-ovh/v2/ovh/types"
+)
+
+var _ resource.ResourceWithConfigure = (*iploadbalancingFreeCertificateResource)(nil)
+var _ resource.ResourceWithImportState = (*iploadbalancingFreeCertificateResource)(nil)
+
+func NewIploadbalancingFreeCertificateResource() resource.Resource {
+	return &iploadbalancingFreeCertificateResource{}
+}
+
+type iploadbalancingFreeCertificateResource struct {
+	config *Config
+}
+
+func (r *iploadbalancingFreeCertificateResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_iploadbalancing_free_certificate"
+}
+
+func (r *iploadbalancingFreeCertificateResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.config = config
+}
+
+func (r *iploadbalancingFreeCertificateResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Orders a free DV certificate managed by an IP load balancing.",
+		MarkdownDescription: "Orders a free DV certificate managed by an IP load balancing.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				CustomType:          ovhtypes.TfInt64Type{},
+				Computed:            true,
+				Description:         "Id of the SSL certificate",
+				MarkdownDescription: "Id of the SSL certificate",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"service_name": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Required:            true,
+				Description:         "The internal name of your IP load balancing",
+				MarkdownDescription: "The internal name of your IP load balancing",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"fqdn": schema.SetAttribute{
+				ElementType:         types.StringType,
+				Required:            true,
+				Description:         "Domain names the certificate is issued for. They must resolve to the IP load balancing",
+				MarkdownDescription: "Domain names the certificate is issued for. They must resolve to the IP load balancing",
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"display_name": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Optional:            true,
+				Computed:            true,
+				Description:         "Human readable name for your SSL certificate",
+				MarkdownDescription: "Human readable name for your SSL certificate",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"expire_date": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Computed:            true,
+				Description:         "Expire date of the SSL certificate. The certificate is renewed by the IP load balancing before it expires",
+				MarkdownDescription: "Expire date of the SSL certificate. The certificate is renewed by the IP load balancing before it expires",
+			},
+			"fingerprint": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Computed:            true,
+				Description:         "Fingerprint of the SSL certificate",
+				MarkdownDescription: "Fingerprint of the SSL certificate",
+			},
+			"san": schema.SetAttribute{
+				ElementType:         types.StringType,
+				Computed:            true,
+				Description:         "Subject Alternative Names of the SSL certificate",
+				MarkdownDescription: "Subject Alternative Names of the SSL certificate",
+			},
+			"serial": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Computed:            true,
+				Description:         "Serial of the SSL certificate",
+				MarkdownDescription: "Serial of the SSL certificate",
+			},
+			"subject": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Computed:            true,
+				Description:         "Subject of the SSL certificate",
+				MarkdownDescription: "Subject of the SSL certificate",
+			},
+			"type": schema.StringAttribute{
+				CustomType:          ovhtypes.TfStringType{},
+				Computed:            true,
+				Description:         "Type of the SSL certificate",
+				MarkdownDescription: "Type of the SSL certificate",
+			},
+		},
+	}
+}
+
+func (r *iploadbalancingFreeCertificateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	splits := strings.Split(req.ID, "/")
+	if len(splits) != 2 {
+		resp.Diagnostics.AddError("Given ID is malformed", "ID must be formatted like the following: <service_name>/<sslId>")
+		return
+	}
+
+	sslId, err := strconv.ParseInt(splits[1], 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Given ID is malformed", "sslId must be an integer")
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("service_name"), splits[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), sslId)...)
+}
+
+func (r *iploadbalancingFreeCertificateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var (
+		data iploadbalancingFreeCertificateModel
+		opts IpLoadbalancingFreeCertificateCreateOpts
+		task IPLoadbalancingRefreshTask
+	)
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(data.Fqdn.ElementsAs(ctx, &opts.Fqdn, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serviceName := data.ServiceName.ValueString()
+
+	// The task doesn't return the id of the certificate, the certificates built
+	// before the order are listed so that only a new one can be picked afterwards
+	previousIds, err := r.listBuiltCertificates(ctx, serviceName)
+	if err != nil {
+		resp.Diagnostics.AddError("Error listing existing certificates", err.Error())
+		return
+	}
+
+	changes := iploadbalancingQueueChanges(r.config, serviceName)
+	defer changes.Release(ctx)
+
+	endpoint := "/ipLoadbalancing/" + url.PathEscape(serviceName) + "/freeCertificate"
+	if err := r.config.OVHClient.PostWithContext(ctx, endpoint, opts, &task); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error calling Post %s", endpoint), err.Error())
+		return
+	}
+
+	if err := iploadbalancingWaitTask(ctx, r.config, serviceName, task.ID); err != nil {
+		resp.Diagnostics.AddError("Error waiting for free certificate issuance", err.Error())
+		return
+	}
+
+	ssl, err := r.findCertificate(ctx, serviceName, opts.Fqdn, previousIds)
+	if err != nil {
+		resp.Diagnostics.AddError("Error retrieving issued certificate", err.Error())
+		return
+	}
+
+	if !data.DisplayName.IsNull() && !data.DisplayName.IsUnknown() {
+		displayName := data.DisplayName.ValueString()
+		endpoint := "/ipLoadbalancing/" + url.PathEscape(serviceName) + "/ssl/" + strconv.FormatInt(ssl.Id, 10)
+		if err := r.config.OVHClient.PutWithContext(ctx, endpoint, map[string]string{"displayName": displayName}, nil); err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Error calling Put %s", endpoint), err.Error())
+			return
+		}
+		ssl.DisplayName = &displayName
+	}
+
+	resp.Diagnostics.Append(data.MergeWith(ctx, ssl)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := changes.Apply(ctx); err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Error applying changes on IP load balancer %s", serviceName),
+			err.Error(),
+		)
+	}
+}
+
+// listBuiltCertificates returns the ids of the certificates built by the IP load balancing
+func (r *iploadbalancingFreeCertificateResource) listBuiltCertificates(ctx context.Context, serviceName string) ([]int64, error) {
+	var ids []int64
+
+	endpoint := "/ipLoadbalancing/" + url.PathEscape(serviceName) + "/ssl?type=built"
+	if err := r.config.OVHClient.GetWithContext(ctx, endpoint, &ids); err != nil {
+		return nil, fmt.Errorf("calling Get %s: %w", endpoint, err)
+	}
+
+	return ids, nil
+}
+
+// findCertificate returns the certificate built by the IP load balancing for the given
+// FQDNs, ignoring the certificates that existed before it was ordered
+func (r *iploadbalancingFreeCertificateResource) findCertificate(ctx context.Context, serviceName string, fqdns []string, previousIds []int64) (*IpLoadbalancingSsl, error) {
+	ids, err := r.listBuiltCertificates(ctx, serviceName)
+	if err != nil {
+		return nil, err
+	}
+
+	previous := make(map[int64]struct{}, len(previousIds))
+	for _, id := range previousIds {
+		previous[id] = struct{}{}
+	}
+
+	sort.Slice(ids, func(i, j int) bool { return ids[i] > ids[j] })
+	for _, id := range ids {
+		if _, ok := previous[id]; ok {
+			continue
+		}
+
+		ssl := &IpLoadbalancingSsl{}
+		endpoint := "/ipLoadbalancing/" + url.PathEscape(serviceName) + "/ssl/" + strconv.FormatInt(id, 10)
+		if err := r.config.OVHClient.GetWithContext(ctx, endpoint, ssl); err != nil {
+			return nil, fmt.Errorf("calling Get %s: %w", endpoint, err)
+		}
+
+		if ssl.coversFqdns(fqdns) {
+			return ssl, nil
+		}
+	}
+
+	return nil, fmt.Errorf("no new certificate found for %s", strings.Join(fqdns, ", "))
+}
+
+func (r *iploadbalancingFreeCertificateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var (
+		data iploadbalancingFreeCertificateModel
+		ssl  IpLoadbalancingSsl
+	)
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	endpoint := "/ipLoadbalancing/" + url.PathEscape(data.ServiceName.ValueString()) + "/ssl/" + strconv.FormatInt(data.Id.ValueInt64(), 10)
+	if err := r.config.OVHClient.GetWithContext(ctx, endpoint, &ssl); err != nil {
+		if errOvh, ok := err.(*ovh.APIError); ok && errOvh.Code == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(fmt.Sprintf("Error calling Get %s", endpoint), err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(data.MergeWith(ctx, &ssl)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *iploadbalancingFreeCertificateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var (
+		data, stateData iploadbalancingFreeCertificateModel
+		ssl             IpLoadbalancingSsl
+	)
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &stateData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only the display name can be updated, other arguments force a replacement
+	endpoint := "/ipLoadbalancing/" + url.PathEscape(stateData.ServiceName.ValueString()) + "/ssl/" + strconv.FormatInt(stateData.Id.ValueInt64(), 10)
+	if err := r.config.OVHClient.PutWithContext(ctx, endpoint, map[string]string{"displayName": data.DisplayName.ValueString()}, nil); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error calling Put %s", endpoint), err.Error())
+		return
+	}
+
+	if err := r.config.OVHClient.GetWithContext(ctx, endpoint, &ssl); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error calling Get %s", endpoint), err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(data.MergeWith(ctx, &ssl)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *iploadbalancingFreeCertificateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data iploadbalancingFreeCertificateModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serviceName := data.ServiceName.ValueString()
+	changes := iploadbalancingQueueChanges(r.config, serviceName)
+	defer changes.Release(ctx)
+
+	endpoint := "/ipLoadbalancing/" + url.PathEscape(serviceName) + "/ssl/" + strconv.FormatInt(data.Id.ValueInt64(), 10)
+	if err := r.config.OVHClient.DeleteWithContext(ctx, endpoint, nil); err != nil {
+		if errOvh, ok := err.(*ovh.APIError); ok && errOvh.Code == http.StatusNotFound {
+			return
+		}
+		resp.Diagnostics.AddError(fmt.Sprintf("Error calling Delete %s", endpoint), err.Error())
+		return
+	}
+
+	if err := changes.Apply(ctx); err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Error applying changes on IP load balancer %s", serviceName),
+			err.Error(),
+		)
+	}
+}
@@ -0,0 +1,65 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+const testAccIpLoadbalancingFreeCertificateConfig = `
+resource "ovh_iploadbalancing_free_certificate" "cert" {
+  service_name = "%s"
+  fqdn         = ["%s"]
+  display_name = "%s"
+}
+`
+
+func TestAccIpLoadbalancingFreeCertificate_basic(t *testing.T) {
+	iplb := os.Getenv("OVH_IPLB_SERVICE_TEST")
+	fqdn := os.Getenv("OVH_IPLB_FREE_CERTIFICATE_FQDN_TEST")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckIpLoadbalancing(t)
+			checkEnvOrSkip(t, "OVH_IPLB_FREE_CERTIFICATE_FQDN_TEST")
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccIpLoadbalancingFreeCertificateConfig, iplb, fqdn, "aaa"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("ovh_iploadbalancing_free_certificate.cert", "id"),
+					resource.TestCheckResourceAttrSet("ovh_iploadbalancing_free_certificate.cert", "expire_date"),
+					resource.TestCheckResourceAttr("ovh_iploadbalancing_free_certificate.cert", "type", "built"),
+					resource.TestCheckResourceAttr("ovh_iploadbalancing_free_certificate.cert", "display_name", "aaa"),
+					resource.TestCheckTypeSetElemAttr("ovh_iploadbalancing_free_certificate.cert", "san.*", fqdn),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccIpLoadbalancingFreeCertificateConfig, iplb, fqdn, "bbb"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ovh_iploadbalancing_free_certificate.cert", "display_name", "bbb"),
+				),
+			},
+			{
+				ResourceName:      "ovh_iploadbalancing_free_certificate.cert",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccIpLoadbalancingFreeCertificateImportId("ovh_iploadbalancing_free_certificate.cert"),
+			},
+		},
+	})
+}
+
+func testAccIpLoadbalancingFreeCertificateImportId(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("resource not found: %s", resourceName)
+		}
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["service_name"], rs.Primary.Attributes["id"]), nil
+	}
+}
@@ -1,22 +1,25 @@
 package ovh
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/ovh/terraform-provider-ovh/v2/ovh/helpers"
 )
 
 func resourceIpLoadbalancingHttpFarm() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceIpLoadbalancingHttpFarmCreate,
-		Read:   resourceIpLoadbalancingHttpFarmRead,
-		Update: resourceIpLoadbalancingHttpFarmUpdate,
-		Delete: resourceIpLoadbalancingHttpFarmDelete,
+		CreateContext: resourceIpLoadbalancingHttpFarmCreate,
+		Read:          resourceIpLoadbalancingHttpFarmRead,
+		UpdateContext: resourceIpLoadbalancingHttpFarmUpdate,
+		DeleteContext: resourceIpLoadbalancingHttpFarmDelete,
 		Importer: &schema.ResourceImporter{
 			State: resourceIpLoadbalancingHttpFarmImportState,
 		},
+		CustomizeDiff: resourceIpLoadbalancingFarmCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"service_name": {
@@ -170,7 +173,7 @@ func resourceIpLoadbalancingHttpFarmImportState(d *schema.ResourceData, meta int
 	return results, nil
 }
 
-func resourceIpLoadbalancingHttpFarmCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIpLoadbalancingHttpFarmCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
 	farm := (&IpLoadbalancingFarmCreateOrUpdateOpts{}).FromResource(d)
@@ -178,14 +181,21 @@ func resourceIpLoadbalancingHttpFarmCreate(d *schema.ResourceData, meta interfac
 	resp := &IpLoadbalancingFarm{}
 	endpoint := fmt.Sprintf("/ipLoadbalancing/%s/http/farm", service)
 
-	err := config.OVHClient.Post(endpoint, farm, resp)
+	changes := iploadbalancingQueueChanges(config, service)
+	defer changes.Release(ctx)
+
+	err := config.OVHClient.PostWithContext(ctx, endpoint, farm, resp)
 	if err != nil {
-		return fmt.Errorf("calling POST %s :\n\t %s", endpoint, err.Error())
+		return diag.Errorf("calling POST %s :\n\t %s", endpoint, err.Error())
 	}
 
 	d.SetId(fmt.Sprintf("%d", resp.FarmId))
 
-	return resourceIpLoadbalancingHttpFarmRead(d, meta)
+	if err := changes.Apply(ctx); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diag.FromErr(resourceIpLoadbalancingHttpFarmRead(d, meta))
 }
 
 func resourceIpLoadbalancingHttpFarmRead(d *schema.ResourceData, meta interface{}) error {
@@ -217,32 +227,46 @@ func resourceIpLoadbalancingHttpFarmRead(d *schema.ResourceData, meta interface{
 	return nil
 }
 
-func resourceIpLoadbalancingHttpFarmUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIpLoadbalancingHttpFarmUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 	service := d.Get("service_name").(string)
 	endpoint := fmt.Sprintf("/ipLoadbalancing/%s/http/farm/%s", service, d.Id())
 
 	farm := (&IpLoadbalancingFarmCreateOrUpdateOpts{}).FromResource(d)
 
-	err := config.OVHClient.Put(endpoint, farm, nil)
+	changes := iploadbalancingQueueChanges(config, service)
+	defer changes.Release(ctx)
+
+	err := config.OVHClient.PutWithContext(ctx, endpoint, farm, nil)
 	if err != nil {
-		return fmt.Errorf("calling PUT %s:\n\t %s", endpoint, err.Error())
+		return diag.Errorf("calling PUT %s:\n\t %s", endpoint, err.Error())
 	}
 
-	return resourceIpLoadbalancingHttpFarmRead(d, meta)
+	if err := changes.Apply(ctx); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diag.FromErr(resourceIpLoadbalancingHttpFarmRead(d, meta))
 
 }
 
-func resourceIpLoadbalancingHttpFarmDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIpLoadbalancingHttpFarmDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
 	service := d.Get("service_name").(string)
 	r := &IpLoadbalancingFarm{}
 	endpoint := fmt.Sprintf("/ipLoadbalancing/%s/http/farm/%s", service, d.Id())
 
-	err := config.OVHClient.Delete(endpoint, &r)
+	changes := iploadbalancingQueueChanges(config, service)
+	defer changes.Release(ctx)
+
+	err := config.OVHClient.DeleteWithContext(ctx, endpoint, &r)
 	if err != nil {
-		return fmt.Errorf("calling DELETE %s: %s \n", endpoint, err.Error())
+		return diag.Errorf("calling DELETE %s: %s \n", endpoint, err.Error())
+	}
+
+	if err := changes.Apply(ctx); err != nil {
+		return diag.FromErr(err)
 	}
 
 	d.SetId("")
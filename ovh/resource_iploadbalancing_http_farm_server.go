@@ -1,21 +1,23 @@
 package ovh
 
 import (
+	"context"
 	"fmt"
 	"net"
 	"strconv"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/ovh/terraform-provider-ovh/v2/ovh/helpers"
 )
 
 func resourceIpLoadbalancingHttpFarmServer() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceIpLoadbalancingHttpFarmServerCreate,
-		Read:   resourceIpLoadbalancingHttpFarmServerRead,
-		Update: resourceIpLoadbalancingHttpFarmServerUpdate,
-		Delete: resourceIpLoadbalancingHttpFarmServerDelete,
+		CreateContext: resourceIpLoadbalancingHttpFarmServerCreate,
+		Read:          resourceIpLoadbalancingHttpFarmServerRead,
+		UpdateContext: resourceIpLoadbalancingHttpFarmServerUpdate,
+		DeleteContext: resourceIpLoadbalancingHttpFarmServerDelete,
 		Importer: &schema.ResourceImporter{
 			State: resourceIpLoadbalancingHttpFarmServerImportState,
 		},
@@ -142,7 +144,7 @@ func resourceIpLoadbalancingHttpFarmServerImportState(d *schema.ResourceData, me
 	return results, nil
 }
 
-func resourceIpLoadbalancingHttpFarmServerCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIpLoadbalancingHttpFarmServerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
 	newBackendServer := &IpLoadbalancingFarmServerCreateOpts{
@@ -164,15 +166,22 @@ func resourceIpLoadbalancingHttpFarmServerCreate(d *schema.ResourceData, meta in
 	r := &IpLoadbalancingFarmServer{}
 	endpoint := fmt.Sprintf("/ipLoadbalancing/%s/http/farm/%d/server", service, farmid)
 
-	err := config.OVHClient.Post(endpoint, newBackendServer, r)
+	changes := iploadbalancingQueueChanges(config, service)
+	defer changes.Release(ctx)
+
+	err := config.OVHClient.PostWithContext(ctx, endpoint, newBackendServer, r)
 	if err != nil {
-		return fmt.Errorf("calling POST %s with %d:\n\t %s", endpoint, farmid, err.Error())
+		return diag.Errorf("calling POST %s with %d:\n\t %s", endpoint, farmid, err.Error())
 	}
 
 	//set id
 	d.SetId(fmt.Sprintf("%d", r.ServerId))
 
-	return resourceIpLoadbalancingHttpFarmServerRead(d, meta)
+	if err := changes.Apply(ctx); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diag.FromErr(resourceIpLoadbalancingHttpFarmServerRead(d, meta))
 }
 
 func resourceIpLoadbalancingHttpFarmServerRead(d *schema.ResourceData, meta interface{}) error {
@@ -196,7 +205,7 @@ func resourceIpLoadbalancingHttpFarmServerRead(d *schema.ResourceData, meta inte
 	return nil
 }
 
-func resourceIpLoadbalancingHttpFarmServerUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIpLoadbalancingHttpFarmServerUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
 	update := &IpLoadbalancingFarmServerUpdateOpts{
@@ -218,14 +227,21 @@ func resourceIpLoadbalancingHttpFarmServerUpdate(d *schema.ResourceData, meta in
 	r := &IpLoadbalancingFarmServer{}
 	endpoint := fmt.Sprintf("/ipLoadbalancing/%s/http/farm/%d/server/%s", service, farmid, d.Id())
 
-	err := config.OVHClient.Put(endpoint, update, r)
+	changes := iploadbalancingQueueChanges(config, service)
+	defer changes.Release(ctx)
+
+	err := config.OVHClient.PutWithContext(ctx, endpoint, update, r)
 	if err != nil {
-		return fmt.Errorf("calling PUT %s with %d:\n\t %s", endpoint, farmid, err.Error())
+		return diag.Errorf("calling PUT %s with %d:\n\t %s", endpoint, farmid, err.Error())
+	}
+	if err := changes.Apply(ctx); err != nil {
+		return diag.FromErr(err)
 	}
-	return resourceIpLoadbalancingHttpFarmServerRead(d, meta)
+
+	return diag.FromErr(resourceIpLoadbalancingHttpFarmServerRead(d, meta))
 }
 
-func resourceIpLoadbalancingHttpFarmServerDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIpLoadbalancingHttpFarmServerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
 	service := d.Get("service_name").(string)
@@ -234,9 +250,16 @@ func resourceIpLoadbalancingHttpFarmServerDelete(d *schema.ResourceData, meta in
 	r := &IpLoadbalancingFarmServer{}
 	endpoint := fmt.Sprintf("/ipLoadbalancing/%s/http/farm/%d/server/%s", service, farmid, d.Id())
 
-	err := config.OVHClient.Delete(endpoint, r)
+	changes := iploadbalancingQueueChanges(config, service)
+	defer changes.Release(ctx)
+
+	err := config.OVHClient.DeleteWithContext(ctx, endpoint, r)
 	if err != nil {
-		return fmt.Errorf("calling DELETE %s :\n\t %s", endpoint, err.Error())
+		return diag.Errorf("calling DELETE %s :\n\t %s", endpoint, err.Error())
+	}
+
+	if err := changes.Apply(ctx); err != nil {
+		return diag.FromErr(err)
 	}
 
 	d.SetId("")
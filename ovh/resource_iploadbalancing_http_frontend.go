@@ -1,19 +1,21 @@
 package ovh
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/ovh/terraform-provider-ovh/v2/ovh/helpers"
 )
 
 func resourceIpLoadbalancingHttpFrontend() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceIpLoadbalancingHttpFrontendCreate,
-		Read:   resourceIpLoadbalancingHttpFrontendRead,
-		Update: resourceIpLoadbalancingHttpFrontendUpdate,
-		Delete: resourceIpLoadbalancingHttpFrontendDelete,
+		CreateContext: resourceIpLoadbalancingHttpFrontendCreate,
+		Read:          resourceIpLoadbalancingHttpFrontendRead,
+		UpdateContext: resourceIpLoadbalancingHttpFrontendUpdate,
+		DeleteContext: resourceIpLoadbalancingHttpFrontendDelete,
 		Importer: &schema.ResourceImporter{
 			State: resourceIpLoadbalancingHttpFrontendImportState,
 		},
@@ -106,7 +108,7 @@ func resourceIpLoadbalancingHttpFrontendImportState(d *schema.ResourceData, meta
 	return results, nil
 }
 
-func resourceIpLoadbalancingHttpFrontendCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIpLoadbalancingHttpFrontendCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
 	allowedSources, _ := helpers.StringsFromSchema(d, "allowed_source")
@@ -115,13 +117,13 @@ func resourceIpLoadbalancingHttpFrontendCreate(d *schema.ResourceData, meta inte
 
 	for _, s := range allowedSources {
 		if err := helpers.ValidateIpBlock(s); err != nil {
-			return fmt.Errorf("Error validating `allowed_source` value: %s", err)
+			return diag.Errorf("Error validating `allowed_source` value: %s", err)
 		}
 	}
 
 	for _, s := range dedicatedIpFo {
 		if err := helpers.ValidateIpBlock(s); err != nil {
-			return fmt.Errorf("Error validating `dedicated_ipfo` value: %s", err)
+			return diag.Errorf("Error validating `dedicated_ipfo` value: %s", err)
 		}
 	}
 
@@ -145,14 +147,21 @@ func resourceIpLoadbalancingHttpFrontendCreate(d *schema.ResourceData, meta inte
 	resp := &IpLoadbalancingHttpFrontend{}
 	endpoint := fmt.Sprintf("/ipLoadbalancing/%s/http/frontend", service)
 
-	err := config.OVHClient.Post(endpoint, frontend, resp)
+	changes := iploadbalancingQueueChanges(config, service)
+	defer changes.Release(ctx)
+
+	err := config.OVHClient.PostWithContext(ctx, endpoint, frontend, resp)
 	if err != nil {
-		return fmt.Errorf("calling POST %s:\n\t %s", endpoint, err.Error())
+		return diag.Errorf("calling POST %s:\n\t %s", endpoint, err.Error())
 	}
 
 	d.SetId(fmt.Sprintf("%d", resp.FrontendId))
 
-	return resourceIpLoadbalancingHttpFrontendRead(d, meta)
+	if err := changes.Apply(ctx); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diag.FromErr(resourceIpLoadbalancingHttpFrontendRead(d, meta))
 }
 
 func resourceIpLoadbalancingHttpFrontendRead(d *schema.ResourceData, meta interface{}) error {
@@ -192,7 +201,7 @@ func resourceIpLoadbalancingHttpFrontendRead(d *schema.ResourceData, meta interf
 	return nil
 }
 
-func resourceIpLoadbalancingHttpFrontendUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIpLoadbalancingHttpFrontendUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 	service := d.Get("service_name").(string)
 	endpoint := fmt.Sprintf("/ipLoadbalancing/%s/http/frontend/%s", service, d.Id())
@@ -203,13 +212,13 @@ func resourceIpLoadbalancingHttpFrontendUpdate(d *schema.ResourceData, meta inte
 
 	for _, s := range allowedSources {
 		if err := helpers.ValidateIpBlock(s); err != nil {
-			return fmt.Errorf("Error validating `allowed_source` value: %s", err)
+			return diag.Errorf("Error validating `allowed_source` value: %s", err)
 		}
 	}
 
 	for _, s := range dedicatedIpFo {
 		if err := helpers.ValidateIpBlock(s); err != nil {
-			return fmt.Errorf("Error validating `dedicated_ipfo` value: %s", err)
+			return diag.Errorf("Error validating `dedicated_ipfo` value: %s", err)
 		}
 	}
 
@@ -229,23 +238,37 @@ func resourceIpLoadbalancingHttpFrontendUpdate(d *schema.ResourceData, meta inte
 	frontend.DefaultFarmId = helpers.GetNilIntPointerFromData(d, "default_farm_id")
 	frontend.DefaultSslId = helpers.GetNilIntPointerFromData(d, "default_ssl_id")
 
-	err := config.OVHClient.Put(endpoint, frontend, nil)
+	changes := iploadbalancingQueueChanges(config, service)
+	defer changes.Release(ctx)
+
+	err := config.OVHClient.PutWithContext(ctx, endpoint, frontend, nil)
 	if err != nil {
-		return fmt.Errorf("calling %s:\n\t %s", endpoint, err.Error())
+		return diag.Errorf("calling %s:\n\t %s", endpoint, err.Error())
 	}
 
-	return resourceIpLoadbalancingHttpFrontendRead(d, meta)
+	if err := changes.Apply(ctx); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diag.FromErr(resourceIpLoadbalancingHttpFrontendRead(d, meta))
 }
 
-func resourceIpLoadbalancingHttpFrontendDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIpLoadbalancingHttpFrontendDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
 	service := d.Get("service_name").(string)
 	endpoint := fmt.Sprintf("/ipLoadbalancing/%s/http/frontend/%s", service, d.Id())
 
-	err := config.OVHClient.Delete(endpoint, nil)
+	changes := iploadbalancingQueueChanges(config, service)
+	defer changes.Release(ctx)
+
+	err := config.OVHClient.DeleteWithContext(ctx, endpoint, nil)
 	if err != nil {
-		return fmt.Errorf("Error calling %s: %s \n", endpoint, err.Error())
+		return diag.Errorf("Error calling %s: %s \n", endpoint, err.Error())
+	}
+
+	if err := changes.Apply(ctx); err != nil {
+		return diag.FromErr(err)
 	}
 
 	d.SetId("")
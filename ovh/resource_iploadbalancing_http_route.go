@@ -1,23 +1,29 @@
 package ovh
 
 import (
+	"context"
 	"fmt"
 	"net/url"
+	"reflect"
+	"strconv"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/ovh/terraform-provider-ovh/v2/ovh/helpers"
 )
 
 func resourceIPLoadbalancingHttpRoute() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceIPLoadbalancingHttpRouteCreate,
-		Read:   resourceIPLoadbalancingHttpRouteRead,
-		Update: resourceIPLoadbalancingHttpRouteUpdate,
-		Delete: resourceIPLoadbalancingHttpRouteDelete,
+		CreateContext: resourceIPLoadbalancingHttpRouteCreate,
+		Read:          resourceIPLoadbalancingHttpRouteRead,
+		UpdateContext: resourceIPLoadbalancingHttpRouteUpdate,
+		DeleteContext: resourceIPLoadbalancingHttpRouteDelete,
 		Importer: &schema.ResourceImporter{
 			State: resourceIpLoadbalancingHttpRouteImportState,
 		},
+		CustomizeDiff: resourceIPLoadbalancingHttpRouteCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"service_name": {
@@ -45,9 +51,10 @@ func resourceIPLoadbalancingHttpRoute() *schema.Resource {
 							Optional:    true,
 						},
 						"type": {
-							Type:        schema.TypeString,
-							Description: "Action to trigger if all the rules of this route matches",
-							Required:    true,
+							Type:         schema.TypeString,
+							Description:  "Action to trigger if all the rules of this route matches",
+							Required:     true,
+							ValidateFunc: helpers.ValidateEnum(IpLoadbalancingHttpRouteActionTypes),
 						},
 					},
 				},
@@ -64,10 +71,11 @@ func resourceIPLoadbalancingHttpRoute() *schema.Resource {
 				Computed:    true,
 			},
 			"weight": {
-				Type:        schema.TypeInt,
-				Description: "Route priority ([0..255]). 0 if null. Highest priority routes are evaluated last. Only the first matching route will trigger an action",
-				Optional:    true,
-				Computed:    true,
+				Type:         schema.TypeInt,
+				Description:  "Route priority ([0..255]). 0 if null. Highest priority routes are evaluated last. Only the first matching route will trigger an action",
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(0, 255),
 			},
 
 			//computed
@@ -78,29 +86,33 @@ func resourceIPLoadbalancingHttpRoute() *schema.Resource {
 			},
 			"rules": {
 				Type:        schema.TypeList,
-				Description: "List of rules to match to trigger action",
+				Description: "List of rules to match to trigger action. If omitted, the rules are left to ovh_iploadbalancing_http_route_rule resources",
+				Optional:    true,
 				Computed:    true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"field": {
-							Type:        schema.TypeString,
-							Description: "Name of the field to match like \"protocol\" or \"host\". See \"/ipLoadbalancing/{serviceName}/route/availableRules\" for a list of available rules",
-							Computed:    true,
+							Type:         schema.TypeString,
+							Description:  "Name of the field to match like \"protocol\" or \"host\". See \"/ipLoadbalancing/{serviceName}/route/availableRules\" for a list of available rules",
+							Required:     true,
+							ValidateFunc: helpers.ValidateEnum(IpLoadbalancingHttpRouteRuleFields),
 						},
 						"match": {
-							Type:        schema.TypeString,
-							Description: "Matching operator. Not all operators are available for all fields. See \"/availableRules\"",
-							Computed:    true,
+							Type:         schema.TypeString,
+							Description:  "Matching operator. Not all operators are available for all fields. See \"/availableRules\"",
+							Required:     true,
+							ValidateFunc: helpers.ValidateEnum(IpLoadbalancingRouteRuleMatches),
 						},
 						"negate": {
 							Type:        schema.TypeBool,
 							Description: "Invert the matching operator effect",
-							Computed:    true,
+							Optional:    true,
+							Default:     false,
 						},
 						"pattern": {
 							Type:        schema.TypeString,
 							Description: "Value to match against this match. Interpretation if this field depends on the match and field",
-							Computed:    true,
+							Optional:    true,
 						},
 						"rule_id": {
 							Type:        schema.TypeInt,
@@ -110,7 +122,7 @@ func resourceIPLoadbalancingHttpRoute() *schema.Resource {
 						"sub_field": {
 							Type:        schema.TypeString,
 							Description: "Name of sub-field, if applicable. This may be a Cookie or Header name for instance",
-							Computed:    true,
+							Optional:    true,
 						},
 					},
 				},
@@ -135,7 +147,129 @@ func resourceIpLoadbalancingHttpRouteImportState(d *schema.ResourceData, meta in
 	return results, nil
 }
 
-func resourceIPLoadbalancingHttpRouteCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIPLoadbalancingHttpRouteCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	config := meta.(*Config)
+
+	if d.HasChange("rules") && d.NewValueKnown("rules") {
+		for i, rule := range d.Get("rules").([]interface{}) {
+			if rule == nil || !d.NewValueKnown(fmt.Sprintf("rules.%d.pattern", i)) || !d.NewValueKnown(fmt.Sprintf("rules.%d.sub_field", i)) {
+				continue
+			}
+			opts := (&IPLoadbalancingRouteRuleOpts{}).FromMap(rule.(map[string]interface{}))
+			if err := opts.ValidateHttp(); err != nil {
+				return fmt.Errorf("invalid rule %d: %w", i, err)
+			}
+		}
+	}
+
+	// The service may not exist yet
+	if !d.NewValueKnown("service_name") || !d.NewValueKnown("action") {
+		return nil
+	}
+	serviceName := d.Get("service_name").(string)
+
+	if d.HasChange("action") && d.NewValueKnown("action.0.target") && d.NewValueKnown("action.0.status") {
+		actions := d.Get("action").([]interface{})
+		if len(actions) == 1 && actions[0] != nil {
+			action := actions[0].(map[string]interface{})
+			opts := &IPLoadbalancingHttpRouteActionOpts{Type: action["type"].(string)}
+			if target := action["target"].(string); target != "" {
+				opts.Target = &target
+			}
+			if status := int64(action["status"].(int)); status != 0 {
+				opts.Status = &status
+			}
+
+			available := []IpLoadbalancingAvailableRouteAction{}
+			endpoint := fmt.Sprintf("/ipLoadbalancing/%s/availableRouteActions", url.PathEscape(serviceName))
+			if err := config.OVHClient.GetWithContext(ctx, endpoint, &available); err != nil {
+				return fmt.Errorf("calling GET %s:\n\t %s", endpoint, err.Error())
+			}
+
+			if err := opts.Validate(available); err != nil {
+				return fmt.Errorf("invalid action: %w", err)
+			}
+		}
+	}
+
+	// Two routes of a frontend with the same weight are evaluated in an unspecified order
+	if d.Id() != "" && !d.HasChange("weight") && !d.HasChange("frontend_id") {
+		return nil
+	}
+	if !d.NewValueKnown("weight") || !d.NewValueKnown("frontend_id") {
+		return nil
+	}
+	frontendId := d.Get("frontend_id").(int)
+	if frontendId == 0 {
+		return nil
+	}
+	weight := int64(d.Get("weight").(int))
+
+	routeIds := []int64{}
+	endpoint := fmt.Sprintf("/ipLoadbalancing/%s/http/route?frontendId=%d", url.PathEscape(serviceName), frontendId)
+	if err := config.OVHClient.GetWithContext(ctx, endpoint, &routeIds); err != nil {
+		return fmt.Errorf("calling GET %s:\n\t %s", endpoint, err.Error())
+	}
+
+	for _, routeId := range routeIds {
+		if strconv.FormatInt(routeId, 10) == d.Id() {
+			continue
+		}
+
+		route := &IPLoadbalancingHttpRoute{}
+		endpoint := fmt.Sprintf("/ipLoadbalancing/%s/http/route/%d", url.PathEscape(serviceName), routeId)
+		if err := config.OVHClient.GetWithContext(ctx, endpoint, route); err != nil {
+			return fmt.Errorf("calling GET %s:\n\t %s", endpoint, err.Error())
+		}
+
+		if route.Weight == weight {
+			return fmt.Errorf("weight %d is already used by route %d of frontend %d, routes of a frontend must have distinct weights", weight, routeId, frontendId)
+		}
+	}
+
+	return nil
+}
+
+// resourceIPLoadbalancingHttpRouteSyncRules updates the rules of the route to match
+// the rules of the configuration, reusing the existing rules in order
+func resourceIPLoadbalancingHttpRouteSyncRules(config *Config, serviceName, routeId string, oldRules, newRules []interface{}) error {
+	endpoint := fmt.Sprintf("/ipLoadbalancing/%s/http/route/%s/rule",
+		url.PathEscape(serviceName),
+		url.PathEscape(routeId),
+	)
+
+	for i, rule := range newRules {
+		opts := (&IPLoadbalancingRouteRuleOpts{}).FromMap(rule.(map[string]interface{}))
+
+		if i >= len(oldRules) {
+			if err := config.OVHClient.Post(endpoint, opts, nil); err != nil {
+				return fmt.Errorf("calling POST %s :\n\t %s", endpoint, err.Error())
+			}
+			continue
+		}
+
+		oldRule := oldRules[i].(map[string]interface{})
+		if reflect.DeepEqual(opts, (&IPLoadbalancingRouteRuleOpts{}).FromMap(oldRule)) {
+			continue
+		}
+
+		ruleEndpoint := fmt.Sprintf("%s/%d", endpoint, oldRule["rule_id"].(int))
+		if err := config.OVHClient.Put(ruleEndpoint, opts, nil); err != nil {
+			return fmt.Errorf("calling PUT %s:\n\t %s", ruleEndpoint, err.Error())
+		}
+	}
+
+	for i := len(newRules); i < len(oldRules); i++ {
+		ruleEndpoint := fmt.Sprintf("%s/%d", endpoint, oldRules[i].(map[string]interface{})["rule_id"].(int))
+		if err := config.OVHClient.Delete(ruleEndpoint, nil); err != nil {
+			return fmt.Errorf("calling DELETE %s:\n\t %s", ruleEndpoint, err.Error())
+		}
+	}
+
+	return nil
+}
+
+func resourceIPLoadbalancingHttpRouteCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
 	serviceName := d.Get("service_name").(string)
@@ -145,13 +279,24 @@ func resourceIPLoadbalancingHttpRouteCreate(d *schema.ResourceData, meta interfa
 		url.PathEscape(serviceName),
 	)
 
-	if err := config.OVHClient.Post(endpoint, route, resp); err != nil {
-		return fmt.Errorf("calling POST %s :\n\t %s", endpoint, err.Error())
+	changes := iploadbalancingQueueChanges(config, serviceName)
+	defer changes.Release(ctx)
+
+	if err := config.OVHClient.PostWithContext(ctx, endpoint, route, resp); err != nil {
+		return diag.Errorf("calling POST %s :\n\t %s", endpoint, err.Error())
 	}
 
 	d.SetId(fmt.Sprintf("%d", resp.RouteId))
 
-	return resourceIPLoadbalancingHttpRouteRead(d, meta)
+	if err := resourceIPLoadbalancingHttpRouteSyncRules(config, serviceName, d.Id(), nil, d.Get("rules").([]interface{})); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := changes.Apply(ctx); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diag.FromErr(resourceIPLoadbalancingHttpRouteRead(d, meta))
 }
 
 func resourceIPLoadbalancingHttpRouteRead(d *schema.ResourceData, meta interface{}) error {
@@ -176,7 +321,7 @@ func resourceIPLoadbalancingHttpRouteRead(d *schema.ResourceData, meta interface
 	return nil
 }
 
-func resourceIPLoadbalancingHttpRouteUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIPLoadbalancingHttpRouteUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 	serviceName := d.Get("service_name").(string)
 	route := (&IPLoadbalancingHttpRouteOpts{}).FromResource(d)
@@ -186,14 +331,28 @@ func resourceIPLoadbalancingHttpRouteUpdate(d *schema.ResourceData, meta interfa
 		url.PathEscape(d.Id()),
 	)
 
-	if err := config.OVHClient.Put(endpoint, route, nil); err != nil {
-		return fmt.Errorf("calling PUT %s:\n\t %s", endpoint, err.Error())
+	changes := iploadbalancingQueueChanges(config, serviceName)
+	defer changes.Release(ctx)
+
+	if err := config.OVHClient.PutWithContext(ctx, endpoint, route, nil); err != nil {
+		return diag.Errorf("calling PUT %s:\n\t %s", endpoint, err.Error())
 	}
 
-	return resourceIPLoadbalancingHttpRouteRead(d, meta)
+	if d.HasChange("rules") {
+		oldRules, newRules := d.GetChange("rules")
+		if err := resourceIPLoadbalancingHttpRouteSyncRules(config, serviceName, d.Id(), oldRules.([]interface{}), newRules.([]interface{})); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if err := changes.Apply(ctx); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diag.FromErr(resourceIPLoadbalancingHttpRouteRead(d, meta))
 }
 
-func resourceIPLoadbalancingHttpRouteDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIPLoadbalancingHttpRouteDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 	serviceName := d.Get("service_name").(string)
 	endpoint := fmt.Sprintf("/ipLoadbalancing/%s/http/route/%s",
@@ -201,9 +360,16 @@ func resourceIPLoadbalancingHttpRouteDelete(d *schema.ResourceData, meta interfa
 		url.PathEscape(d.Id()),
 	)
 
-	if err := config.OVHClient.Delete(endpoint, nil); err != nil {
-		return helpers.CheckDeleted(d, err, endpoint)
+	changes := iploadbalancingQueueChanges(config, serviceName)
+	defer changes.Release(ctx)
+
+	if err := config.OVHClient.DeleteWithContext(ctx, endpoint, nil); err != nil {
+		return diag.FromErr(helpers.CheckDeleted(d, err, endpoint))
 	}
+	if err := changes.Apply(ctx); err != nil {
+		return diag.FromErr(err)
+	}
+
 	d.SetId("")
 	return nil
 }
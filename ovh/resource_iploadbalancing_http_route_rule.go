@@ -1,20 +1,22 @@
 package ovh
 
 import (
+	"context"
 	"fmt"
 	"net/url"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/ovh/terraform-provider-ovh/v2/ovh/helpers"
 )
 
 func resourceIPLoadbalancingHttpRouteRule() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceIPLoadbalancingHttpRouteRuleCreate,
-		Read:   resourceIPLoadbalancingHttpRouteRuleRead,
-		Update: resourceIPLoadbalancingHttpRouteRuleUpdate,
-		Delete: resourceIPLoadbalancingHttpRouteRuleDelete,
+		CreateContext: resourceIPLoadbalancingHttpRouteRuleCreate,
+		Read:          resourceIPLoadbalancingHttpRouteRuleRead,
+		UpdateContext: resourceIPLoadbalancingHttpRouteRuleUpdate,
+		DeleteContext: resourceIPLoadbalancingHttpRouteRuleDelete,
 		Importer: &schema.ResourceImporter{
 			State: resourceIpLoadbalancingHttpRouteRuleImportState,
 		},
@@ -85,7 +87,7 @@ func resourceIpLoadbalancingHttpRouteRuleImportState(d *schema.ResourceData, met
 	return results, nil
 }
 
-func resourceIPLoadbalancingHttpRouteRuleCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIPLoadbalancingHttpRouteRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
 	rule := (&IPLoadbalancingRouteRuleOpts{}).FromResource(d)
@@ -98,13 +100,20 @@ func resourceIPLoadbalancingHttpRouteRuleCreate(d *schema.ResourceData, meta int
 		url.PathEscape(routeId),
 	)
 
-	if err := config.OVHClient.Post(endpoint, rule, resp); err != nil {
-		return fmt.Errorf("calling POST %s :\n\t %s", endpoint, err.Error())
+	changes := iploadbalancingQueueChanges(config, serviceName)
+	defer changes.Release(ctx)
+
+	if err := config.OVHClient.PostWithContext(ctx, endpoint, rule, resp); err != nil {
+		return diag.Errorf("calling POST %s :\n\t %s", endpoint, err.Error())
 	}
 
 	d.SetId(fmt.Sprintf("%d", resp.RuleId))
 
-	return resourceIPLoadbalancingHttpRouteRuleRead(d, meta)
+	if err := changes.Apply(ctx); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diag.FromErr(resourceIPLoadbalancingHttpRouteRuleRead(d, meta))
 }
 
 func resourceIPLoadbalancingHttpRouteRuleRead(d *schema.ResourceData, meta interface{}) error {
@@ -132,7 +141,7 @@ func resourceIPLoadbalancingHttpRouteRuleRead(d *schema.ResourceData, meta inter
 	return nil
 }
 
-func resourceIPLoadbalancingHttpRouteRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIPLoadbalancingHttpRouteRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 	rule := (&IPLoadbalancingRouteRuleOpts{}).FromResource(d)
 	serviceName := d.Get("service_name").(string)
@@ -143,14 +152,21 @@ func resourceIPLoadbalancingHttpRouteRuleUpdate(d *schema.ResourceData, meta int
 		url.PathEscape(d.Id()),
 	)
 
-	if err := config.OVHClient.Put(endpoint, rule, nil); err != nil {
-		return fmt.Errorf("calling PUT %s:\n\t %s", endpoint, err.Error())
+	changes := iploadbalancingQueueChanges(config, serviceName)
+	defer changes.Release(ctx)
+
+	if err := config.OVHClient.PutWithContext(ctx, endpoint, rule, nil); err != nil {
+		return diag.Errorf("calling PUT %s:\n\t %s", endpoint, err.Error())
+	}
+
+	if err := changes.Apply(ctx); err != nil {
+		return diag.FromErr(err)
 	}
 
-	return resourceIPLoadbalancingHttpRouteRuleRead(d, meta)
+	return diag.FromErr(resourceIPLoadbalancingHttpRouteRuleRead(d, meta))
 }
 
-func resourceIPLoadbalancingHttpRouteRuleDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIPLoadbalancingHttpRouteRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 	serviceName := d.Get("service_name").(string)
 	routeId := d.Get("route_id").(string)
@@ -160,9 +176,16 @@ func resourceIPLoadbalancingHttpRouteRuleDelete(d *schema.ResourceData, meta int
 		url.PathEscape(d.Id()),
 	)
 
-	if err := config.OVHClient.Delete(endpoint, nil); err != nil {
-		return helpers.CheckDeleted(d, err, endpoint)
+	changes := iploadbalancingQueueChanges(config, serviceName)
+	defer changes.Release(ctx)
+
+	if err := config.OVHClient.DeleteWithContext(ctx, endpoint, nil); err != nil {
+		return diag.FromErr(helpers.CheckDeleted(d, err, endpoint))
 	}
+	if err := changes.Apply(ctx); err != nil {
+		return diag.FromErr(err)
+	}
+
 	d.SetId("")
 	return nil
 }
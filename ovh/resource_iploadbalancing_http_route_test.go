@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"regexp"
 	"strings"
 	"testing"
 	"time"
@@ -151,3 +152,72 @@ resource "ovh_iploadbalancing_http_route" "testroute" {
 	}
 }
 `
+
+func TestAccIPLoadbalancingHttpRouteWithRules(t *testing.T) {
+	serviceName := os.Getenv("OVH_IPLB_SERVICE_TEST")
+	name := acctest.RandomWithPrefix(test_prefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccCheckIpLoadbalancingHttpRoutePreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIPLoadbalancingHttpRouteDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccCheckOvhIpLoadbalancingHttpRouteConfig_rules, serviceName, name, "example.com", "X-Test"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ovh_iploadbalancing_http_route.testroute", "rules.#", "2"),
+					resource.TestCheckResourceAttr(
+						"ovh_iploadbalancing_http_route.testroute", "rules.0.field", "host"),
+					resource.TestCheckResourceAttr(
+						"ovh_iploadbalancing_http_route.testroute", "rules.0.pattern", "example.com"),
+					resource.TestCheckResourceAttrSet(
+						"ovh_iploadbalancing_http_route.testroute", "rules.0.rule_id"),
+					resource.TestCheckResourceAttr(
+						"ovh_iploadbalancing_http_route.testroute", "rules.1.sub_field", "X-Test"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccCheckOvhIpLoadbalancingHttpRouteConfig_rules, serviceName, name, "www.example.com", "X-Other"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ovh_iploadbalancing_http_route.testroute", "rules.#", "2"),
+					resource.TestCheckResourceAttr(
+						"ovh_iploadbalancing_http_route.testroute", "rules.0.pattern", "www.example.com"),
+					resource.TestCheckResourceAttr(
+						"ovh_iploadbalancing_http_route.testroute", "rules.1.sub_field", "X-Other"),
+				),
+			},
+			{
+				Config:      fmt.Sprintf(testAccCheckOvhIpLoadbalancingHttpRouteConfig_rules, serviceName, name, "www.example.com", ""),
+				ExpectError: regexp.MustCompile(`field "header" requires a sub_field`),
+			},
+		},
+	})
+}
+
+const testAccCheckOvhIpLoadbalancingHttpRouteConfig_rules = `
+resource "ovh_iploadbalancing_http_route" "testroute" {
+	service_name = "%s"
+	display_name = "%s"
+	weight = 1
+
+	action {
+	  status = 302
+	  target = "https://$${host}$${path}"
+	  type = "redirect"
+	}
+
+	rules {
+	  field = "host"
+	  match = "is"
+	  pattern = "%s"
+	}
+
+	rules {
+	  field = "header"
+	  match = "exists"
+	  sub_field = "%s"
+	}
+}
+`
@@ -0,0 +1,177 @@
+package ovh
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/ovh/terraform-provider-ovh/v2/ovh/helpers"
+)
+
+func resourceIpLoadbalancingLogSubscription() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIpLoadbalancingLogSubscriptionCreate,
+		ReadContext:   resourceIpLoadbalancingLogSubscriptionRead,
+		DeleteContext: resourceIpLoadbalancingLogSubscriptionDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceIpLoadbalancingLogSubscriptionImportState,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service_name": {
+				Type:        schema.TypeString,
+				Description: "The internal name of your IP load balancing",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"stream_id": {
+				Type:        schema.TypeString,
+				Description: "Id of the target Log data platform stream",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"kind": {
+				Type:        schema.TypeString,
+				Description: "Log kind name of this subscription",
+				Required:    true,
+				ForceNew:    true,
+			},
+
+			//computed
+			"created_at": {
+				Type:        schema.TypeString,
+				Description: "Creation date of the subscription",
+				Computed:    true,
+			},
+			"ldp_service_name": {
+				Type:        schema.TypeString,
+				Description: "Name of the destination log service",
+				Computed:    true,
+			},
+			"operation_id": {
+				Type:        schema.TypeString,
+				Description: "Identifier of the operation",
+				Computed:    true,
+			},
+			"resource_name": {
+				Type:        schema.TypeString,
+				Description: "Name of subscribed resource, where the logs come from",
+				Computed:    true,
+			},
+			"resource_type": {
+				Type:        schema.TypeString,
+				Description: "Type of subscribed resource, where the logs come from",
+				Computed:    true,
+			},
+			"updated_at": {
+				Type:        schema.TypeString,
+				Description: "Last update date of the subscription",
+				Computed:    true,
+			},
+			"subscription_id": {
+				Type:        schema.TypeString,
+				Description: "Id of the subscription",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceIpLoadbalancingLogSubscriptionImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	givenId := d.Id()
+	splitId := strings.SplitN(givenId, "/", 2)
+	if len(splitId) != 2 {
+		return nil, fmt.Errorf("Import Id is not service_name/subscription_id formatted")
+	}
+	serviceName := splitId[0]
+	id := splitId[1]
+	d.SetId(id)
+	d.Set("service_name", serviceName)
+
+	results := make([]*schema.ResourceData, 1)
+	results[0] = d
+	return results, nil
+}
+
+func resourceIpLoadbalancingLogSubscriptionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+
+	endpoint := fmt.Sprintf("/ipLoadbalancing/%s/log/subscription", url.PathEscape(serviceName))
+	params := (&IpLoadbalancingLogSubscriptionCreateOpts{}).FromResource(d)
+	res := &IpLoadbalancingLogSubscriptionOperation{}
+
+	log.Printf("[DEBUG] Will create Log subscription : %+v for IP load balancing %s", params, serviceName)
+	if err := config.OVHClient.PostWithContext(ctx, endpoint, params, res); err != nil {
+		return diag.Errorf("calling Post %s with params %+v:\n\t %q", endpoint, params, err)
+	}
+
+	log.Printf("[DEBUG] Waiting for Log subscription operation %s to be READY", res.OperationId)
+	waitCtx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutCreate))
+	defer cancel()
+	op, err := waitForDbaasLogsOperation(waitCtx, config.OVHClient, res.ServiceName, res.OperationId)
+	if err != nil {
+		return diag.Errorf("timeout while waiting log subscription operation %s to be READY: %q", res.OperationId, err)
+	}
+
+	d.SetId(*op.SubscriptionID)
+	d.Set("operation_id", res.OperationId)
+
+	return resourceIpLoadbalancingLogSubscriptionRead(ctx, d, meta)
+}
+
+func resourceIpLoadbalancingLogSubscriptionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	id := d.Id()
+
+	endpoint := fmt.Sprintf("/ipLoadbalancing/%s/log/subscription/%s", url.PathEscape(serviceName), url.PathEscape(id))
+	res := &IpLoadbalancingLogSubscription{}
+
+	log.Printf("[DEBUG] Will read log subscription %s from IP load balancing %s", id, serviceName)
+	if err := config.OVHClient.GetWithContext(ctx, endpoint, res); err != nil {
+		return diag.FromErr(helpers.CheckDeleted(d, err, endpoint))
+	}
+
+	for k, v := range res.ToMap() {
+		d.Set(k, v)
+	}
+
+	log.Printf("[DEBUG] Read log subscription %+v", res)
+	return nil
+}
+
+func resourceIpLoadbalancingLogSubscriptionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	id := d.Id()
+
+	endpoint := fmt.Sprintf("/ipLoadbalancing/%s/log/subscription/%s", url.PathEscape(serviceName), url.PathEscape(id))
+	res := &IpLoadbalancingLogSubscriptionOperation{}
+
+	log.Printf("[DEBUG] Will delete Log subscription %s from IP load balancing %s", id, serviceName)
+	if err := config.OVHClient.DeleteWithContext(ctx, endpoint, res); err != nil {
+		return diag.FromErr(helpers.CheckDeleted(d, err, endpoint))
+	}
+
+	waitCtx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutDelete))
+	defer cancel()
+	if _, err := waitForDbaasLogsOperation(waitCtx, config.OVHClient, res.ServiceName, res.OperationId); err != nil {
+		return diag.Errorf("timeout while waiting log subscription operation %s to be READY: %s", res.OperationId, err)
+	}
+	log.Printf("[DEBUG] Log subscription %s is DELETED", id)
+
+	d.SetId("")
+
+	return nil
+}
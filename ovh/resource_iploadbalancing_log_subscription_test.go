@@ -0,0 +1,57 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccIpLoadbalancingLogSubscription_basic(t *testing.T) {
+	dbaasLogsServiceName := os.Getenv("OVH_DBAAS_LOGS_SERVICE_TEST")
+	iplbServiceName := os.Getenv("OVH_IPLB_SERVICE_TEST")
+
+	config := fmt.Sprintf(`
+		resource "ovh_dbaas_logs_output_graylog_stream" "stream" {
+			service_name = "%s"
+			title        = "%s"
+			description  = "%s"
+		}
+
+		resource "ovh_iploadbalancing_log_subscription" "subscription" {
+			service_name = "%s"
+			kind         = "haproxy"
+			stream_id    = ovh_dbaas_logs_output_graylog_stream.stream.stream_id
+		}
+	`, dbaasLogsServiceName, acctest.RandomWithPrefix(test_prefix), acctest.RandomWithPrefix(test_prefix), iplbServiceName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckIpLoadbalancing(t)
+			testAccPreCheckDbaasLogs(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ovh_iploadbalancing_log_subscription.subscription", "kind", "haproxy"),
+					resource.TestCheckResourceAttr("ovh_iploadbalancing_log_subscription.subscription", "ldp_service_name", dbaasLogsServiceName),
+					resource.TestCheckResourceAttrSet("ovh_iploadbalancing_log_subscription.subscription", "created_at"),
+					resource.TestCheckResourceAttrSet("ovh_iploadbalancing_log_subscription.subscription", "subscription_id"),
+					resource.TestCheckResourceAttrSet("ovh_iploadbalancing_log_subscription.subscription", "resource_name"),
+					resource.TestCheckResourceAttrSet("ovh_iploadbalancing_log_subscription.subscription", "operation_id"),
+				),
+			},
+			{
+				ResourceName:            "ovh_iploadbalancing_log_subscription.subscription",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateIdPrefix:     iplbServiceName + "/",
+				ImportStateVerifyIgnore: []string{"operation_id"},
+			},
+		},
+	})
+}
@@ -1,18 +1,18 @@
 package ovh
 
 import (
+	"context"
 	"fmt"
-	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
 func resourceIPLoadbalancingRefresh() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceIPLoadbalancingRefreshCreate,
-		Read:   resourceIPLoadbalancingRefreshRead,
-		Delete: resourceIPLoadbalancingRefreshDelete,
+		CreateContext: resourceIPLoadbalancingRefreshCreate,
+		Read:          resourceIPLoadbalancingRefreshRead,
+		Delete:        resourceIPLoadbalancingRefreshDelete,
 
 		Schema: map[string]*schema.Schema{
 			"service_name": {
@@ -32,37 +32,14 @@ func resourceIPLoadbalancingRefresh() *schema.Resource {
 	}
 }
 
-func resourceIPLoadbalancingRefreshCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIPLoadbalancingRefreshCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 	service := d.Get("service_name").(string)
 
 	// verify if there are no active tasks for the loadbalancer
 	// at the moment and wait till finished if there are any
-
-	stateConf := &resource.StateChangeConf{
-		Target: []string{"empty"},
-		Refresh: func() (interface{}, string, error) {
-			for _, state := range []string{"todo", "doing"} {
-				taskResp := &[]int{}
-				endpoint := fmt.Sprintf("/ipLoadbalancing/%s/task?action=refreshIplb&status=%s", service, state)
-				err := config.OVHClient.Get(endpoint, taskResp)
-				if err != nil {
-					return d, "error", fmt.Errorf("calling GET %s:\n\t %s", endpoint, err.Error())
-				}
-				if len(*taskResp) > 0 {
-					return d, "exists", nil
-				}
-			}
-			return d, "empty", nil
-		},
-		Timeout:    10 * time.Minute,
-		Delay:      10 * time.Second,
-		MinTimeout: 3 * time.Second,
-	}
-
-	_, err := stateConf.WaitForState()
-	if err != nil {
-		return fmt.Errorf("Error waiting for IPLoadbalancer tasks to finish: %s", err)
+	if err := iploadbalancingWaitRefreshTasks(ctx, config, service); err != nil {
+		return diag.FromErr(err)
 	}
 
 	// verify if there are any outstanding changes to refresh
@@ -70,9 +47,9 @@ func resourceIPLoadbalancingRefreshCreate(d *schema.ResourceData, meta interface
 	checkResp := &IPLoadbalancingRefreshPendings{}
 	endpoint := fmt.Sprintf("/ipLoadbalancing/%s/pendingChanges", service)
 
-	err = config.OVHClient.Get(endpoint, checkResp)
+	err := config.OVHClient.GetWithContext(ctx, endpoint, checkResp)
 	if err != nil {
-		return fmt.Errorf("calling GET %s:\n\t %s", endpoint, err.Error())
+		return diag.Errorf("calling GET %s:\n\t %s", endpoint, err.Error())
 	}
 
 	// no changes detected, return successfull creation/refresh
@@ -86,30 +63,13 @@ func resourceIPLoadbalancingRefreshCreate(d *schema.ResourceData, meta interface
 	resp := &IPLoadbalancingRefreshTask{}
 	endpoint = fmt.Sprintf("/ipLoadbalancing/%s/refresh", service)
 
-	err = config.OVHClient.Post(endpoint, nil, resp)
+	err = config.OVHClient.PostWithContext(ctx, endpoint, nil, resp)
 	if err != nil {
-		return fmt.Errorf("calling POST %s :\n\t %s", endpoint, err.Error())
+		return diag.Errorf("calling POST %s :\n\t %s", endpoint, err.Error())
 	}
 
-	stateConf = &resource.StateChangeConf{
-		Target: []string{"done"},
-		Refresh: func() (interface{}, string, error) {
-			endpoint := fmt.Sprintf("/ipLoadbalancing/%s/task/%d", service, resp.ID)
-			stateResp := &IPLoadbalancingRefreshTask{}
-			err := config.OVHClient.Get(endpoint, stateResp)
-			if err != nil {
-				return nil, "", err
-			}
-			return d, stateResp.Status, nil
-		},
-		Timeout:    10 * time.Minute,
-		Delay:      10 * time.Second,
-		MinTimeout: 3 * time.Second,
-	}
-
-	_, err = stateConf.WaitForState()
-	if err != nil {
-		return fmt.Errorf("Error waiting for IPLoadbalancer refresh: %s", err)
+	if err := iploadbalancingWaitTask(ctx, config, service, resp.ID); err != nil {
+		return diag.Errorf("Error waiting for IPLoadbalancer refresh: %s", err)
 	}
 
 	d.SetId(service)
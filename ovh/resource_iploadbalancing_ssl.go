@@ -58,8 +58,11 @@ func (r *iploadbalancingSslResource) Create(ctx context.Context, req resource.Cr
 		return
 	}
 
+	changes := iploadbalancingQueueChanges(r.config, data.ServiceName.ValueString())
+	defer changes.Release(ctx)
+
 	endpoint := "/ipLoadbalancing/" + url.PathEscape(data.ServiceName.ValueString()) + "/ssl"
-	if err := r.config.OVHClient.Post(endpoint, data.ToCreate(), &responseData); err != nil {
+	if err := r.config.OVHClient.PostWithContext(ctx, endpoint, data.ToCreate(), &responseData); err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("Error calling Post %s", endpoint),
 			err.Error(),
@@ -71,6 +74,16 @@ func (r *iploadbalancingSslResource) Create(ctx context.Context, req resource.Cr
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &responseData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := changes.Apply(ctx); err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Error applying changes on IP load balancer %s", data.ServiceName.ValueString()),
+			err.Error(),
+		)
+	}
 }
 
 func (r *iploadbalancingSslResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -113,9 +126,12 @@ func (r *iploadbalancingSslResource) Update(ctx context.Context, req resource.Up
 		return
 	}
 
+	changes := iploadbalancingQueueChanges(r.config, data.ServiceName.ValueString())
+	defer changes.Release(ctx)
+
 	// Update resource
 	endpoint := "/ipLoadbalancing/" + url.PathEscape(data.ServiceName.ValueString()) + "/ssl/" + strconv.FormatInt(data.Id.ValueInt64(), 10)
-	if err := r.config.OVHClient.Put(endpoint, planData.ToUpdate(), nil); err != nil {
+	if err := r.config.OVHClient.PutWithContext(ctx, endpoint, planData.ToUpdate(), nil); err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("Error calling Put %s", endpoint),
 			err.Error(),
@@ -137,6 +153,16 @@ func (r *iploadbalancingSslResource) Update(ctx context.Context, req resource.Up
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &responseData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := changes.Apply(ctx); err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Error applying changes on IP load balancer %s", data.ServiceName.ValueString()),
+			err.Error(),
+		)
+	}
 }
 
 func (r *iploadbalancingSslResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -149,13 +175,24 @@ func (r *iploadbalancingSslResource) Delete(ctx context.Context, req resource.De
 		return
 	}
 
+	changes := iploadbalancingQueueChanges(r.config, data.ServiceName.ValueString())
+	defer changes.Release(ctx)
+
 	// Delete API call logic
 	endpoint := "/ipLoadbalancing/" + url.PathEscape(data.ServiceName.ValueString()) + "/ssl/" + strconv.FormatInt(data.Id.ValueInt64(), 10)
-	if err := r.config.OVHClient.Delete(endpoint, nil); err != nil {
+	if err := r.config.OVHClient.DeleteWithContext(ctx, endpoint, nil); err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("Error calling Delete %s", endpoint),
 			err.Error(),
 		)
+		return
+	}
+
+	if err := changes.Apply(ctx); err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Error applying changes on IP load balancer %s", data.ServiceName.ValueString()),
+			err.Error(),
+		)
 	}
 }
 
@@ -1,22 +1,25 @@
 package ovh
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/ovh/terraform-provider-ovh/v2/ovh/helpers"
 )
 
 func resourceIpLoadbalancingTcpFarm() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceIpLoadbalancingTcpFarmCreate,
-		Read:   resourceIpLoadbalancingTcpFarmRead,
-		Update: resourceIpLoadbalancingTcpFarmUpdate,
-		Delete: resourceIpLoadbalancingTcpFarmDelete,
+		CreateContext: resourceIpLoadbalancingTcpFarmCreate,
+		Read:          resourceIpLoadbalancingTcpFarmRead,
+		UpdateContext: resourceIpLoadbalancingTcpFarmUpdate,
+		DeleteContext: resourceIpLoadbalancingTcpFarmDelete,
 		Importer: &schema.ResourceImporter{
 			State: resourceIpLoadbalancingTcpFarmImportState,
 		},
+		CustomizeDiff: resourceIpLoadbalancingFarmCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"service_name": {
@@ -167,7 +170,7 @@ func resourceIpLoadbalancingTcpFarmImportState(d *schema.ResourceData, meta inte
 	return results, nil
 }
 
-func resourceIpLoadbalancingTcpFarmCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIpLoadbalancingTcpFarmCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
 	farm := (&IpLoadbalancingFarmCreateOrUpdateOpts{}).FromResource(d)
@@ -175,14 +178,21 @@ func resourceIpLoadbalancingTcpFarmCreate(d *schema.ResourceData, meta interface
 	resp := &IpLoadbalancingFarm{}
 	endpoint := fmt.Sprintf("/ipLoadbalancing/%s/tcp/farm", service)
 
-	err := config.OVHClient.Post(endpoint, farm, resp)
+	changes := iploadbalancingQueueChanges(config, service)
+	defer changes.Release(ctx)
+
+	err := config.OVHClient.PostWithContext(ctx, endpoint, farm, resp)
 	if err != nil {
-		return fmt.Errorf("calling POST %s :\n\t %s", endpoint, err.Error())
+		return diag.Errorf("calling POST %s :\n\t %s", endpoint, err.Error())
 	}
 
 	d.SetId(fmt.Sprintf("%d", resp.FarmId))
 
-	return resourceIpLoadbalancingTcpFarmRead(d, meta)
+	if err := changes.Apply(ctx); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diag.FromErr(resourceIpLoadbalancingTcpFarmRead(d, meta))
 }
 
 func resourceIpLoadbalancingTcpFarmRead(d *schema.ResourceData, meta interface{}) error {
@@ -210,31 +220,45 @@ func resourceIpLoadbalancingTcpFarmRead(d *schema.ResourceData, meta interface{}
 	return nil
 }
 
-func resourceIpLoadbalancingTcpFarmUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIpLoadbalancingTcpFarmUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 	service := d.Get("service_name").(string)
 	endpoint := fmt.Sprintf("/ipLoadbalancing/%s/tcp/farm/%s", service, d.Id())
 
 	farm := (&IpLoadbalancingFarmCreateOrUpdateOpts{}).FromResource(d)
 
-	err := config.OVHClient.Put(endpoint, farm, nil)
+	changes := iploadbalancingQueueChanges(config, service)
+	defer changes.Release(ctx)
+
+	err := config.OVHClient.PutWithContext(ctx, endpoint, farm, nil)
 	if err != nil {
-		return fmt.Errorf("calling %s:\n\t %s", endpoint, err.Error())
+		return diag.Errorf("calling %s:\n\t %s", endpoint, err.Error())
 	}
 
-	return resourceIpLoadbalancingTcpFarmRead(d, meta)
+	if err := changes.Apply(ctx); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diag.FromErr(resourceIpLoadbalancingTcpFarmRead(d, meta))
 }
 
-func resourceIpLoadbalancingTcpFarmDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIpLoadbalancingTcpFarmDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
 	service := d.Get("service_name").(string)
 	r := &IpLoadbalancingFarm{}
 	endpoint := fmt.Sprintf("/ipLoadbalancing/%s/tcp/farm/%s", service, d.Id())
 
-	err := config.OVHClient.Delete(endpoint, &r)
+	changes := iploadbalancingQueueChanges(config, service)
+	defer changes.Release(ctx)
+
+	err := config.OVHClient.DeleteWithContext(ctx, endpoint, &r)
 	if err != nil {
-		return fmt.Errorf("Error calling %s: %s \n", endpoint, err.Error())
+		return diag.Errorf("Error calling %s: %s \n", endpoint, err.Error())
+	}
+
+	if err := changes.Apply(ctx); err != nil {
+		return diag.FromErr(err)
 	}
 
 	d.SetId("")
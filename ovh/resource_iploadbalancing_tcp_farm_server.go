@@ -1,21 +1,23 @@
 package ovh
 
 import (
+	"context"
 	"fmt"
 	"net"
 	"strconv"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/ovh/terraform-provider-ovh/v2/ovh/helpers"
 )
 
 func resourceIpLoadbalancingTcpFarmServer() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceIpLoadbalancingTcpFarmServerCreate,
-		Read:   resourceIpLoadbalancingTcpFarmServerRead,
-		Update: resourceIpLoadbalancingTcpFarmServerUpdate,
-		Delete: resourceIpLoadbalancingTcpFarmServerDelete,
+		CreateContext: resourceIpLoadbalancingTcpFarmServerCreate,
+		Read:          resourceIpLoadbalancingTcpFarmServerRead,
+		UpdateContext: resourceIpLoadbalancingTcpFarmServerUpdate,
+		DeleteContext: resourceIpLoadbalancingTcpFarmServerDelete,
 		Importer: &schema.ResourceImporter{
 			State: resourceIpLoadbalancingTcpFarmServerImportState,
 		},
@@ -138,7 +140,7 @@ func resourceIpLoadbalancingTcpFarmServerImportState(d *schema.ResourceData, met
 	return results, nil
 }
 
-func resourceIpLoadbalancingTcpFarmServerCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIpLoadbalancingTcpFarmServerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
 	newBackendServer := &IpLoadbalancingFarmServerCreateOpts{
@@ -160,15 +162,22 @@ func resourceIpLoadbalancingTcpFarmServerCreate(d *schema.ResourceData, meta int
 	r := &IpLoadbalancingFarmServer{}
 	endpoint := fmt.Sprintf("/ipLoadbalancing/%s/tcp/farm/%d/server", service, farmid)
 
-	err := config.OVHClient.Post(endpoint, newBackendServer, r)
+	changes := iploadbalancingQueueChanges(config, service)
+	defer changes.Release(ctx)
+
+	err := config.OVHClient.PostWithContext(ctx, endpoint, newBackendServer, r)
 	if err != nil {
-		return fmt.Errorf("calling POST %s with %d:\n\t %s", endpoint, farmid, err.Error())
+		return diag.Errorf("calling POST %s with %d:\n\t %s", endpoint, farmid, err.Error())
 	}
 
 	//set id
 	d.SetId(fmt.Sprintf("%d", r.ServerId))
 
-	return resourceIpLoadbalancingTcpFarmServerRead(d, meta)
+	if err := changes.Apply(ctx); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diag.FromErr(resourceIpLoadbalancingTcpFarmServerRead(d, meta))
 }
 
 func resourceIpLoadbalancingTcpFarmServerRead(d *schema.ResourceData, meta interface{}) error {
@@ -192,7 +201,7 @@ func resourceIpLoadbalancingTcpFarmServerRead(d *schema.ResourceData, meta inter
 	return nil
 }
 
-func resourceIpLoadbalancingTcpFarmServerUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIpLoadbalancingTcpFarmServerUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
 	update := &IpLoadbalancingFarmServerUpdateOpts{
@@ -213,14 +222,21 @@ func resourceIpLoadbalancingTcpFarmServerUpdate(d *schema.ResourceData, meta int
 	farmid := d.Get("farm_id").(int)
 	r := &IpLoadbalancingFarmServer{}
 	endpoint := fmt.Sprintf("/ipLoadbalancing/%s/tcp/farm/%d/server/%s", service, farmid, d.Id())
-	err := config.OVHClient.Put(endpoint, update, r)
+	changes := iploadbalancingQueueChanges(config, service)
+	defer changes.Release(ctx)
+
+	err := config.OVHClient.PutWithContext(ctx, endpoint, update, r)
 	if err != nil {
-		return fmt.Errorf("calling PUT %s with %d:\n\t %s", endpoint, farmid, err.Error())
+		return diag.Errorf("calling PUT %s with %d:\n\t %s", endpoint, farmid, err.Error())
+	}
+	if err := changes.Apply(ctx); err != nil {
+		return diag.FromErr(err)
 	}
-	return resourceIpLoadbalancingTcpFarmServerRead(d, meta)
+
+	return diag.FromErr(resourceIpLoadbalancingTcpFarmServerRead(d, meta))
 }
 
-func resourceIpLoadbalancingTcpFarmServerDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIpLoadbalancingTcpFarmServerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
 	service := d.Get("service_name").(string)
@@ -229,9 +245,16 @@ func resourceIpLoadbalancingTcpFarmServerDelete(d *schema.ResourceData, meta int
 	r := &IpLoadbalancingFarmServer{}
 	endpoint := fmt.Sprintf("/ipLoadbalancing/%s/tcp/farm/%d/server/%s", service, farmid, d.Id())
 
-	err := config.OVHClient.Delete(endpoint, r)
+	changes := iploadbalancingQueueChanges(config, service)
+	defer changes.Release(ctx)
+
+	err := config.OVHClient.DeleteWithContext(ctx, endpoint, r)
 	if err != nil {
-		return fmt.Errorf("calling DELETE %s :\n\t %s", endpoint, err.Error())
+		return diag.Errorf("calling DELETE %s :\n\t %s", endpoint, err.Error())
+	}
+
+	if err := changes.Apply(ctx); err != nil {
+		return diag.FromErr(err)
 	}
 
 	d.SetId("")
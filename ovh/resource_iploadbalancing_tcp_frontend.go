@@ -1,19 +1,21 @@
 package ovh
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/ovh/terraform-provider-ovh/v2/ovh/helpers"
 )
 
 func resourceIpLoadbalancingTcpFrontend() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceIpLoadbalancingTcpFrontendCreate,
-		Read:   resourceIpLoadbalancingTcpFrontendRead,
-		Update: resourceIpLoadbalancingTcpFrontendUpdate,
-		Delete: resourceIpLoadbalancingTcpFrontendDelete,
+		CreateContext: resourceIpLoadbalancingTcpFrontendCreate,
+		Read:          resourceIpLoadbalancingTcpFrontendRead,
+		UpdateContext: resourceIpLoadbalancingTcpFrontendUpdate,
+		DeleteContext: resourceIpLoadbalancingTcpFrontendDelete,
 		Importer: &schema.ResourceImporter{
 			State: resourceIpLoadbalancingTcpFrontendImportState,
 		},
@@ -96,7 +98,7 @@ func resourceIpLoadbalancingTcpFrontendImportState(d *schema.ResourceData, meta
 	return results, nil
 }
 
-func resourceIpLoadbalancingTcpFrontendCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIpLoadbalancingTcpFrontendCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
 	allowedSources, _ := helpers.StringsFromSchema(d, "allowed_source")
@@ -105,19 +107,19 @@ func resourceIpLoadbalancingTcpFrontendCreate(d *schema.ResourceData, meta inter
 
 	for _, s := range allowedSources {
 		if err := helpers.ValidateIpBlock(s); err != nil {
-			return fmt.Errorf("Error validating `allowed_source` value: %s", err)
+			return diag.Errorf("Error validating `allowed_source` value: %s", err)
 		}
 	}
 
 	for _, s := range deniedSources {
 		if err := helpers.ValidateIpBlock(s); err != nil {
-			return fmt.Errorf("Error validating `denied_source` value: %s", err)
+			return diag.Errorf("Error validating `denied_source` value: %s", err)
 		}
 	}
 
 	for _, s := range dedicatedIpFo {
 		if err := helpers.ValidateIpBlock(s); err != nil {
-			return fmt.Errorf("Error validating `dedicated_ipfo` value: %s", err)
+			return diag.Errorf("Error validating `dedicated_ipfo` value: %s", err)
 		}
 	}
 
@@ -139,14 +141,21 @@ func resourceIpLoadbalancingTcpFrontendCreate(d *schema.ResourceData, meta inter
 	resp := &IpLoadbalancingTcpFrontend{}
 	endpoint := fmt.Sprintf("/ipLoadbalancing/%s/tcp/frontend", service)
 
-	err := config.OVHClient.Post(endpoint, frontend, resp)
+	changes := iploadbalancingQueueChanges(config, service)
+	defer changes.Release(ctx)
+
+	err := config.OVHClient.PostWithContext(ctx, endpoint, frontend, resp)
 	if err != nil {
-		return fmt.Errorf("calling POST %s:\n\t %s", endpoint, err.Error())
+		return diag.Errorf("calling POST %s:\n\t %s", endpoint, err.Error())
 	}
 
 	d.SetId(fmt.Sprintf("%d", resp.FrontendId))
 
-	return resourceIpLoadbalancingTcpFrontendRead(d, meta)
+	if err := changes.Apply(ctx); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diag.FromErr(resourceIpLoadbalancingTcpFrontendRead(d, meta))
 }
 
 func resourceIpLoadbalancingTcpFrontendRead(d *schema.ResourceData, meta interface{}) error {
@@ -184,7 +193,7 @@ func resourceIpLoadbalancingTcpFrontendRead(d *schema.ResourceData, meta interfa
 	return nil
 }
 
-func resourceIpLoadbalancingTcpFrontendUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIpLoadbalancingTcpFrontendUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 	service := d.Get("service_name").(string)
 	endpoint := fmt.Sprintf("/ipLoadbalancing/%s/tcp/frontend/%s", service, d.Id())
@@ -195,19 +204,19 @@ func resourceIpLoadbalancingTcpFrontendUpdate(d *schema.ResourceData, meta inter
 
 	for _, s := range allowedSources {
 		if err := helpers.ValidateIpBlock(s); err != nil {
-			return fmt.Errorf("Error validating `allowed_source` value: %s", err)
+			return diag.Errorf("Error validating `allowed_source` value: %s", err)
 		}
 	}
 
 	for _, s := range deniedSources {
 		if err := helpers.ValidateIpBlock(s); err != nil {
-			return fmt.Errorf("Error validating `denied_source` value: %s", err)
+			return diag.Errorf("Error validating `denied_source` value: %s", err)
 		}
 	}
 
 	for _, s := range dedicatedIpFo {
 		if err := helpers.ValidateIpBlock(s); err != nil {
-			return fmt.Errorf("Error validating `dedicated_ipfo` value: %s", err)
+			return diag.Errorf("Error validating `dedicated_ipfo` value: %s", err)
 		}
 	}
 
@@ -225,23 +234,37 @@ func resourceIpLoadbalancingTcpFrontendUpdate(d *schema.ResourceData, meta inter
 	frontend.DefaultFarmId = helpers.GetNilIntPointerFromData(d, "default_farm_id")
 	frontend.DefaultSslId = helpers.GetNilIntPointerFromData(d, "default_ssl_id")
 
-	err := config.OVHClient.Put(endpoint, frontend, nil)
+	changes := iploadbalancingQueueChanges(config, service)
+	defer changes.Release(ctx)
+
+	err := config.OVHClient.PutWithContext(ctx, endpoint, frontend, nil)
 	if err != nil {
-		return fmt.Errorf("calling PUT %s:\n\t %s", endpoint, err.Error())
+		return diag.Errorf("calling PUT %s:\n\t %s", endpoint, err.Error())
 	}
 
-	return resourceIpLoadbalancingTcpFrontendRead(d, meta)
+	if err := changes.Apply(ctx); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diag.FromErr(resourceIpLoadbalancingTcpFrontendRead(d, meta))
 }
 
-func resourceIpLoadbalancingTcpFrontendDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIpLoadbalancingTcpFrontendDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
 	service := d.Get("service_name").(string)
 	endpoint := fmt.Sprintf("/ipLoadbalancing/%s/tcp/frontend/%s", service, d.Id())
 
-	err := config.OVHClient.Delete(endpoint, nil)
+	changes := iploadbalancingQueueChanges(config, service)
+	defer changes.Release(ctx)
+
+	err := config.OVHClient.DeleteWithContext(ctx, endpoint, nil)
 	if err != nil {
-		return fmt.Errorf("calling DELETE %s: %s \n", endpoint, err.Error())
+		return diag.Errorf("calling DELETE %s: %s \n", endpoint, err.Error())
+	}
+
+	if err := changes.Apply(ctx); err != nil {
+		return diag.FromErr(err)
 	}
 
 	d.SetId("")
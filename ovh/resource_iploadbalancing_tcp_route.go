@@ -1,20 +1,22 @@
 package ovh
 
 import (
+	"context"
 	"fmt"
 	"net/url"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/ovh/terraform-provider-ovh/v2/ovh/helpers"
 )
 
 func resourceIPLoadbalancingTcpRoute() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceIPLoadbalancingTcpRouteCreate,
-		Read:   resourceIPLoadbalancingTcpRouteRead,
-		Update: resourceIPLoadbalancingTcpRouteUpdate,
-		Delete: resourceIPLoadbalancingTcpRouteDelete,
+		CreateContext: resourceIPLoadbalancingTcpRouteCreate,
+		Read:          resourceIPLoadbalancingTcpRouteRead,
+		UpdateContext: resourceIPLoadbalancingTcpRouteUpdate,
+		DeleteContext: resourceIPLoadbalancingTcpRouteDelete,
 		Importer: &schema.ResourceImporter{
 			State: resourceIpLoadbalancingTcpRouteImportState,
 		},
@@ -130,7 +132,7 @@ func resourceIpLoadbalancingTcpRouteImportState(d *schema.ResourceData, meta int
 	return results, nil
 }
 
-func resourceIPLoadbalancingTcpRouteCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIPLoadbalancingTcpRouteCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
 	serviceName := d.Get("service_name").(string)
@@ -140,13 +142,20 @@ func resourceIPLoadbalancingTcpRouteCreate(d *schema.ResourceData, meta interfac
 		url.PathEscape(serviceName),
 	)
 
-	if err := config.OVHClient.Post(endpoint, route, resp); err != nil {
-		return fmt.Errorf("calling POST %s :\n\t %s", endpoint, err.Error())
+	changes := iploadbalancingQueueChanges(config, serviceName)
+	defer changes.Release(ctx)
+
+	if err := config.OVHClient.PostWithContext(ctx, endpoint, route, resp); err != nil {
+		return diag.Errorf("calling POST %s :\n\t %s", endpoint, err.Error())
 	}
 
 	d.SetId(fmt.Sprintf("%d", resp.RouteId))
 
-	return resourceIPLoadbalancingTcpRouteRead(d, meta)
+	if err := changes.Apply(ctx); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diag.FromErr(resourceIPLoadbalancingTcpRouteRead(d, meta))
 }
 
 func resourceIPLoadbalancingTcpRouteRead(d *schema.ResourceData, meta interface{}) error {
@@ -171,7 +180,7 @@ func resourceIPLoadbalancingTcpRouteRead(d *schema.ResourceData, meta interface{
 	return nil
 }
 
-func resourceIPLoadbalancingTcpRouteUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIPLoadbalancingTcpRouteUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 	serviceName := d.Get("service_name").(string)
 	route := (&IPLoadbalancingTcpRouteOpts{}).FromResource(d)
@@ -181,14 +190,21 @@ func resourceIPLoadbalancingTcpRouteUpdate(d *schema.ResourceData, meta interfac
 		url.PathEscape(d.Id()),
 	)
 
-	if err := config.OVHClient.Put(endpoint, route, nil); err != nil {
-		return fmt.Errorf("calling PUT %s:\n\t %s", endpoint, err.Error())
+	changes := iploadbalancingQueueChanges(config, serviceName)
+	defer changes.Release(ctx)
+
+	if err := config.OVHClient.PutWithContext(ctx, endpoint, route, nil); err != nil {
+		return diag.Errorf("calling PUT %s:\n\t %s", endpoint, err.Error())
+	}
+
+	if err := changes.Apply(ctx); err != nil {
+		return diag.FromErr(err)
 	}
 
-	return resourceIPLoadbalancingTcpRouteRead(d, meta)
+	return diag.FromErr(resourceIPLoadbalancingTcpRouteRead(d, meta))
 }
 
-func resourceIPLoadbalancingTcpRouteDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIPLoadbalancingTcpRouteDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 	serviceName := d.Get("service_name").(string)
 	endpoint := fmt.Sprintf("/ipLoadbalancing/%s/tcp/route/%s",
@@ -196,9 +212,16 @@ func resourceIPLoadbalancingTcpRouteDelete(d *schema.ResourceData, meta interfac
 		url.PathEscape(d.Id()),
 	)
 
-	if err := config.OVHClient.Delete(endpoint, nil); err != nil {
-		return helpers.CheckDeleted(d, err, endpoint)
+	changes := iploadbalancingQueueChanges(config, serviceName)
+	defer changes.Release(ctx)
+
+	if err := config.OVHClient.DeleteWithContext(ctx, endpoint, nil); err != nil {
+		return diag.FromErr(helpers.CheckDeleted(d, err, endpoint))
 	}
+	if err := changes.Apply(ctx); err != nil {
+		return diag.FromErr(err)
+	}
+
 	d.SetId("")
 	return nil
 }
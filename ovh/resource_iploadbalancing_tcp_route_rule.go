@@ -1,20 +1,22 @@
 package ovh
 
 import (
+	"context"
 	"fmt"
 	"net/url"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/ovh/terraform-provider-ovh/v2/ovh/helpers"
 )
 
 func resourceIPLoadbalancingTcpRouteRule() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceIPLoadbalancingTcpRouteRuleCreate,
-		Read:   resourceIPLoadbalancingTcpRouteRuleRead,
-		Update: resourceIPLoadbalancingTcpRouteRuleUpdate,
-		Delete: resourceIPLoadbalancingTcpRouteRuleDelete,
+		CreateContext: resourceIPLoadbalancingTcpRouteRuleCreate,
+		Read:          resourceIPLoadbalancingTcpRouteRuleRead,
+		UpdateContext: resourceIPLoadbalancingTcpRouteRuleUpdate,
+		DeleteContext: resourceIPLoadbalancingTcpRouteRuleDelete,
 		Importer: &schema.ResourceImporter{
 			State: resourceIpLoadbalancingTcpRouteRuleImportState,
 		},
@@ -85,7 +87,7 @@ func resourceIpLoadbalancingTcpRouteRuleImportState(d *schema.ResourceData, meta
 	return results, nil
 }
 
-func resourceIPLoadbalancingTcpRouteRuleCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIPLoadbalancingTcpRouteRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
 	rule := (&IPLoadbalancingRouteRuleOpts{}).FromResource(d)
@@ -98,13 +100,20 @@ func resourceIPLoadbalancingTcpRouteRuleCreate(d *schema.ResourceData, meta inte
 		url.PathEscape(routeId),
 	)
 
-	if err := config.OVHClient.Post(endpoint, rule, resp); err != nil {
-		return fmt.Errorf("calling POST %s :\n\t %s", endpoint, err.Error())
+	changes := iploadbalancingQueueChanges(config, serviceName)
+	defer changes.Release(ctx)
+
+	if err := config.OVHClient.PostWithContext(ctx, endpoint, rule, resp); err != nil {
+		return diag.Errorf("calling POST %s :\n\t %s", endpoint, err.Error())
 	}
 
 	d.SetId(fmt.Sprintf("%d", resp.RuleId))
 
-	return resourceIPLoadbalancingTcpRouteRuleRead(d, meta)
+	if err := changes.Apply(ctx); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diag.FromErr(resourceIPLoadbalancingTcpRouteRuleRead(d, meta))
 }
 
 func resourceIPLoadbalancingTcpRouteRuleRead(d *schema.ResourceData, meta interface{}) error {
@@ -132,7 +141,7 @@ func resourceIPLoadbalancingTcpRouteRuleRead(d *schema.ResourceData, meta interf
 	return nil
 }
 
-func resourceIPLoadbalancingTcpRouteRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIPLoadbalancingTcpRouteRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 	rule := (&IPLoadbalancingRouteRuleOpts{}).FromResource(d)
 	serviceName := d.Get("service_name").(string)
@@ -143,14 +152,21 @@ func resourceIPLoadbalancingTcpRouteRuleUpdate(d *schema.ResourceData, meta inte
 		url.PathEscape(d.Id()),
 	)
 
-	if err := config.OVHClient.Put(endpoint, rule, nil); err != nil {
-		return fmt.Errorf("calling PUT %s:\n\t %s", endpoint, err.Error())
+	changes := iploadbalancingQueueChanges(config, serviceName)
+	defer changes.Release(ctx)
+
+	if err := config.OVHClient.PutWithContext(ctx, endpoint, rule, nil); err != nil {
+		return diag.Errorf("calling PUT %s:\n\t %s", endpoint, err.Error())
+	}
+
+	if err := changes.Apply(ctx); err != nil {
+		return diag.FromErr(err)
 	}
 
-	return resourceIPLoadbalancingTcpRouteRuleRead(d, meta)
+	return diag.FromErr(resourceIPLoadbalancingTcpRouteRuleRead(d, meta))
 }
 
-func resourceIPLoadbalancingTcpRouteRuleDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIPLoadbalancingTcpRouteRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 	serviceName := d.Get("service_name").(string)
 	routeId := d.Get("route_id").(string)
@@ -160,8 +176,15 @@ func resourceIPLoadbalancingTcpRouteRuleDelete(d *schema.ResourceData, meta inte
 		url.PathEscape(d.Id()),
 	)
 
-	if err := config.OVHClient.Delete(endpoint, nil); err != nil {
-		return helpers.CheckDeleted(d, err, endpoint)
+	changes := iploadbalancingQueueChanges(config, serviceName)
+	defer changes.Release(ctx)
+
+	if err := config.OVHClient.DeleteWithContext(ctx, endpoint, nil); err != nil {
+		return diag.FromErr(helpers.CheckDeleted(d, err, endpoint))
+	}
+
+	if err := changes.Apply(ctx); err != nil {
+		return diag.FromErr(err)
 	}
 
 	d.SetId("")
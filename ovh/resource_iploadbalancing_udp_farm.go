@@ -59,8 +59,11 @@ func (r *iploadbalancingUdpFarmResource) Create(ctx context.Context, req resourc
 		return
 	}
 
+	changes := iploadbalancingQueueChanges(r.config, data.ServiceName.ValueString())
+	defer changes.Release(ctx)
+
 	endpoint := "/ipLoadbalancing/" + url.PathEscape(data.ServiceName.ValueString()) + "/udp/farm"
-	if err := r.config.OVHClient.Post(endpoint, data.ToCreate(), &responseData); err != nil {
+	if err := r.config.OVHClient.PostWithContext(ctx, endpoint, data.ToCreate(), &responseData); err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("Error calling Post %s", endpoint),
 			err.Error(),
@@ -73,6 +76,16 @@ func (r *iploadbalancingUdpFarmResource) Create(ctx context.Context, req resourc
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &responseData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := changes.Apply(ctx); err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Error applying changes on IP load balancer %s", data.ServiceName.ValueString()),
+			err.Error(),
+		)
+	}
 }
 
 func (r *iploadbalancingUdpFarmResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -115,9 +128,12 @@ func (r *iploadbalancingUdpFarmResource) Update(ctx context.Context, req resourc
 		return
 	}
 
+	changes := iploadbalancingQueueChanges(r.config, data.ServiceName.ValueString())
+	defer changes.Release(ctx)
+
 	// Update resource
 	endpoint := "/ipLoadbalancing/" + url.PathEscape(data.ServiceName.ValueString()) + "/udp/farm/" + strconv.FormatInt(data.FarmId.ValueInt64(), 10)
-	if err := r.config.OVHClient.Put(endpoint, planData.ToUpdate(), nil); err != nil {
+	if err := r.config.OVHClient.PutWithContext(ctx, endpoint, planData.ToUpdate(), nil); err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("Error calling Put %s", endpoint),
 			err.Error(),
@@ -139,6 +155,16 @@ func (r *iploadbalancingUdpFarmResource) Update(ctx context.Context, req resourc
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &responseData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := changes.Apply(ctx); err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Error applying changes on IP load balancer %s", data.ServiceName.ValueString()),
+			err.Error(),
+		)
+	}
 }
 
 func (r *iploadbalancingUdpFarmResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -151,13 +177,24 @@ func (r *iploadbalancingUdpFarmResource) Delete(ctx context.Context, req resourc
 		return
 	}
 
+	changes := iploadbalancingQueueChanges(r.config, data.ServiceName.ValueString())
+	defer changes.Release(ctx)
+
 	// Delete API call logic
 	endpoint := "/ipLoadbalancing/" + url.PathEscape(data.ServiceName.ValueString()) + "/udp/farm/" + strconv.FormatInt(data.FarmId.ValueInt64(), 10)
-	if err := r.config.OVHClient.Delete(endpoint, nil); err != nil {
+	if err := r.config.OVHClient.DeleteWithContext(ctx, endpoint, nil); err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("Error calling Delete %s", endpoint),
 			err.Error(),
 		)
+		return
+	}
+
+	if err := changes.Apply(ctx); err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Error applying changes on IP load balancer %s", data.ServiceName.ValueString()),
+			err.Error(),
+		)
 	}
 }
 
@@ -59,8 +59,11 @@ func (r *iploadbalancingUdpFrontendResource) Create(ctx context.Context, req res
 		return
 	}
 
+	changes := iploadbalancingQueueChanges(r.config, data.ServiceName.ValueString())
+	defer changes.Release(ctx)
+
 	endpoint := "/ipLoadbalancing/" + url.PathEscape(data.ServiceName.ValueString()) + "/udp/frontend"
-	if err := r.config.OVHClient.Post(endpoint, data.ToCreate(), &responseData); err != nil {
+	if err := r.config.OVHClient.PostWithContext(ctx, endpoint, data.ToCreate(), &responseData); err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("Error calling Post %s", endpoint),
 			err.Error(),
@@ -73,6 +76,16 @@ func (r *iploadbalancingUdpFrontendResource) Create(ctx context.Context, req res
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &responseData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := changes.Apply(ctx); err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Error applying changes on IP load balancer %s", data.ServiceName.ValueString()),
+			err.Error(),
+		)
+	}
 }
 
 func (r *iploadbalancingUdpFrontendResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -115,10 +128,13 @@ func (r *iploadbalancingUdpFrontendResource) Update(ctx context.Context, req res
 		return
 	}
 
+	changes := iploadbalancingQueueChanges(r.config, data.ServiceName.ValueString())
+	defer changes.Release(ctx)
+
 	// Update resource
 	endpoint := fmt.Sprintf("/ipLoadbalancing/%s/udp/frontend/%d",
 		url.PathEscape(data.ServiceName.ValueString()), data.FrontendId.ValueInt64())
-	if err := r.config.OVHClient.Put(endpoint, planData.ToUpdate(), nil); err != nil {
+	if err := r.config.OVHClient.PutWithContext(ctx, endpoint, planData.ToUpdate(), nil); err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("Error calling Put %s", endpoint),
 			err.Error(),
@@ -139,6 +155,16 @@ func (r *iploadbalancingUdpFrontendResource) Update(ctx context.Context, req res
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &responseData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := changes.Apply(ctx); err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Error applying changes on IP load balancer %s", data.ServiceName.ValueString()),
+			err.Error(),
+		)
+	}
 }
 
 func (r *iploadbalancingUdpFrontendResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -151,14 +177,25 @@ func (r *iploadbalancingUdpFrontendResource) Delete(ctx context.Context, req res
 		return
 	}
 
+	changes := iploadbalancingQueueChanges(r.config, data.ServiceName.ValueString())
+	defer changes.Release(ctx)
+
 	// Delete API call logic
 	endpoint := fmt.Sprintf("/ipLoadbalancing/%s/udp/frontend/%d",
 		url.PathEscape(data.ServiceName.ValueString()), data.FrontendId.ValueInt64())
-	if err := r.config.OVHClient.Delete(endpoint, nil); err != nil {
+	if err := r.config.OVHClient.DeleteWithContext(ctx, endpoint, nil); err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("Error calling Delete %s", endpoint),
 			err.Error(),
 		)
+		return
+	}
+
+	if err := changes.Apply(ctx); err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Error applying changes on IP load balancer %s", data.ServiceName.ValueString()),
+			err.Error(),
+		)
 	}
 }
 
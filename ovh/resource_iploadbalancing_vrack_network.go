@@ -1,21 +1,23 @@
 package ovh
 
 import (
+	"context"
 	"fmt"
 	"net/url"
 	"strconv"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/ovh/terraform-provider-ovh/v2/ovh/helpers"
 )
 
 func resourceIPLoadbalancingVrackNetwork() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceIPLoadbalancingVrackNetworkCreate,
-		Read:   resourceIPLoadbalancingVrackNetworkRead,
-		Update: resourceIPLoadbalancingVrackNetworkUpdate,
-		Delete: resourceIPLoadbalancingVrackNetworkDelete,
+		CreateContext: resourceIPLoadbalancingVrackNetworkCreate,
+		Read:          resourceIPLoadbalancingVrackNetworkRead,
+		UpdateContext: resourceIPLoadbalancingVrackNetworkUpdate,
+		DeleteContext: resourceIPLoadbalancingVrackNetworkDelete,
 		Importer: &schema.ResourceImporter{
 			State: resourceIPLoadbalancingVrackNetworkImportState,
 		},
@@ -95,7 +97,7 @@ func resourceIPLoadbalancingVrackNetworkImportState(d *schema.ResourceData, meta
 	return results, nil
 }
 
-func resourceIPLoadbalancingVrackNetworkCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIPLoadbalancingVrackNetworkCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 	serviceName := d.Get("service_name").(string)
 
@@ -106,8 +108,11 @@ func resourceIPLoadbalancingVrackNetworkCreate(d *schema.ResourceData, meta inte
 		"/ipLoadbalancing/%s/vrack/network",
 		url.PathEscape(serviceName),
 	)
-	if err := config.OVHClient.Post(endpoint, opts, vrackNetwork); err != nil {
-		return fmt.Errorf("Error calling POST %s with opts %v:\n\t %q", endpoint, opts, err)
+	changes := iploadbalancingQueueChanges(config, serviceName)
+	defer changes.Release(ctx)
+
+	if err := config.OVHClient.PostWithContext(ctx, endpoint, opts, vrackNetwork); err != nil {
+		return diag.Errorf("Error calling POST %s with opts %v:\n\t %q", endpoint, opts, err)
 	}
 	d.SetId(fmt.Sprintf("%s_%d", serviceName, vrackNetwork.VrackNetworkId))
 
@@ -116,10 +121,14 @@ func resourceIPLoadbalancingVrackNetworkCreate(d *schema.ResourceData, meta inte
 		d.Set(k, v)
 	}
 
-	return resourceIPLoadbalancingVrackNetworkRead(d, meta)
+	if err := changes.Apply(ctx); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diag.FromErr(resourceIPLoadbalancingVrackNetworkRead(d, meta))
 }
 
-func resourceIPLoadbalancingVrackNetworkUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIPLoadbalancingVrackNetworkUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 	serviceName := d.Get("service_name").(string)
 
@@ -131,14 +140,21 @@ func resourceIPLoadbalancingVrackNetworkUpdate(d *schema.ResourceData, meta inte
 		d.Get("vrack_network_id").(int),
 	)
 
-	if err := config.OVHClient.Put(endpoint, opts, nil); err != nil {
-		return fmt.Errorf("Error calling PUT %s with opts %v:\n\t %q", endpoint, opts, err)
+	changes := iploadbalancingQueueChanges(config, serviceName)
+	defer changes.Release(ctx)
+
+	if err := config.OVHClient.PutWithContext(ctx, endpoint, opts, nil); err != nil {
+		return diag.Errorf("Error calling PUT %s with opts %v:\n\t %q", endpoint, opts, err)
 	}
 
-	return resourceIPLoadbalancingVrackNetworkRead(d, meta)
+	if err := changes.Apply(ctx); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diag.FromErr(resourceIPLoadbalancingVrackNetworkRead(d, meta))
 }
 
-func resourceIPLoadbalancingVrackNetworkDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIPLoadbalancingVrackNetworkDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 	serviceName := d.Get("service_name").(string)
 
@@ -149,8 +165,15 @@ func resourceIPLoadbalancingVrackNetworkDelete(d *schema.ResourceData, meta inte
 		d.Get("vrack_network_id").(int),
 	)
 
-	if err := config.OVHClient.Delete(endpoint, nil); err != nil {
-		return fmt.Errorf("Error calling DELETE %s: %s \n", endpoint, err.Error())
+	changes := iploadbalancingQueueChanges(config, serviceName)
+	defer changes.Release(ctx)
+
+	if err := config.OVHClient.DeleteWithContext(ctx, endpoint, nil); err != nil {
+		return diag.Errorf("Error calling DELETE %s: %s \n", endpoint, err.Error())
+	}
+
+	if err := changes.Apply(ctx); err != nil {
+		return diag.FromErr(err)
 	}
 
 	d.SetId("")
@@ -62,6 +62,12 @@ func NewTfBoolValue(v bool) TfBoolValue {
 	}
 }
 
+func NewTfBoolValueNull() TfBoolValue {
+	return TfBoolValue{
+		BoolValue: basetypes.NewBoolNull(),
+	}
+}
+
 type TfBoolType struct {
 	basetypes.BoolType
 }
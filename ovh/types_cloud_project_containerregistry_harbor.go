@@ -0,0 +1,489 @@
+package ovh
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Harbor projects
+
+// HarborProjectMetadata holds the settings of a project, Harbor expects
+// booleans as strings
+type HarborProjectMetadata struct {
+	Public      string `json:"public,omitempty"`
+	AutoScan    string `json:"auto_scan,omitempty"`
+	PreventVul  string `json:"prevent_vul,omitempty"`
+	Severity    string `json:"severity,omitempty"`
+	RetentionId string `json:"retention_id,omitempty"`
+}
+
+type HarborProject struct {
+	ProjectId int64                 `json:"project_id"`
+	Name      string                `json:"name"`
+	RepoCount int64                 `json:"repo_count"`
+	Metadata  HarborProjectMetadata `json:"metadata"`
+}
+
+func (p HarborProject) ToMap() map[string]interface{} {
+	obj := make(map[string]interface{})
+	obj["name"] = p.Name
+	obj["project_id"] = p.ProjectId
+	obj["repo_count"] = p.RepoCount
+	obj["public"] = p.Metadata.Public == "true"
+	obj["auto_scan"] = p.Metadata.AutoScan == "true"
+	obj["prevent_vulnerable"] = p.Metadata.PreventVul == "true"
+	if p.Metadata.Severity != "" {
+		obj["severity"] = p.Metadata.Severity
+	}
+	return obj
+}
+
+type HarborProjectCreateOpts struct {
+	ProjectName  string                `json:"project_name"`
+	Metadata     HarborProjectMetadata `json:"metadata"`
+	StorageLimit int64                 `json:"storage_limit"`
+}
+
+func (opts *HarborProjectCreateOpts) FromResource(d *schema.ResourceData) *HarborProjectCreateOpts {
+	opts.ProjectName = d.Get("name").(string)
+	opts.Metadata = harborProjectMetadataFromResource(d)
+	opts.StorageLimit = int64(d.Get("storage_limit").(int))
+	return opts
+}
+
+type HarborProjectUpdateOpts struct {
+	Metadata HarborProjectMetadata `json:"metadata"`
+}
+
+func (opts *HarborProjectUpdateOpts) FromResource(d *schema.ResourceData) *HarborProjectUpdateOpts {
+	opts.Metadata = harborProjectMetadataFromResource(d)
+	return opts
+}
+
+func harborProjectMetadataFromResource(d *schema.ResourceData) HarborProjectMetadata {
+	return HarborProjectMetadata{
+		Public:     strconv.FormatBool(d.Get("public").(bool)),
+		AutoScan:   strconv.FormatBool(d.Get("auto_scan").(bool)),
+		PreventVul: strconv.FormatBool(d.Get("prevent_vulnerable").(bool)),
+		Severity:   d.Get("severity").(string),
+	}
+}
+
+type HarborQuota struct {
+	Id   int64            `json:"id"`
+	Hard map[string]int64 `json:"hard"`
+	Used map[string]int64 `json:"used"`
+}
+
+// Harbor robot accounts
+
+type HarborRobotAccess struct {
+	Resource string `json:"resource"`
+	Action   string `json:"action"`
+	Effect   string `json:"effect,omitempty"`
+}
+
+type HarborRobotPermission struct {
+	Kind      string              `json:"kind"`
+	Namespace string              `json:"namespace"`
+	Access    []HarborRobotAccess `json:"access"`
+}
+
+type HarborRobot struct {
+	Id          int64                   `json:"id,omitempty"`
+	Name        string                  `json:"name"`
+	Description string                  `json:"description"`
+	Level       string                  `json:"level"`
+	Duration    int64                   `json:"duration"`
+	Disable     bool                    `json:"disable"`
+	ExpiresAt   int64                   `json:"expires_at,omitempty"`
+	Secret      string                  `json:"secret,omitempty"`
+	Permissions []HarborRobotPermission `json:"permissions"`
+}
+
+// ShortName returns the name the robot account was created with, its full name
+// being prefixed with robot$ and, for project robots, with the project name
+func (r HarborRobot) ShortName() string {
+	name := r.Name
+	if i := strings.Index(name, "$"); i >= 0 {
+		name = name[i+1:]
+	}
+	if r.Level == "project" {
+		if i := strings.LastIndex(name, "+"); i >= 0 {
+			name = name[i+1:]
+		}
+	}
+	return name
+}
+
+func (r HarborRobot) ToMap() map[string]interface{} {
+	obj := make(map[string]interface{})
+	obj["full_name"] = r.Name
+	obj["description"] = r.Description
+	obj["level"] = r.Level
+	obj["duration"] = r.Duration
+	obj["disabled"] = r.Disable
+	obj["expires_at"] = r.ExpiresAt
+
+	permissions := make([]interface{}, 0, len(r.Permissions))
+	for _, permission := range r.Permissions {
+		access := make([]interface{}, 0, len(permission.Access))
+		for _, a := range permission.Access {
+			effect := a.Effect
+			if effect == "" {
+				effect = "allow"
+			}
+			access = append(access, map[string]interface{}{
+				"resource": a.Resource,
+				"action":   a.Action,
+				"effect":   effect,
+			})
+		}
+		permissions = append(permissions, map[string]interface{}{
+			"kind":      permission.Kind,
+			"namespace": permission.Namespace,
+			"access":    access,
+		})
+	}
+	obj["permission"] = permissions
+
+	return obj
+}
+
+// HarborRobotFromResource builds the robot account described by the resource,
+// name is the name expected by Harbor: the short one on creation, the full one
+// on update
+func HarborRobotFromResource(d *schema.ResourceData, name string) *HarborRobot {
+	robot := &HarborRobot{
+		Name:        name,
+		Description: d.Get("description").(string),
+		Level:       d.Get("level").(string),
+		Duration:    int64(d.Get("duration").(int)),
+		Disable:     d.Get("disabled").(bool),
+	}
+
+	for _, p := range d.Get("permission").([]interface{}) {
+		permission := p.(map[string]interface{})
+		robotPermission := HarborRobotPermission{
+			Kind:      permission["kind"].(string),
+			Namespace: permission["namespace"].(string),
+		}
+		for _, a := range permission["access"].(*schema.Set).List() {
+			access := a.(map[string]interface{})
+			robotPermission.Access = append(robotPermission.Access, HarborRobotAccess{
+				Resource: access["resource"].(string),
+				Action:   access["action"].(string),
+				Effect:   access["effect"].(string),
+			})
+		}
+		robot.Permissions = append(robot.Permissions, robotPermission)
+	}
+
+	return robot
+}
+
+// Harbor retention policies
+
+type HarborRetentionSelector struct {
+	Kind       string `json:"kind"`
+	Decoration string `json:"decoration"`
+	Pattern    string `json:"pattern"`
+	Extras     string `json:"extras,omitempty"`
+}
+
+type HarborRetentionRule struct {
+	Disabled       bool                                 `json:"disabled"`
+	Action         string                               `json:"action"`
+	Template       string                               `json:"template"`
+	Params         map[string]int64                     `json:"params"`
+	TagSelectors   []HarborRetentionSelector            `json:"tag_selectors"`
+	ScopeSelectors map[string][]HarborRetentionSelector `json:"scope_selectors"`
+}
+
+type HarborRetentionTrigger struct {
+	Kind     string            `json:"kind"`
+	Settings map[string]string `json:"settings"`
+}
+
+type HarborRetentionScope struct {
+	Level string `json:"level"`
+	Ref   int64  `json:"ref"`
+}
+
+type HarborRetentionPolicy struct {
+	Id        int64                  `json:"id,omitempty"`
+	Algorithm string                 `json:"algorithm"`
+	Rules     []HarborRetentionRule  `json:"rules"`
+	Trigger   HarborRetentionTrigger `json:"trigger"`
+	Scope     HarborRetentionScope   `json:"scope"`
+}
+
+func (p HarborRetentionPolicy) ToMap() map[string]interface{} {
+	obj := make(map[string]interface{})
+	obj["schedule"] = p.Trigger.Settings["cron"]
+
+	rules := make([]interface{}, 0, len(p.Rules))
+	for _, rule := range p.Rules {
+		r := map[string]interface{}{
+			"template": rule.Template,
+			"count":    rule.Params[rule.Template],
+			"disabled": rule.Disabled,
+		}
+		if len(rule.TagSelectors) > 0 {
+			r["tag_pattern"] = rule.TagSelectors[0].Pattern
+			r["untagged"] = rule.TagSelectors[0].Extras == `{"untagged":true}`
+		}
+		if repositories := rule.ScopeSelectors["repository"]; len(repositories) > 0 {
+			r["repository_pattern"] = repositories[0].Pattern
+		}
+		rules = append(rules, r)
+	}
+	obj["rule"] = rules
+
+	return obj
+}
+
+// HarborRetentionPolicyFromResource builds the retention policy of the project
+// described by the resource
+func HarborRetentionPolicyFromResource(d *schema.ResourceData, projectId int64) *HarborRetentionPolicy {
+	policy := &HarborRetentionPolicy{
+		Algorithm: "or",
+		Trigger: HarborRetentionTrigger{
+			Kind:     "Schedule",
+			Settings: map[string]string{"cron": d.Get("schedule").(string)},
+		},
+		Scope: HarborRetentionScope{
+			Level: "project",
+			Ref:   projectId,
+		},
+	}
+
+	for _, r := range d.Get("rule").([]interface{}) {
+		rule := r.(map[string]interface{})
+		template := rule["template"].(string)
+
+		params := map[string]int64{}
+		if template != "always" {
+			params[template] = int64(rule["count"].(int))
+		}
+
+		policy.Rules = append(policy.Rules, HarborRetentionRule{
+			Disabled: rule["disabled"].(bool),
+			Action:   "retain",
+			Template: template,
+			Params:   params,
+			TagSelectors: []HarborRetentionSelector{{
+				Kind:       "doublestar",
+				Decoration: "matches",
+				Pattern:    rule["tag_pattern"].(string),
+				Extras:     fmt.Sprintf(`{"untagged":%t}`, rule["untagged"].(bool)),
+			}},
+			ScopeSelectors: map[string][]HarborRetentionSelector{
+				"repository": {{
+					Kind:       "doublestar",
+					Decoration: "repoMatches",
+					Pattern:    rule["repository_pattern"].(string),
+				}},
+			},
+		})
+	}
+
+	return policy
+}
+
+// Harbor replication rules
+
+type HarborRegistryCredential struct {
+	Type         string `json:"type"`
+	AccessKey    string `json:"access_key"`
+	AccessSecret string `json:"access_secret,omitempty"`
+}
+
+type HarborRegistry struct {
+	Id         int64                     `json:"id,omitempty"`
+	Name       string                    `json:"name"`
+	Type       string                    `json:"type"`
+	Url        string                    `json:"url"`
+	Insecure   bool                      `json:"insecure"`
+	Credential *HarborRegistryCredential `json:"credential,omitempty"`
+}
+
+// HarborRegistryFromResource builds the remote registry of the replication rule
+func HarborRegistryFromResource(d *schema.ResourceData) *HarborRegistry {
+	remote := d.Get("remote_registry").([]interface{})[0].(map[string]interface{})
+
+	registry := &HarborRegistry{
+		Name:     remote["name"].(string),
+		Type:     remote["type"].(string),
+		Url:      remote["url"].(string),
+		Insecure: remote["insecure"].(bool),
+	}
+
+	if accessKey := remote["access_key"].(string); accessKey != "" {
+		registry.Credential = &HarborRegistryCredential{
+			Type:         "basic",
+			AccessKey:    accessKey,
+			AccessSecret: remote["access_secret"].(string),
+		}
+	}
+
+	return registry
+}
+
+type HarborRegistryRef struct {
+	Id int64 `json:"id"`
+}
+
+type HarborReplicationFilter struct {
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+type HarborReplicationTriggerSettings struct {
+	Cron string `json:"cron"`
+}
+
+type HarborReplicationTrigger struct {
+	Type            string                            `json:"type"`
+	TriggerSettings *HarborReplicationTriggerSettings `json:"trigger_settings,omitempty"`
+}
+
+type HarborReplicationPolicy struct {
+	Id            int64                     `json:"id,omitempty"`
+	Name          string                    `json:"name"`
+	Description   string                    `json:"description"`
+	SrcRegistry   *HarborRegistryRef        `json:"src_registry,omitempty"`
+	DestRegistry  *HarborRegistryRef        `json:"dest_registry,omitempty"`
+	DestNamespace string                    `json:"dest_namespace,omitempty"`
+	Filters       []HarborReplicationFilter `json:"filters"`
+	Trigger       HarborReplicationTrigger  `json:"trigger"`
+	Enabled       bool                      `json:"enabled"`
+	Override      bool                      `json:"override"`
+	Deletion      bool                      `json:"deletion"`
+}
+
+func (p HarborReplicationPolicy) ToMap() map[string]interface{} {
+	obj := make(map[string]interface{})
+	obj["name"] = p.Name
+	obj["description"] = p.Description
+	obj["dest_namespace"] = p.DestNamespace
+	obj["trigger"] = p.Trigger.Type
+	obj["enabled"] = p.Enabled
+	obj["override"] = p.Override
+	obj["deletion"] = p.Deletion
+
+	obj["cron"] = ""
+	if p.Trigger.TriggerSettings != nil {
+		obj["cron"] = p.Trigger.TriggerSettings.Cron
+	}
+
+	obj["name_filter"] = ""
+	obj["tag_filter"] = ""
+	for _, filter := range p.Filters {
+		switch filter.Type {
+		case "name":
+			obj["name_filter"] = filter.Value
+		case "tag":
+			obj["tag_filter"] = filter.Value
+		}
+	}
+
+	// The local registry has no ID, it is the remote one that tells the direction
+	if p.SrcRegistry != nil && p.SrcRegistry.Id != 0 {
+		obj["direction"] = "pull"
+	} else {
+		obj["direction"] = "push"
+	}
+
+	return obj
+}
+
+// HarborReplicationPolicyFromResource builds the replication rule described by
+// the resource, from or to the given remote registry
+func HarborReplicationPolicyFromResource(d *schema.ResourceData, remoteRegistryId int64) *HarborReplicationPolicy {
+	policy := &HarborReplicationPolicy{
+		Name:          d.Get("name").(string),
+		Description:   d.Get("description").(string),
+		DestNamespace: d.Get("dest_namespace").(string),
+		Filters:       []HarborReplicationFilter{},
+		Trigger: HarborReplicationTrigger{
+			Type: d.Get("trigger").(string),
+		},
+		Enabled:  d.Get("enabled").(bool),
+		Override: d.Get("override").(bool),
+		Deletion: d.Get("deletion").(bool),
+	}
+
+	if d.Get("direction").(string) == "pull" {
+		policy.SrcRegistry = &HarborRegistryRef{Id: remoteRegistryId}
+	} else {
+		policy.DestRegistry = &HarborRegistryRef{Id: remoteRegistryId}
+	}
+
+	if cron := d.Get("cron").(string); cron != "" {
+		policy.Trigger.TriggerSettings = &HarborReplicationTriggerSettings{Cron: cron}
+	}
+
+	if name := d.Get("name_filter").(string); name != "" {
+		policy.Filters = append(policy.Filters, HarborReplicationFilter{Type: "name", Value: name})
+	}
+	if tag := d.Get("tag_filter").(string); tag != "" {
+		policy.Filters = append(policy.Filters, HarborReplicationFilter{Type: "tag", Value: tag})
+	}
+
+	return policy
+}
+
+// Harbor garbage collection
+
+type HarborScheduleObj struct {
+	Type string `json:"type"`
+	Cron string `json:"cron,omitempty"`
+}
+
+type HarborGCParameters struct {
+	DeleteUntagged bool  `json:"delete_untagged"`
+	Workers        int64 `json:"workers,omitempty"`
+}
+
+type HarborGCSchedule struct {
+	Schedule   *HarborScheduleObj `json:"schedule"`
+	Parameters HarborGCParameters `json:"parameters"`
+}
+
+func (s HarborGCSchedule) ToMap() map[string]interface{} {
+	obj := make(map[string]interface{})
+	if s.Schedule != nil {
+		obj["schedule_type"] = s.Schedule.Type
+		obj["cron"] = s.Schedule.Cron
+	}
+	obj["delete_untagged"] = s.Parameters.DeleteUntagged
+	if s.Parameters.Workers > 0 {
+		obj["workers"] = s.Parameters.Workers
+	}
+	return obj
+}
+
+func (s *HarborGCSchedule) FromResource(d *schema.ResourceData) *HarborGCSchedule {
+	s.Schedule = &HarborScheduleObj{
+		Type: d.Get("schedule_type").(string),
+	}
+	if s.Schedule.Type == "Custom" {
+		s.Schedule.Cron = d.Get("cron").(string)
+	}
+	s.Parameters = HarborGCParameters{
+		DeleteUntagged: d.Get("delete_untagged").(bool),
+		Workers:        int64(d.Get("workers").(int)),
+	}
+	return s
+}
+
+type HarborGCHistory struct {
+	Id           int64  `json:"id"`
+	JobStatus    string `json:"job_status"`
+	CreationTime string `json:"creation_time"`
+	UpdateTime   string `json:"update_time"`
+}
@@ -0,0 +1,107 @@
+package ovh
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestUnitHarborRetentionPolicyFromResource(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceCloudProjectContainerRegistryRetention().Schema, map[string]interface{}{
+		"project_name": "apps",
+		"schedule":     "0 0 0 * * *",
+		"rule": []interface{}{
+			map[string]interface{}{
+				"template":           "latestPushedK",
+				"count":              10,
+				"repository_pattern": "apps/**",
+				"untagged":           false,
+			},
+			map[string]interface{}{
+				"template":    "always",
+				"tag_pattern": "release-*",
+			},
+		},
+	})
+
+	policy := HarborRetentionPolicyFromResource(d, 42)
+
+	if policy.Scope.Ref != 42 || policy.Scope.Level != "project" {
+		t.Fatalf("scope = %+v, want project 42", policy.Scope)
+	}
+	if policy.Trigger.Settings["cron"] != "0 0 0 * * *" {
+		t.Fatalf("cron = %q", policy.Trigger.Settings["cron"])
+	}
+	if len(policy.Rules) != 2 {
+		t.Fatalf("rules = %d, want 2", len(policy.Rules))
+	}
+	if policy.Rules[0].Params["latestPushedK"] != 10 {
+		t.Fatalf("params = %v, want latestPushedK=10", policy.Rules[0].Params)
+	}
+	if len(policy.Rules[1].Params) != 0 {
+		t.Fatalf("params of always = %v, want none", policy.Rules[1].Params)
+	}
+
+	// Converting back must give the configured rules
+	rules := policy.ToMap()["rule"].([]interface{})
+	first := rules[0].(map[string]interface{})
+	if first["repository_pattern"] != "apps/**" || first["tag_pattern"] != "**" || first["untagged"] != false || first["count"] != int64(10) {
+		t.Fatalf("first rule = %v", first)
+	}
+	second := rules[1].(map[string]interface{})
+	if second["tag_pattern"] != "release-*" || second["untagged"] != true || second["count"] != int64(0) {
+		t.Fatalf("second rule = %v", second)
+	}
+}
+
+func TestUnitHarborReplicationPolicyFromResource(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceCloudProjectContainerRegistryReplication().Schema, map[string]interface{}{
+		"name":        "mirror",
+		"direction":   "pull",
+		"name_filter": "library/**",
+		"trigger":     "scheduled",
+		"cron":        "0 0 * * * *",
+		"remote_registry": []interface{}{
+			map[string]interface{}{
+				"name": "docker-hub",
+				"type": "docker-hub",
+				"url":  "https://hub.docker.com",
+			},
+		},
+	})
+
+	policy := HarborReplicationPolicyFromResource(d, 7)
+
+	if policy.SrcRegistry == nil || policy.SrcRegistry.Id != 7 || policy.DestRegistry != nil {
+		t.Fatalf("registries = %+v / %+v, want source 7", policy.SrcRegistry, policy.DestRegistry)
+	}
+	if len(policy.Filters) != 1 || policy.Filters[0].Type != "name" {
+		t.Fatalf("filters = %+v, want a single name filter", policy.Filters)
+	}
+
+	obj := policy.ToMap()
+	if obj["direction"] != "pull" || obj["cron"] != "0 0 * * * *" || obj["name_filter"] != "library/**" || obj["tag_filter"] != "" {
+		t.Fatalf("converted policy = %v", obj)
+	}
+
+	if registry := HarborRegistryFromResource(d); registry.Credential != nil {
+		t.Fatalf("credential = %+v, want none without access key", registry.Credential)
+	}
+}
+
+func TestUnitHarborRobotShortName(t *testing.T) {
+	tests := []struct {
+		robot HarborRobot
+		want  string
+	}{
+		{robot: HarborRobot{Name: "robot$apps+ci", Level: "project"}, want: "ci"},
+		{robot: HarborRobot{Name: "robot$ci", Level: "system"}, want: "ci"},
+		{robot: HarborRobot{Name: "robot$ci+deploy", Level: "system"}, want: "ci+deploy"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.robot.ShortName(); got != tt.want {
+			t.Errorf("ShortName() of %s = %q, want %q", tt.robot.Name, got, tt.want)
+		}
+	}
+}
@@ -386,14 +386,7 @@ func (v *CloudProjectKubeResponse) ToMap(d *schema.ResourceData) map[string]inte
 	obj[kubeClusterUrlKey] = v.Url
 	obj[kubeClusterPlanKey] = v.Plan
 	loadKubeIPAllocationPolicy(obj, v)
-	versionPatch, err := version.NewVersion(v.Version)
-	if err != nil {
-		// if fail, return to the previous implementation
-		obj[kubeVersionKey] = v.Version[:strings.LastIndex(v.Version, ".")]
-	} else {
-		// versionPatch.String() return a true semantic version (0.0.0)
-		obj[kubeVersionKey] = v.Version[:strings.LastIndex(versionPatch.String(), ".")]
-	}
+	obj[kubeVersionKey] = v.MinorVersion()
 	obj[kubeClusterProxyModeKey] = v.KubeProxyMode
 
 	if v.Customization.APIServer != nil {
@@ -415,6 +408,64 @@ func (v *CloudProjectKubeResponse) ToMap(d *schema.ResourceData) map[string]inte
 	return obj
 }
 
+// MinorVersion returns the version of the cluster without its patch version
+func (v *CloudProjectKubeResponse) MinorVersion() string {
+	versionPatch, err := version.NewVersion(v.Version)
+	if err != nil {
+		// if fail, return to the previous implementation
+		return v.Version[:strings.LastIndex(v.Version, ".")]
+	}
+
+	// versionPatch.String() return a true semantic version (0.0.0)
+	return v.Version[:strings.LastIndex(versionPatch.String(), ".")]
+}
+
+// ToSummaryMap returns the attributes of the cluster listed by the
+// ovh_cloud_project_kubes data source
+func (v *CloudProjectKubeResponse) ToSummaryMap() map[string]interface{} {
+	obj := make(map[string]interface{})
+	obj[kubeClusterControlPlaneIsUpToDateKey] = v.ControlPlaneIsUpToDate
+	obj[kubeNodeIdKey] = v.Id
+	obj[kubeClusterIsUpToDateKey] = v.IsUpToDate
+	obj[kubeClusterLoadBalancersSubnetIdKey] = v.LoadBalancersSubnetId
+	obj[kubeNameKey] = v.Name
+	obj[kubeClusterNextUpgradeVersionsKey] = v.NextUpgradeVersions
+	obj[kubeClusterNodesSubnetIdKey] = v.NodesSubnetId
+	obj[kubeClusterPrivateNetworkIDKey] = v.PrivateNetworkId
+	obj[kubeRegionKey] = v.Region
+	obj[kubeStatusKey] = v.Status
+	obj[kubeClusterUpdatePolicyKey] = v.UpdatePolicy
+	obj[kubeClusterUrlKey] = v.Url
+	obj[kubeClusterPlanKey] = v.Plan
+	obj[kubeVersionKey] = v.MinorVersion()
+	obj[kubeClusterProxyModeKey] = v.KubeProxyMode
+
+	return obj
+}
+
+// Matches returns whether the cluster matches all the given filters, empty
+// filters are ignored. The version filter matches both minor (1.31) and
+// patch (1.31.1) versions
+func (v *CloudProjectKubeResponse) Matches(name, region, version, status, plan string) bool {
+	if name != "" && v.Name != name {
+		return false
+	}
+	if region != "" && v.Region != region {
+		return false
+	}
+	if version != "" && v.Version != version && !strings.HasPrefix(v.Version, version+".") {
+		return false
+	}
+	if status != "" && v.Status != status {
+		return false
+	}
+	if plan != "" && v.Plan != plan {
+		return false
+	}
+
+	return true
+}
+
 func loadKubeProxyCustomizationToMap(obj map[string]interface{}, v *CloudProjectKubeResponse) {
 	obj[kubeClusterCustomizationKubeProxyKey] = []map[string]interface{}{{}}
 
@@ -0,0 +1,85 @@
+package ovh
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// Object storage user role allowed to manage the buckets it is granted by its policy
+const kubeBackupTargetUserRole = "objectstore_operator"
+
+// Actions needed by Velero and its file system backups on the bucket
+var kubeBackupTargetPolicyActions = []string{
+	"s3:GetObject",
+	"s3:PutObject",
+	"s3:DeleteObject",
+	"s3:ListBucket",
+	"s3:ListMultipartUploadParts",
+	"s3:ListBucketMultipartUploads",
+	"s3:AbortMultipartUpload",
+	"s3:GetBucketLocation",
+}
+
+type CloudProjectKubeBackupTargetStorageCreateOpts struct {
+	Name    string `json:"name"`
+	OwnerId int    `json:"ownerId"`
+}
+
+// Returned by GET /cloud/project/{serviceName}/region/{regionName}/storage/{name}
+type CloudProjectKubeBackupTargetStorage struct {
+	Name        string `json:"name"`
+	OwnerId     int    `json:"ownerId"`
+	VirtualHost string `json:"virtualHost"`
+}
+
+type CloudProjectKubeBackupTargetPolicyStatement struct {
+	Sid      string   `json:"Sid"`
+	Effect   string   `json:"Effect"`
+	Action   []string `json:"Action"`
+	Resource []string `json:"Resource"`
+}
+
+type CloudProjectKubeBackupTargetPolicyDocument struct {
+	Statement []CloudProjectKubeBackupTargetPolicyStatement `json:"Statement"`
+}
+
+// cloudProjectKubeBackupTargetPolicy returns the S3 policy only granting access to the given bucket
+func cloudProjectKubeBackupTargetPolicy(bucket string) (string, error) {
+	policy := CloudProjectKubeBackupTargetPolicyDocument{
+		Statement: []CloudProjectKubeBackupTargetPolicyStatement{{
+			Sid:      "VeleroBackupTarget",
+			Effect:   "Allow",
+			Action:   kubeBackupTargetPolicyActions,
+			Resource: []string{"arn:aws:s3:::" + bucket, "arn:aws:s3:::" + bucket + "/*"},
+		}},
+	}
+
+	b, err := json.Marshal(policy)
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+// cloudProjectKubeBackupTargetS3Endpoint returns the path-style S3 endpoint of a bucket,
+// falling back on the regional one when the virtual host of the bucket is unknown
+func cloudProjectKubeBackupTargetS3Endpoint(virtualHost, bucket, region string) string {
+	if u, err := url.Parse(virtualHost); err == nil && u.Host != "" {
+		scheme := u.Scheme
+		if scheme == "" {
+			scheme = "https"
+		}
+		return scheme + "://" + strings.TrimPrefix(u.Host, bucket+".")
+	}
+
+	return fmt.Sprintf("https://s3.%s.io.cloud.ovh.net", strings.ToLower(region))
+}
+
+// cloudProjectKubeBackupTargetVeleroCredentials returns the content of the credentials file
+// expected by the Velero AWS plugin
+func cloudProjectKubeBackupTargetVeleroCredentials(accessKeyId, secretAccessKey string) string {
+	return fmt.Sprintf("[default]\naws_access_key_id=%s\naws_secret_access_key=%s\n", accessKeyId, secretAccessKey)
+}
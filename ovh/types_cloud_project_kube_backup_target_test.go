@@ -0,0 +1,76 @@
+package ovh
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestCloudProjectKubeBackupTargetPolicy(t *testing.T) {
+	policy, err := cloudProjectKubeBackupTargetPolicy("velero")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	document := CloudProjectKubeBackupTargetPolicyDocument{}
+	if err := json.Unmarshal([]byte(policy), &document); err != nil {
+		t.Fatalf("invalid policy %s: %s", policy, err)
+	}
+
+	if len(document.Statement) != 1 {
+		t.Fatalf("expected a single statement, got %+v", document.Statement)
+	}
+
+	statement := document.Statement[0]
+	if statement.Effect != "Allow" {
+		t.Errorf("expected an Allow statement, got %s", statement.Effect)
+	}
+	if want := []string{"arn:aws:s3:::velero", "arn:aws:s3:::velero/*"}; !reflect.DeepEqual(statement.Resource, want) {
+		t.Errorf("expected resources %v, got %v", want, statement.Resource)
+	}
+	if !reflect.DeepEqual(statement.Action, kubeBackupTargetPolicyActions) {
+		t.Errorf("expected actions %v, got %v", kubeBackupTargetPolicyActions, statement.Action)
+	}
+}
+
+func TestCloudProjectKubeBackupTargetS3Endpoint(t *testing.T) {
+	tests := []struct {
+		name        string
+		virtualHost string
+		region      string
+		want        string
+	}{
+		{
+			name:        "virtual host",
+			virtualHost: "https://velero.s3.gra.io.cloud.ovh.net/",
+			region:      "GRA",
+			want:        "https://s3.gra.io.cloud.ovh.net",
+		},
+		{
+			name:        "3AZ region",
+			virtualHost: "https://velero.s3.eu-west-par.io.cloud.ovh.net",
+			region:      "EU-WEST-PAR",
+			want:        "https://s3.eu-west-par.io.cloud.ovh.net",
+		},
+		{
+			name:   "unknown virtual host",
+			region: "SBG",
+			want:   "https://s3.sbg.io.cloud.ovh.net",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := cloudProjectKubeBackupTargetS3Endpoint(tt.virtualHost, "velero", tt.region); got != tt.want {
+				t.Errorf("cloudProjectKubeBackupTargetS3Endpoint() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCloudProjectKubeBackupTargetVeleroCredentials(t *testing.T) {
+	want := "[default]\naws_access_key_id=access\naws_secret_access_key=secret\n"
+	if got := cloudProjectKubeBackupTargetVeleroCredentials("access", "secret"); got != want {
+		t.Errorf("cloudProjectKubeBackupTargetVeleroCredentials() = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,21 @@
+package ovh
+
+// Returned by GET /cloud/project/{serviceName}/kube/{kubeId}/metrics/etcdUsage
+type CloudProjectKubeEtcdUsageResponse struct {
+	Quota int64 `json:"quota"`
+	Usage int64 `json:"usage"`
+}
+
+func (v *CloudProjectKubeEtcdUsageResponse) ToMap() map[string]interface{} {
+	obj := make(map[string]interface{})
+	obj[kubeEtcdUsageQuotaKey] = v.Quota
+	obj[kubeEtcdUsageUsageKey] = v.Usage
+
+	usagePercent := 0.0
+	if v.Quota > 0 {
+		usagePercent = float64(v.Usage) * 100 / float64(v.Quota)
+	}
+	obj[kubeEtcdUsageUsagePercentKey] = usagePercent
+
+	return obj
+}
@@ -380,6 +380,25 @@ type CloudProjectKubeNodePoolResponse struct {
 	Template          *CloudProjectKubeNodePoolTemplate          `json:"template,omitempty"`
 }
 
+// ToSummaryMap returns the attributes of the node pool exposed in the
+// nodepools attribute of the kube data sources
+func (v CloudProjectKubeNodePoolResponse) ToSummaryMap() map[string]interface{} {
+	obj := make(map[string]interface{})
+	obj[kubeNodeIdKey] = v.Id
+	obj[kubeNameKey] = v.Name
+	obj[kubeFlavorKey] = v.Flavor
+	obj[kubeStatusKey] = v.Status
+	obj[kubeNodePoolAutoscaleKey] = v.Autoscale
+	obj[kubeNodePoolAvailabilityZonesKey] = v.AvailabilityZones
+	obj[kubeNodePoolDesiredNodesKey] = v.DesiredNodes
+	obj[kubeNodePoolCurrentNodesKey] = v.CurrentNodes
+	obj[kubeNodePoolMinNodesKey] = v.MinNodes
+	obj[kubeNodePoolMaxNodesKey] = v.MaxNodes
+	obj[kubeNodePoolUpToDateNodesKey] = v.UpToDateNodes
+
+	return obj
+}
+
 func (v CloudProjectKubeNodePoolResponse) ToMap() map[string]interface{} {
 	obj := make(map[string]interface{})
 	obj[kubeNodePoolAntiAffinityKey] = v.AntiAffinity
@@ -715,3 +715,38 @@ func Test_readApiServerAdmissionPlugins(t *testing.T) {
 		})
 	}
 }
+
+func TestCloudProjectKubeResponse_Matches(t *testing.T) {
+	kube := &CloudProjectKubeResponse{
+		Name:    "prod",
+		Region:  "GRA11",
+		Version: "1.31.2-1",
+		Status:  "READY",
+		Plan:    "standard",
+	}
+
+	type args struct {
+		name, region, version, status, plan string
+	}
+	tests := []struct {
+		name string
+		args args
+		want bool
+	}{
+		{name: "no filter", args: args{}, want: true},
+		{name: "name and region", args: args{name: "prod", region: "GRA11"}, want: true},
+		{name: "other region", args: args{name: "prod", region: "SBG5"}, want: false},
+		{name: "minor version", args: args{version: "1.31"}, want: true},
+		{name: "patch version", args: args{version: "1.31.2-1"}, want: true},
+		{name: "version prefix is not a minor", args: args{version: "1.3"}, want: false},
+		{name: "status and plan", args: args{status: "READY", plan: "standard"}, want: true},
+		{name: "other plan", args: args{plan: "free"}, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := kube.Matches(tt.args.name, tt.args.region, tt.args.version, tt.args.status, tt.args.plan); got != tt.want {
+				t.Errorf("Matches() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
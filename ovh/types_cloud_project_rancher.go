@@ -0,0 +1,50 @@
+package ovh
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	ovhtypes "github.com/ovh/terraform-provider-ovh/v2/ovh/types"
+)
+
+type cloudProjectRancherAdminCredentialsModel struct {
+	ID        ovhtypes.TfStringValue `tfsdk:"id"`
+	ProjectId ovhtypes.TfStringValue `tfsdk:"project_id"`
+	RancherId ovhtypes.TfStringValue `tfsdk:"rancher_id"`
+	Triggers  types.Map              `tfsdk:"triggers"`
+	Username  ovhtypes.TfStringValue `tfsdk:"username"`
+	Password  ovhtypes.TfStringValue `tfsdk:"password"`
+	ApiToken  ovhtypes.TfStringValue `tfsdk:"api_token"`
+}
+
+type cloudProjectRancherIamBindingModel struct {
+	ID            ovhtypes.TfStringValue `tfsdk:"id"`
+	ProjectId     ovhtypes.TfStringValue `tfsdk:"project_id"`
+	RancherId     ovhtypes.TfStringValue `tfsdk:"rancher_id"`
+	ApiToken      ovhtypes.TfStringValue `tfsdk:"api_token"`
+	PrincipalId   ovhtypes.TfStringValue `tfsdk:"principal_id"`
+	PrincipalType ovhtypes.TfStringValue `tfsdk:"principal_type"`
+	GlobalRoleId  ovhtypes.TfStringValue `tfsdk:"global_role_id"`
+}
+
+func (m *cloudProjectRancherIamBindingModel) toBinding() *rancherGlobalRoleBinding {
+	binding := &rancherGlobalRoleBinding{
+		GlobalRoleID: m.GlobalRoleId.ValueString(),
+	}
+
+	if m.PrincipalType.ValueString() == "group" {
+		binding.GroupPrincipalID = m.PrincipalId.ValueString()
+	} else {
+		binding.UserPrincipalID = m.PrincipalId.ValueString()
+	}
+
+	return binding
+}
+
+type cloudProjectRancherClusterModel struct {
+	ID        ovhtypes.TfStringValue `tfsdk:"id"`
+	ProjectId ovhtypes.TfStringValue `tfsdk:"project_id"`
+	RancherId ovhtypes.TfStringValue `tfsdk:"rancher_id"`
+	ApiToken  ovhtypes.TfStringValue `tfsdk:"api_token"`
+	Name      ovhtypes.TfStringValue `tfsdk:"name"`
+	KubeId    ovhtypes.TfStringValue `tfsdk:"kube_id"`
+	State     ovhtypes.TfStringValue `tfsdk:"state"`
+}
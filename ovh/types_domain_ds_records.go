@@ -74,3 +74,75 @@ func (v DomainDsRecord) ToMap() map[string]interface{} {
 type DomainDsRecordsUpdateOpts struct {
 	DsRecords []DomainDsRecord `json:"keys"`
 }
+
+const (
+	DsRecordsRolloverModeImmediate = "immediate"
+	DsRecordsRolloverModeStaged    = "staged"
+
+	DsRecordsRolloverPhaseStable     = "stable"
+	DsRecordsRolloverPhasePublishing = "publishing"
+
+	// The registry API does not accept more than 4 DS records for a domain
+	dsRecordsMaxItems = 4
+)
+
+// sameKey returns true if both DS records describe the same DNSSEC key.
+func (v DomainDsRecord) sameKey(other DomainDsRecord) bool {
+	return v.Algorithm == other.Algorithm &&
+		v.Flags == other.Flags &&
+		v.PublicKey == other.PublicKey &&
+		v.Tag == other.Tag
+}
+
+func containsDsRecord(records []DomainDsRecord, record DomainDsRecord) bool {
+	for _, r := range records {
+		if r.sameKey(record) {
+			return true
+		}
+	}
+	return false
+}
+
+// DomainDsRecordsRollover describes the records to publish during a staged key rollover.
+type DomainDsRecordsRollover struct {
+	// Records that are in the configuration but not published yet
+	ToAdd []DomainDsRecord
+	// Records that are published but not in the configuration anymore
+	ToRemove []DomainDsRecord
+	// Records to publish during the double-DS phase: the published records plus the new ones
+	Union []DomainDsRecord
+}
+
+// newDomainDsRecordsRollover computes the difference between the published and desired DS records.
+func newDomainDsRecordsRollover(current, desired []DomainDsRecord) DomainDsRecordsRollover {
+	rollover := DomainDsRecordsRollover{}
+
+	for _, record := range desired {
+		if !containsDsRecord(current, record) {
+			rollover.ToAdd = append(rollover.ToAdd, record)
+		}
+	}
+
+	for _, record := range current {
+		if !containsDsRecord(desired, record) {
+			rollover.ToRemove = append(rollover.ToRemove, record)
+		}
+	}
+
+	for _, record := range current {
+		record.Id = 0
+		rollover.Union = append(rollover.Union, record)
+	}
+	rollover.Union = append(rollover.Union, rollover.ToAdd...)
+
+	return rollover
+}
+
+// RemovedTags returns the tags of the records that are removed by the rollover.
+func (v DomainDsRecordsRollover) RemovedTags() []int {
+	tags := make([]int, 0, len(v.ToRemove))
+	for _, record := range v.ToRemove {
+		tags = append(tags, record.Tag)
+	}
+	return tags
+}
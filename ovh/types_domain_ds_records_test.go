@@ -0,0 +1,67 @@
+package ovh
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestNewDomainDsRecordsRollover(t *testing.T) {
+	oldKsk := DomainDsRecord{Id: 1, Algorithm: 13, Flags: 257, PublicKey: "old", Tag: 1111}
+	newKsk := DomainDsRecord{Algorithm: 13, Flags: 257, PublicKey: "new", Tag: 2222}
+	zsk := DomainDsRecord{Id: 2, Algorithm: 13, Flags: 256, PublicKey: "zsk", Tag: 3333}
+
+	tests := []struct {
+		name        string
+		current     []DomainDsRecord
+		desired     []DomainDsRecord
+		wantAdd     []DomainDsRecord
+		wantRemove  []DomainDsRecord
+		wantUnion   []DomainDsRecord
+		wantRemoved []int
+	}{
+		{
+			name:        "key rollover",
+			current:     []DomainDsRecord{oldKsk, zsk},
+			desired:     []DomainDsRecord{newKsk, zsk},
+			wantAdd:     []DomainDsRecord{newKsk},
+			wantRemove:  []DomainDsRecord{oldKsk},
+			wantUnion:   []DomainDsRecord{{Algorithm: 13, Flags: 257, PublicKey: "old", Tag: 1111}, {Algorithm: 13, Flags: 256, PublicKey: "zsk", Tag: 3333}, newKsk},
+			wantRemoved: []int{1111},
+		},
+		{
+			name:        "publishing phase already done",
+			current:     []DomainDsRecord{oldKsk, newKsk},
+			desired:     []DomainDsRecord{newKsk},
+			wantRemove:  []DomainDsRecord{oldKsk},
+			wantUnion:   []DomainDsRecord{{Algorithm: 13, Flags: 257, PublicKey: "old", Tag: 1111}, newKsk},
+			wantRemoved: []int{1111},
+		},
+		{
+			name:        "no change",
+			current:     []DomainDsRecord{zsk},
+			desired:     []DomainDsRecord{{Algorithm: 13, Flags: 256, PublicKey: "zsk", Tag: 3333}},
+			wantUnion:   []DomainDsRecord{{Algorithm: 13, Flags: 256, PublicKey: "zsk", Tag: 3333}},
+			wantRemoved: []int{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := newDomainDsRecordsRollover(tt.current, tt.desired)
+
+			if diff := cmp.Diff(tt.wantAdd, got.ToAdd); diff != "" {
+				t.Errorf("ToAdd mismatch (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tt.wantRemove, got.ToRemove); diff != "" {
+				t.Errorf("ToRemove mismatch (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tt.wantUnion, got.Union); diff != "" {
+				t.Errorf("Union mismatch (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tt.wantRemoved, got.RemovedTags()); diff != "" {
+				t.Errorf("RemovedTags mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
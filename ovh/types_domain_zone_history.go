@@ -0,0 +1,53 @@
+package ovh
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	ovhtypes "github.com/ovh/terraform-provider-ovh/v2/ovh/types"
+)
+
+// DomainZoneRestorePoint is the API response for domain.zone.ZoneRestorePoint,
+// returned by GET /domain/zone/{zoneName}/history/{creationDate}.
+type DomainZoneRestorePoint struct {
+	CreationDate string `json:"creationDate"`
+	ZoneFileUrl  string `json:"zoneFileUrl"`
+}
+
+// domainZoneHistoryDataSourceModel is the Terraform model for the ovh_domain_zone_history data source.
+type domainZoneHistoryDataSourceModel struct {
+	ZoneName         ovhtypes.TfStringValue `tfsdk:"zone_name"`
+	CreationDateFrom ovhtypes.TfStringValue `tfsdk:"creation_date_from"`
+	CreationDateTo   ovhtypes.TfStringValue `tfsdk:"creation_date_to"`
+	History          types.List             `tfsdk:"history"`
+}
+
+// domainZoneHistoryRestoreModel is the Terraform model for the ovh_domain_zone_history_restore resource.
+type domainZoneHistoryRestoreModel struct {
+	ID           ovhtypes.TfStringValue `tfsdk:"id"`
+	ZoneName     ovhtypes.TfStringValue `tfsdk:"zone_name"`
+	CreationDate ovhtypes.TfStringValue `tfsdk:"creation_date"`
+	ZoneFileUrl  ovhtypes.TfStringValue `tfsdk:"zone_file_url"`
+}
+
+// domainZoneRestorePointObjectAttrTypes returns the attribute types of a single history entry.
+func domainZoneRestorePointObjectAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"creation_date": ovhtypes.TfStringType{},
+		"zone_file_url": ovhtypes.TfStringType{},
+	}
+}
+
+// domainZoneRestorePointToObject converts an API restore point into a Terraform object value
+// matching domainZoneRestorePointObjectAttrTypes.
+func domainZoneRestorePointToObject(point DomainZoneRestorePoint) (types.Object, diag.Diagnostics) {
+	return types.ObjectValue(domainZoneRestorePointObjectAttrTypes(), map[string]attr.Value{
+		"creation_date": ovhtypes.NewTfStringValue(point.CreationDate),
+		"zone_file_url": ovhtypes.NewTfStringValue(point.ZoneFileUrl),
+	})
+}
+
+// MergeWith populates the restore resource model from an API restore point.
+func (m *domainZoneHistoryRestoreModel) MergeWith(point *DomainZoneRestorePoint) {
+	m.ZoneFileUrl = ovhtypes.NewTfStringValue(point.ZoneFileUrl)
+}
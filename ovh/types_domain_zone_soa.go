@@ -0,0 +1,80 @@
+package ovh
+
+import (
+	ovhtypes "github.com/ovh/terraform-provider-ovh/v2/ovh/types"
+)
+
+// RFC-compatible bounds used to validate the SOA timers. Timers are 32-bit
+// values (RFC 1035) capped to 2^31-1 (RFC 2181), and the negative caching
+// TTL should not exceed one day (RFC 2308).
+const (
+	domainZoneSoaMinTimer    = 60
+	domainZoneSoaMaxTimer    = 2147483647
+	domainZoneSoaMaxNegative = 86400
+)
+
+// DomainZoneSoa is the API representation of domain.zone.Soa,
+// returned by GET /domain/zone/{zoneName}/soa.
+type DomainZoneSoa struct {
+	Email       string `json:"email"`
+	Expire      int64  `json:"expire"`
+	NxDomainTtl int64  `json:"nxDomainTtl"`
+	Refresh     int64  `json:"refresh"`
+	Retry       int64  `json:"retry"`
+	Serial      int64  `json:"serial,omitempty"`
+	Server      string `json:"server,omitempty"`
+	Ttl         int64  `json:"ttl"`
+}
+
+// domainZoneSoaModel is the Terraform model for the ovh_domain_zone_soa resource.
+type domainZoneSoaModel struct {
+	ID          ovhtypes.TfStringValue `tfsdk:"id"`
+	ZoneName    ovhtypes.TfStringValue `tfsdk:"zone_name"`
+	Email       ovhtypes.TfStringValue `tfsdk:"email"`
+	Expire      ovhtypes.TfInt64Value  `tfsdk:"expire"`
+	NxDomainTtl ovhtypes.TfInt64Value  `tfsdk:"nx_domain_ttl"`
+	Refresh     ovhtypes.TfInt64Value  `tfsdk:"refresh"`
+	Retry       ovhtypes.TfInt64Value  `tfsdk:"retry"`
+	Serial      ovhtypes.TfInt64Value  `tfsdk:"serial"`
+	Server      ovhtypes.TfStringValue `tfsdk:"server"`
+	Ttl         ovhtypes.TfInt64Value  `tfsdk:"ttl"`
+}
+
+// ApplyTo overwrites the values of the given SOA with the ones configured in the model.
+// Values that are not configured are left untouched.
+func (m *domainZoneSoaModel) ApplyTo(soa *DomainZoneSoa) {
+	if !m.Email.IsNull() && !m.Email.IsUnknown() {
+		soa.Email = m.Email.ValueString()
+	}
+	if !m.Expire.IsNull() && !m.Expire.IsUnknown() {
+		soa.Expire = m.Expire.ValueInt64()
+	}
+	if !m.NxDomainTtl.IsNull() && !m.NxDomainTtl.IsUnknown() {
+		soa.NxDomainTtl = m.NxDomainTtl.ValueInt64()
+	}
+	if !m.Refresh.IsNull() && !m.Refresh.IsUnknown() {
+		soa.Refresh = m.Refresh.ValueInt64()
+	}
+	if !m.Retry.IsNull() && !m.Retry.IsUnknown() {
+		soa.Retry = m.Retry.ValueInt64()
+	}
+	if !m.Ttl.IsNull() && !m.Ttl.IsUnknown() {
+		soa.Ttl = m.Ttl.ValueInt64()
+	}
+
+	// Read-only values must not be sent back to the API
+	soa.Serial = 0
+	soa.Server = ""
+}
+
+// MergeWith populates the model from an API SOA.
+func (m *domainZoneSoaModel) MergeWith(soa *DomainZoneSoa) {
+	m.Email = ovhtypes.NewTfStringValue(soa.Email)
+	m.Expire = ovhtypes.NewTfInt64Value(soa.Expire)
+	m.NxDomainTtl = ovhtypes.NewTfInt64Value(soa.NxDomainTtl)
+	m.Refresh = ovhtypes.NewTfInt64Value(soa.Refresh)
+	m.Retry = ovhtypes.NewTfInt64Value(soa.Retry)
+	m.Serial = ovhtypes.NewTfInt64Value(soa.Serial)
+	m.Server = ovhtypes.NewTfStringValue(soa.Server)
+	m.Ttl = ovhtypes.NewTfInt64Value(soa.Ttl)
+}
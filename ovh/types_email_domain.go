@@ -0,0 +1,340 @@
+package ovh
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/ovh/go-ovh/ovh"
+	ovhtypes "github.com/ovh/terraform-provider-ovh/v2/ovh/types"
+)
+
+// Kinds of tasks exposed under /email/domain/{domain}/task/{kind}
+const (
+	emailDomainTaskKindAccount     = "account"
+	emailDomainTaskKindFilter      = "filter"
+	emailDomainTaskKindMailingList = "mailinglist"
+	emailDomainTaskKindRedirection = "redirection"
+	emailDomainTaskKindResponder   = "responder"
+)
+
+// EmailDomainTask is the common part of the email.domain.Task* API objects.
+type EmailDomainTask struct {
+	Id      int64  `json:"id"`
+	Action  string `json:"action"`
+	Date    string `json:"date"`
+	Domain  string `json:"domain"`
+	Account string `json:"account"`
+}
+
+// waitEmailDomainTask waits for an email domain task to complete. Tasks of
+// email domains are removed from the API once they are done.
+func waitEmailDomainTask(ctx context.Context, config *Config, domain, kind string, taskID int64) error {
+	endpoint := fmt.Sprintf("/email/domain/%s/task/%s/%d", url.PathEscape(domain), kind, taskID)
+
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{"todo"},
+		Target:  []string{"done"},
+		Refresh: func() (interface{}, string, error) {
+			var task EmailDomainTask
+			if err := config.OVHClient.GetWithContext(ctx, endpoint, &task); err != nil {
+				if errOvh, ok := err.(*ovh.APIError); ok && errOvh.Code == http.StatusNotFound {
+					return task, "done", nil
+				}
+				log.Printf("[ERROR] couldn't fetch email domain task %d: error: %v", taskID, err)
+				return nil, "error", err
+			}
+			return task, "todo", nil
+		},
+		Timeout:    20 * time.Minute,
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("error waiting for email domain %s %s task %d to complete: %w", domain, kind, taskID, err)
+	}
+
+	return nil
+}
+
+// Redirections
+
+// EmailDomainRedirection is the API representation of email.domain.RedirectionGlobal.
+type EmailDomainRedirection struct {
+	Id   string `json:"id"`
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+type EmailDomainRedirectionCreateOpts struct {
+	From      string `json:"from"`
+	To        string `json:"to"`
+	LocalCopy bool   `json:"localCopy"`
+}
+
+type emailDomainRedirectionModel struct {
+	ID        ovhtypes.TfStringValue `tfsdk:"id"`
+	Domain    ovhtypes.TfStringValue `tfsdk:"domain"`
+	From      ovhtypes.TfStringValue `tfsdk:"from"`
+	To        ovhtypes.TfStringValue `tfsdk:"to"`
+	LocalCopy ovhtypes.TfBoolValue   `tfsdk:"local_copy"`
+}
+
+func (m *emailDomainRedirectionModel) MergeWith(redirection *EmailDomainRedirection) {
+	m.ID = ovhtypes.NewTfStringValue(redirection.Id)
+	m.From = ovhtypes.NewTfStringValue(redirection.From)
+	m.To = ovhtypes.NewTfStringValue(redirection.To)
+}
+
+// Responders
+
+// EmailDomainResponder is the API representation of email.domain.Responder.
+type EmailDomainResponder struct {
+	Account string  `json:"account,omitempty"`
+	Content string  `json:"content"`
+	Copy    bool    `json:"copy"`
+	CopyTo  *string `json:"copyTo"`
+	From    *string `json:"from"`
+	To      *string `json:"to"`
+}
+
+type emailDomainResponderModel struct {
+	ID          ovhtypes.TfStringValue `tfsdk:"id"`
+	Domain      ovhtypes.TfStringValue `tfsdk:"domain"`
+	AccountName ovhtypes.TfStringValue `tfsdk:"account_name"`
+	Content     ovhtypes.TfStringValue `tfsdk:"content"`
+	Copy        ovhtypes.TfBoolValue   `tfsdk:"copy"`
+	CopyTo      ovhtypes.TfStringValue `tfsdk:"copy_to"`
+	From        ovhtypes.TfStringValue `tfsdk:"from"`
+	To          ovhtypes.TfStringValue `tfsdk:"to"`
+}
+
+func (m *emailDomainResponderModel) ToPayload(withAccount bool) *EmailDomainResponder {
+	payload := &EmailDomainResponder{
+		Content: m.Content.ValueString(),
+		Copy:    m.Copy.ValueBool(),
+		CopyTo:  tfStringPtr(m.CopyTo),
+		From:    tfStringPtr(m.From),
+		To:      tfStringPtr(m.To),
+	}
+
+	if withAccount {
+		payload.Account = m.AccountName.ValueString()
+	}
+
+	return payload
+}
+
+func (m *emailDomainResponderModel) MergeWith(responder *EmailDomainResponder) {
+	m.Content = ovhtypes.NewTfStringValue(responder.Content)
+	m.Copy = ovhtypes.NewTfBoolValue(responder.Copy)
+
+	if responder.CopyTo != nil {
+		m.CopyTo = tfStringOrNull(*responder.CopyTo)
+	} else {
+		m.CopyTo = ovhtypes.NewTfStringNull()
+	}
+
+	// Keep the configured dates when they represent the same instant as the API ones
+	m.From = mergeEmailDomainDate(m.From, responder.From)
+	m.To = mergeEmailDomainDate(m.To, responder.To)
+}
+
+// mergeEmailDomainDate returns the date returned by the API unless it represents
+// the same instant as the current value, to avoid diffs due to formatting.
+func mergeEmailDomainDate(current ovhtypes.TfStringValue, apiValue *string) ovhtypes.TfStringValue {
+	if apiValue == nil || *apiValue == "" {
+		return ovhtypes.NewTfStringNull()
+	}
+
+	if !current.IsNull() && !current.IsUnknown() {
+		currentDate, errCurrent := time.Parse(time.RFC3339, current.ValueString())
+		apiDate, errAPI := time.Parse(time.RFC3339, *apiValue)
+		if errCurrent == nil && errAPI == nil && currentDate.Equal(apiDate) {
+			return current
+		}
+	}
+
+	return ovhtypes.NewTfStringValue(*apiValue)
+}
+
+// Mailing lists
+
+// EmailDomainMailingListOptions is the API representation of email.domain.DomainMlOptionsStruct.
+type EmailDomainMailingListOptions struct {
+	ModeratorMessage     bool `json:"moderatorMessage"`
+	SubscribeByModerator bool `json:"subscribeByModerator"`
+	UsersPostOnly        bool `json:"usersPostOnly"`
+}
+
+// EmailDomainMailingList is the API representation of email.domain.MailingList.
+type EmailDomainMailingList struct {
+	Id            int64                         `json:"id,omitempty"`
+	Name          string                        `json:"name,omitempty"`
+	Language      string                        `json:"language"`
+	OwnerEmail    string                        `json:"ownerEmail"`
+	ReplyTo       string                        `json:"replyTo"`
+	Options       EmailDomainMailingListOptions `json:"options"`
+	NbSubscribers int64                         `json:"nbSubscribers,omitempty"`
+}
+
+type EmailDomainMailingListUpdateOpts struct {
+	Language   string `json:"language"`
+	OwnerEmail string `json:"ownerEmail"`
+	ReplyTo    string `json:"replyTo"`
+}
+
+type EmailDomainMailingListChangeOptionsOpts struct {
+	Options EmailDomainMailingListOptions `json:"options"`
+}
+
+type emailDomainMailingListModel struct {
+	ID                   ovhtypes.TfStringValue `tfsdk:"id"`
+	Domain               ovhtypes.TfStringValue `tfsdk:"domain"`
+	Name                 ovhtypes.TfStringValue `tfsdk:"name"`
+	Language             ovhtypes.TfStringValue `tfsdk:"language"`
+	OwnerEmail           ovhtypes.TfStringValue `tfsdk:"owner_email"`
+	ReplyTo              ovhtypes.TfStringValue `tfsdk:"reply_to"`
+	ModeratorMessage     ovhtypes.TfBoolValue   `tfsdk:"moderator_message"`
+	SubscribeByModerator ovhtypes.TfBoolValue   `tfsdk:"subscribe_by_moderator"`
+	UsersPostOnly        ovhtypes.TfBoolValue   `tfsdk:"users_post_only"`
+	NbSubscribers        ovhtypes.TfInt64Value  `tfsdk:"nb_subscribers"`
+}
+
+func (m *emailDomainMailingListModel) Options() EmailDomainMailingListOptions {
+	return EmailDomainMailingListOptions{
+		ModeratorMessage:     m.ModeratorMessage.ValueBool(),
+		SubscribeByModerator: m.SubscribeByModerator.ValueBool(),
+		UsersPostOnly:        m.UsersPostOnly.ValueBool(),
+	}
+}
+
+func (m *emailDomainMailingListModel) ToCreate() *EmailDomainMailingList {
+	return &EmailDomainMailingList{
+		Name:       m.Name.ValueString(),
+		Language:   m.Language.ValueString(),
+		OwnerEmail: m.OwnerEmail.ValueString(),
+		ReplyTo:    m.ReplyTo.ValueString(),
+		Options:    m.Options(),
+	}
+}
+
+func (m *emailDomainMailingListModel) ToUpdate() *EmailDomainMailingListUpdateOpts {
+	return &EmailDomainMailingListUpdateOpts{
+		Language:   m.Language.ValueString(),
+		OwnerEmail: m.OwnerEmail.ValueString(),
+		ReplyTo:    m.ReplyTo.ValueString(),
+	}
+}
+
+func (m *emailDomainMailingListModel) MergeWith(ml *EmailDomainMailingList) {
+	m.Name = ovhtypes.NewTfStringValue(ml.Name)
+	m.Language = ovhtypes.NewTfStringValue(ml.Language)
+	m.OwnerEmail = ovhtypes.NewTfStringValue(ml.OwnerEmail)
+	m.ReplyTo = ovhtypes.NewTfStringValue(ml.ReplyTo)
+	m.ModeratorMessage = ovhtypes.NewTfBoolValue(ml.Options.ModeratorMessage)
+	m.SubscribeByModerator = ovhtypes.NewTfBoolValue(ml.Options.SubscribeByModerator)
+	m.UsersPostOnly = ovhtypes.NewTfBoolValue(ml.Options.UsersPostOnly)
+	m.NbSubscribers = ovhtypes.NewTfInt64Value(ml.NbSubscribers)
+}
+
+// emailDomainMailingListMemberModel is the Terraform model shared by the mailing
+// list moderator and subscriber resources.
+type emailDomainMailingListMemberModel struct {
+	ID              ovhtypes.TfStringValue `tfsdk:"id"`
+	Domain          ovhtypes.TfStringValue `tfsdk:"domain"`
+	MailingListName ovhtypes.TfStringValue `tfsdk:"mailing_list_name"`
+	Email           ovhtypes.TfStringValue `tfsdk:"email"`
+}
+
+// Account filters
+
+// EmailDomainFilter is the API representation of email.domain.Filter.
+type EmailDomainFilter struct {
+	Account     string `json:"account"`
+	Action      string `json:"action"`
+	ActionParam string `json:"actionParam"`
+	Active      bool   `json:"active"`
+	Domain      string `json:"domain"`
+	Name        string `json:"name"`
+	Priority    int64  `json:"priority"`
+}
+
+// EmailDomainFilterRule is the API representation of email.domain.Rule.
+type EmailDomainFilterRule struct {
+	Id      int64  `json:"id"`
+	Header  string `json:"header"`
+	Operand string `json:"operand"`
+	Value   string `json:"value"`
+}
+
+type EmailDomainFilterCreateOpts struct {
+	Name        string `json:"name"`
+	Action      string `json:"action"`
+	ActionParam string `json:"actionParam,omitempty"`
+	Active      bool   `json:"active"`
+	Priority    int64  `json:"priority"`
+	Header      string `json:"header"`
+	Operand     string `json:"operand"`
+	Value       string `json:"value"`
+}
+
+type emailDomainAccountFilterModel struct {
+	ID          ovhtypes.TfStringValue `tfsdk:"id"`
+	Domain      ovhtypes.TfStringValue `tfsdk:"domain"`
+	AccountName ovhtypes.TfStringValue `tfsdk:"account_name"`
+	Name        ovhtypes.TfStringValue `tfsdk:"name"`
+	Action      ovhtypes.TfStringValue `tfsdk:"action"`
+	ActionParam ovhtypes.TfStringValue `tfsdk:"action_param"`
+	Active      ovhtypes.TfBoolValue   `tfsdk:"active"`
+	Priority    ovhtypes.TfInt64Value  `tfsdk:"priority"`
+	Header      ovhtypes.TfStringValue `tfsdk:"header"`
+	Operand     ovhtypes.TfStringValue `tfsdk:"operand"`
+	Value       ovhtypes.TfStringValue `tfsdk:"value"`
+}
+
+func (m *emailDomainAccountFilterModel) ToCreate() *EmailDomainFilterCreateOpts {
+	return &EmailDomainFilterCreateOpts{
+		Name:        m.Name.ValueString(),
+		Action:      m.Action.ValueString(),
+		ActionParam: m.ActionParam.ValueString(),
+		Active:      m.Active.ValueBool(),
+		Priority:    m.Priority.ValueInt64(),
+		Header:      m.Header.ValueString(),
+		Operand:     m.Operand.ValueString(),
+		Value:       m.Value.ValueString(),
+	}
+}
+
+func (m *emailDomainAccountFilterModel) MergeWith(filter *EmailDomainFilter, rule *EmailDomainFilterRule) {
+	m.Name = ovhtypes.NewTfStringValue(filter.Name)
+	m.Action = ovhtypes.NewTfStringValue(filter.Action)
+	m.ActionParam = tfStringOrNull(filter.ActionParam)
+	m.Active = ovhtypes.NewTfBoolValue(filter.Active)
+	m.Priority = ovhtypes.NewTfInt64Value(filter.Priority)
+
+	if rule != nil {
+		m.Header = ovhtypes.NewTfStringValue(rule.Header)
+		m.Operand = ovhtypes.NewTfStringValue(rule.Operand)
+		m.Value = ovhtypes.NewTfStringValue(rule.Value)
+	}
+}
+
+// Account delegations
+
+// EmailDomainDelegation is the API representation of email.domain.AccountDelegation.
+type EmailDomainDelegation struct {
+	AccountId string `json:"accountId"`
+}
+
+type emailDomainAccountDelegationModel struct {
+	ID          ovhtypes.TfStringValue `tfsdk:"id"`
+	Domain      ovhtypes.TfStringValue `tfsdk:"domain"`
+	AccountName ovhtypes.TfStringValue `tfsdk:"account_name"`
+	AccountId   ovhtypes.TfStringValue `tfsdk:"account_id"`
+}
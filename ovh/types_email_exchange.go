@@ -0,0 +1,502 @@
+package ovh
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/ovh/go-ovh/ovh"
+	ovhtypes "github.com/ovh/terraform-provider-ovh/v2/ovh/types"
+)
+
+// EmailExchangeTask is the API representation of email.exchange.Task and email.pro.Task,
+// both products sharing the same task lifecycle.
+type EmailExchangeTask struct {
+	Id         int64  `json:"id"`
+	Function   string `json:"function"`
+	Status     string `json:"status"`
+	TodoDate   string `json:"todoDate"`
+	FinishDate string `json:"finishDate"`
+}
+
+func emailExchangeServiceEndpoint(organizationName, exchangeService string) string {
+	return "/email/exchange/" + url.PathEscape(organizationName) + "/service/" + url.PathEscape(exchangeService)
+}
+
+// waitEmailExchangeTask waits for a task of an Exchange or Email Pro service to complete.
+// serviceEndpoint is the base path of the service, e.g. /email/exchange/{org}/service/{svc}
+// or /email/pro/{svc}. Finished tasks may be purged from the API, a 404 is considered done.
+func waitEmailExchangeTask(ctx context.Context, config *Config, serviceEndpoint string, taskID int64) error {
+	endpoint := fmt.Sprintf("%s/task/%d", serviceEndpoint, taskID)
+
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{"todo", "doing", "fixing"},
+		Target:  []string{"done"},
+		Refresh: func() (interface{}, string, error) {
+			var task EmailExchangeTask
+			if err := config.OVHClient.GetWithContext(ctx, endpoint, &task); err != nil {
+				if errOvh, ok := err.(*ovh.APIError); ok && errOvh.Code == http.StatusNotFound {
+					return task, "done", nil
+				}
+				log.Printf("[ERROR] couldn't fetch task %d of %s: error: %v", taskID, serviceEndpoint, err)
+				return nil, "error", err
+			}
+			return task, task.Status, nil
+		},
+		Timeout:    30 * time.Minute,
+		Delay:      10 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("error waiting for task %d of %s to complete: %w", taskID, serviceEndpoint, err)
+	}
+
+	return nil
+}
+
+// emailExchangeSyncAliases adds and removes the aliases of an Exchange or Email Pro account
+// so that they match the wanted ones, waiting for each resulting task.
+func emailExchangeSyncAliases(ctx context.Context, config *Config, serviceEndpoint, accountEndpoint string, current, wanted []string) error {
+	currentSet := make(map[string]bool, len(current))
+	for _, alias := range current {
+		currentSet[alias] = true
+	}
+	wantedSet := make(map[string]bool, len(wanted))
+	for _, alias := range wanted {
+		wantedSet[alias] = true
+	}
+
+	for _, alias := range current {
+		if wantedSet[alias] {
+			continue
+		}
+
+		var task EmailExchangeTask
+		endpoint := accountEndpoint + "/alias/" + url.PathEscape(alias)
+		if err := config.OVHClient.DeleteWithContext(ctx, endpoint, &task); err != nil {
+			return fmt.Errorf("error calling Delete %s: %w", endpoint, err)
+		}
+		if err := waitEmailExchangeTask(ctx, config, serviceEndpoint, task.Id); err != nil {
+			return err
+		}
+	}
+
+	for _, alias := range wanted {
+		if currentSet[alias] {
+			continue
+		}
+
+		var task EmailExchangeTask
+		endpoint := accountEndpoint + "/alias"
+		if err := config.OVHClient.PostWithContext(ctx, endpoint, map[string]string{"alias": alias}, &task); err != nil {
+			return fmt.Errorf("error calling Post %s: %w", endpoint, err)
+		}
+		if err := waitEmailExchangeTask(ctx, config, serviceEndpoint, task.Id); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// emailExchangeGetAliases returns the sorted aliases of an Exchange or Email Pro account.
+func emailExchangeGetAliases(ctx context.Context, config *Config, accountEndpoint string) ([]string, error) {
+	var aliases []string
+
+	endpoint := accountEndpoint + "/alias"
+	if err := config.OVHClient.GetWithContext(ctx, endpoint, &aliases); err != nil {
+		return nil, fmt.Errorf("error calling Get %s: %w", endpoint, err)
+	}
+	sort.Strings(aliases)
+
+	return aliases, nil
+}
+
+func emailExchangeAliasesFromSet(ctx context.Context, set types.Set) ([]string, diag.Diagnostics) {
+	aliases := []string{}
+	if set.IsNull() || set.IsUnknown() {
+		return aliases, nil
+	}
+	diags := set.ElementsAs(ctx, &aliases, false)
+	return aliases, diags
+}
+
+func emailExchangeAliasesToSet(aliases []string) types.Set {
+	elems := make([]attr.Value, 0, len(aliases))
+	for _, alias := range aliases {
+		elems = append(elems, types.StringValue(alias))
+	}
+	return types.SetValueMust(types.StringType, elems)
+}
+
+// Accounts
+
+// EmailExchangeAccount is the API representation of email.exchange.Account.
+type EmailExchangeAccount struct {
+	PrimaryEmailAddress string `json:"primaryEmailAddress"`
+	Login               string `json:"login"`
+	Domain              string `json:"domain"`
+	AccountLicense      string `json:"accountLicense"`
+	DisplayName         string `json:"displayName"`
+	FirstName           string `json:"firstName"`
+	LastName            string `json:"lastName"`
+	HiddenFromGAL       bool   `json:"hiddenFromGAL"`
+	Quota               int64  `json:"quota"`
+	CurrentUsage        int64  `json:"currentUsage"`
+	State               string `json:"state"`
+}
+
+type EmailExchangeAccountCreateOpts struct {
+	Login         string  `json:"login"`
+	Domain        string  `json:"domain"`
+	License       string  `json:"license"`
+	Password      string  `json:"password"`
+	DisplayName   *string `json:"displayName,omitempty"`
+	FirstName     *string `json:"firstName,omitempty"`
+	LastName      *string `json:"lastName,omitempty"`
+	HiddenFromGAL bool    `json:"hiddenFromGAL"`
+}
+
+type EmailExchangeAccountUpdateOpts struct {
+	AccountLicense string  `json:"accountLicense,omitempty"`
+	DisplayName    *string `json:"displayName,omitempty"`
+	FirstName      *string `json:"firstName,omitempty"`
+	LastName       *string `json:"lastName,omitempty"`
+	HiddenFromGAL  bool    `json:"hiddenFromGAL"`
+	Quota          *int64  `json:"quota,omitempty"`
+}
+
+type emailExchangeAccountModel struct {
+	ID                  ovhtypes.TfStringValue `tfsdk:"id"`
+	OrganizationName    ovhtypes.TfStringValue `tfsdk:"organization_name"`
+	ExchangeService     ovhtypes.TfStringValue `tfsdk:"exchange_service"`
+	Login               ovhtypes.TfStringValue `tfsdk:"login"`
+	Domain              ovhtypes.TfStringValue `tfsdk:"domain"`
+	License             ovhtypes.TfStringValue `tfsdk:"license"`
+	Password            ovhtypes.TfStringValue `tfsdk:"password"`
+	DisplayName         ovhtypes.TfStringValue `tfsdk:"display_name"`
+	FirstName           ovhtypes.TfStringValue `tfsdk:"first_name"`
+	LastName            ovhtypes.TfStringValue `tfsdk:"last_name"`
+	HiddenFromGAL       ovhtypes.TfBoolValue   `tfsdk:"hidden_from_gal"`
+	Aliases             types.Set              `tfsdk:"aliases"`
+	PrimaryEmailAddress ovhtypes.TfStringValue `tfsdk:"primary_email_address"`
+	Quota               ovhtypes.TfInt64Value  `tfsdk:"quota"`
+	CurrentUsage        ovhtypes.TfInt64Value  `tfsdk:"current_usage"`
+	State               ovhtypes.TfStringValue `tfsdk:"state"`
+}
+
+func (m *emailExchangeAccountModel) ToCreate() *EmailExchangeAccountCreateOpts {
+	return &EmailExchangeAccountCreateOpts{
+		Login:         m.Login.ValueString(),
+		Domain:        m.Domain.ValueString(),
+		License:       m.License.ValueString(),
+		Password:      m.Password.ValueString(),
+		DisplayName:   tfStringPtr(m.DisplayName),
+		FirstName:     tfStringPtr(m.FirstName),
+		LastName:      tfStringPtr(m.LastName),
+		HiddenFromGAL: m.HiddenFromGAL.ValueBool(),
+	}
+}
+
+func (m *emailExchangeAccountModel) ToUpdate() *EmailExchangeAccountUpdateOpts {
+	return &EmailExchangeAccountUpdateOpts{
+		AccountLicense: m.License.ValueString(),
+		DisplayName:    tfStringPtr(m.DisplayName),
+		FirstName:      tfStringPtr(m.FirstName),
+		LastName:       tfStringPtr(m.LastName),
+		HiddenFromGAL:  m.HiddenFromGAL.ValueBool(),
+		Quota:          tfInt64Ptr(m.Quota),
+	}
+}
+
+func (m *emailExchangeAccountModel) MergeWith(account *EmailExchangeAccount, aliases []string) {
+	m.ID = ovhtypes.NewTfStringValue(account.PrimaryEmailAddress)
+	m.PrimaryEmailAddress = ovhtypes.NewTfStringValue(account.PrimaryEmailAddress)
+	m.Login = ovhtypes.NewTfStringValue(account.Login)
+	m.Domain = ovhtypes.NewTfStringValue(account.Domain)
+	m.License = ovhtypes.NewTfStringValue(account.AccountLicense)
+	m.DisplayName = tfStringOrNull(account.DisplayName)
+	m.FirstName = tfStringOrNull(account.FirstName)
+	m.LastName = tfStringOrNull(account.LastName)
+	m.HiddenFromGAL = ovhtypes.NewTfBoolValue(account.HiddenFromGAL)
+	m.Aliases = emailExchangeAliasesToSet(aliases)
+	m.Quota = ovhtypes.NewTfInt64Value(account.Quota)
+	m.CurrentUsage = ovhtypes.NewTfInt64Value(account.CurrentUsage)
+	m.State = ovhtypes.NewTfStringValue(account.State)
+}
+
+// Shared accounts
+
+// EmailExchangeSharedAccount is the API representation of email.exchange.SharedAccount and email.pro.SharedAccount.
+type EmailExchangeSharedAccount struct {
+	SharedEmailAddress string `json:"sharedEmailAddress"`
+	DisplayName        string `json:"displayName"`
+	FirstName          string `json:"firstName"`
+	LastName           string `json:"lastName"`
+	HiddenFromGAL      bool   `json:"hiddenFromGAL"`
+	Quota              int64  `json:"quota"`
+	CurrentUsage       int64  `json:"currentUsage"`
+	State              string `json:"state"`
+}
+
+type EmailExchangeSharedAccountOpts struct {
+	SharedEmailAddress string  `json:"sharedEmailAddress,omitempty"`
+	DisplayName        *string `json:"displayName,omitempty"`
+	FirstName          *string `json:"firstName,omitempty"`
+	LastName           *string `json:"lastName,omitempty"`
+	HiddenFromGAL      bool    `json:"hiddenFromGAL"`
+	Quota              int64   `json:"quota"`
+}
+
+type emailExchangeSharedAccountModel struct {
+	ID                 ovhtypes.TfStringValue `tfsdk:"id"`
+	OrganizationName   ovhtypes.TfStringValue `tfsdk:"organization_name"`
+	ExchangeService    ovhtypes.TfStringValue `tfsdk:"exchange_service"`
+	SharedEmailAddress ovhtypes.TfStringValue `tfsdk:"shared_email_address"`
+	DisplayName        ovhtypes.TfStringValue `tfsdk:"display_name"`
+	FirstName          ovhtypes.TfStringValue `tfsdk:"first_name"`
+	LastName           ovhtypes.TfStringValue `tfsdk:"last_name"`
+	HiddenFromGAL      ovhtypes.TfBoolValue   `tfsdk:"hidden_from_gal"`
+	Quota              ovhtypes.TfInt64Value  `tfsdk:"quota"`
+	CurrentUsage       ovhtypes.TfInt64Value  `tfsdk:"current_usage"`
+	State              ovhtypes.TfStringValue `tfsdk:"state"`
+}
+
+// ToOpts returns the payload of a shared account creation, or of an update when
+// withAddress is false as the address can't be changed.
+func (m *emailExchangeSharedAccountModel) ToOpts(withAddress bool) *EmailExchangeSharedAccountOpts {
+	opts := &EmailExchangeSharedAccountOpts{
+		DisplayName:   tfStringPtr(m.DisplayName),
+		FirstName:     tfStringPtr(m.FirstName),
+		LastName:      tfStringPtr(m.LastName),
+		HiddenFromGAL: m.HiddenFromGAL.ValueBool(),
+		Quota:         m.Quota.ValueInt64(),
+	}
+	if withAddress {
+		opts.SharedEmailAddress = m.SharedEmailAddress.ValueString()
+	}
+	return opts
+}
+
+func (m *emailExchangeSharedAccountModel) MergeWith(account *EmailExchangeSharedAccount) {
+	m.ID = ovhtypes.NewTfStringValue(account.SharedEmailAddress)
+	m.SharedEmailAddress = ovhtypes.NewTfStringValue(account.SharedEmailAddress)
+	m.DisplayName = tfStringOrNull(account.DisplayName)
+	m.FirstName = tfStringOrNull(account.FirstName)
+	m.LastName = tfStringOrNull(account.LastName)
+	m.HiddenFromGAL = ovhtypes.NewTfBoolValue(account.HiddenFromGAL)
+	m.Quota = ovhtypes.NewTfInt64Value(account.Quota)
+	m.CurrentUsage = ovhtypes.NewTfInt64Value(account.CurrentUsage)
+	m.State = ovhtypes.NewTfStringValue(account.State)
+}
+
+// Distribution groups
+
+// EmailExchangeMailingList is the API representation of email.exchange.MailingList and email.pro.MailingList.
+type EmailExchangeMailingList struct {
+	MailingListAddress     string `json:"mailingListAddress"`
+	DisplayName            string `json:"displayName"`
+	DepartRestriction      string `json:"departRestriction"`
+	JoinRestriction        string `json:"joinRestriction"`
+	MaxReceiveSize         int64  `json:"maxReceiveSize"`
+	MaxSendSize            int64  `json:"maxSendSize"`
+	SenderAuthentification bool   `json:"senderAuthentification"`
+	HiddenFromGAL          bool   `json:"hiddenFromGAL"`
+	State                  string `json:"state"`
+}
+
+type EmailExchangeMailingListOpts struct {
+	MailingListAddress     string  `json:"mailingListAddress,omitempty"`
+	DisplayName            *string `json:"displayName,omitempty"`
+	DepartRestriction      string  `json:"departRestriction"`
+	JoinRestriction        string  `json:"joinRestriction"`
+	MaxReceiveSize         *int64  `json:"maxReceiveSize,omitempty"`
+	MaxSendSize            *int64  `json:"maxSendSize,omitempty"`
+	SenderAuthentification bool    `json:"senderAuthentification"`
+	HiddenFromGAL          bool    `json:"hiddenFromGAL"`
+}
+
+type emailExchangeMailingListModel struct {
+	ID                     ovhtypes.TfStringValue `tfsdk:"id"`
+	OrganizationName       ovhtypes.TfStringValue `tfsdk:"organization_name"`
+	ExchangeService        ovhtypes.TfStringValue `tfsdk:"exchange_service"`
+	MailingListAddress     ovhtypes.TfStringValue `tfsdk:"mailing_list_address"`
+	DisplayName            ovhtypes.TfStringValue `tfsdk:"display_name"`
+	DepartRestriction      ovhtypes.TfStringValue `tfsdk:"depart_restriction"`
+	JoinRestriction        ovhtypes.TfStringValue `tfsdk:"join_restriction"`
+	MaxReceiveSize         ovhtypes.TfInt64Value  `tfsdk:"max_receive_size"`
+	MaxSendSize            ovhtypes.TfInt64Value  `tfsdk:"max_send_size"`
+	SenderAuthentification ovhtypes.TfBoolValue   `tfsdk:"sender_authentification"`
+	HiddenFromGAL          ovhtypes.TfBoolValue   `tfsdk:"hidden_from_gal"`
+	State                  ovhtypes.TfStringValue `tfsdk:"state"`
+}
+
+func tfInt64Ptr(v ovhtypes.TfInt64Value) *int64 {
+	if v.IsNull() || v.IsUnknown() {
+		return nil
+	}
+	i := v.ValueInt64()
+	return &i
+}
+
+// ToOpts returns the payload of a distribution group creation, or of an update when
+// withAddress is false as the address can't be changed.
+func (m *emailExchangeMailingListModel) ToOpts(withAddress bool) *EmailExchangeMailingListOpts {
+	opts := &EmailExchangeMailingListOpts{
+		DisplayName:            tfStringPtr(m.DisplayName),
+		DepartRestriction:      m.DepartRestriction.ValueString(),
+		JoinRestriction:        m.JoinRestriction.ValueString(),
+		MaxReceiveSize:         tfInt64Ptr(m.MaxReceiveSize),
+		MaxSendSize:            tfInt64Ptr(m.MaxSendSize),
+		SenderAuthentification: m.SenderAuthentification.ValueBool(),
+		HiddenFromGAL:          m.HiddenFromGAL.ValueBool(),
+	}
+	if withAddress {
+		opts.MailingListAddress = m.MailingListAddress.ValueString()
+	}
+	return opts
+}
+
+func (m *emailExchangeMailingListModel) MergeWith(mailingList *EmailExchangeMailingList) {
+	m.ID = ovhtypes.NewTfStringValue(mailingList.MailingListAddress)
+	m.MailingListAddress = ovhtypes.NewTfStringValue(mailingList.MailingListAddress)
+	m.DisplayName = tfStringOrNull(mailingList.DisplayName)
+	m.DepartRestriction = ovhtypes.NewTfStringValue(mailingList.DepartRestriction)
+	m.JoinRestriction = ovhtypes.NewTfStringValue(mailingList.JoinRestriction)
+	m.MaxReceiveSize = ovhtypes.NewTfInt64Value(mailingList.MaxReceiveSize)
+	m.MaxSendSize = ovhtypes.NewTfInt64Value(mailingList.MaxSendSize)
+	m.SenderAuthentification = ovhtypes.NewTfBoolValue(mailingList.SenderAuthentification)
+	m.HiddenFromGAL = ovhtypes.NewTfBoolValue(mailingList.HiddenFromGAL)
+	m.State = ovhtypes.NewTfStringValue(mailingList.State)
+}
+
+// External contacts
+
+// EmailExchangeExternalContact is the API representation of email.exchange.ExchangeExternalContact and email.pro.ExternalContact.
+type EmailExchangeExternalContact struct {
+	ExternalEmailAddress string `json:"externalEmailAddress"`
+	DisplayName          string `json:"displayName"`
+	FirstName            string `json:"firstName"`
+	LastName             string `json:"lastName"`
+	Initials             string `json:"initials"`
+	HiddenFromGAL        bool   `json:"hiddenFromGAL"`
+	State                string `json:"state"`
+}
+
+type EmailExchangeExternalContactOpts struct {
+	ExternalEmailAddress string  `json:"externalEmailAddress,omitempty"`
+	DisplayName          *string `json:"displayName,omitempty"`
+	FirstName            *string `json:"firstName,omitempty"`
+	LastName             *string `json:"lastName,omitempty"`
+	Initials             *string `json:"initials,omitempty"`
+	HiddenFromGAL        bool    `json:"hiddenFromGAL"`
+}
+
+type emailExchangeExternalContactModel struct {
+	ID                   ovhtypes.TfStringValue `tfsdk:"id"`
+	OrganizationName     ovhtypes.TfStringValue `tfsdk:"organization_name"`
+	ExchangeService      ovhtypes.TfStringValue `tfsdk:"exchange_service"`
+	ExternalEmailAddress ovhtypes.TfStringValue `tfsdk:"external_email_address"`
+	DisplayName          ovhtypes.TfStringValue `tfsdk:"display_name"`
+	FirstName            ovhtypes.TfStringValue `tfsdk:"first_name"`
+	LastName             ovhtypes.TfStringValue `tfsdk:"last_name"`
+	Initials             ovhtypes.TfStringValue `tfsdk:"initials"`
+	HiddenFromGAL        ovhtypes.TfBoolValue   `tfsdk:"hidden_from_gal"`
+	State                ovhtypes.TfStringValue `tfsdk:"state"`
+}
+
+// ToOpts returns the payload of an external contact creation, or of an update when
+// withAddress is false as the address can't be changed.
+func (m *emailExchangeExternalContactModel) ToOpts(withAddress bool) *EmailExchangeExternalContactOpts {
+	opts := &EmailExchangeExternalContactOpts{
+		DisplayName:   tfStringPtr(m.DisplayName),
+		FirstName:     tfStringPtr(m.FirstName),
+		LastName:      tfStringPtr(m.LastName),
+		Initials:      tfStringPtr(m.Initials),
+		HiddenFromGAL: m.HiddenFromGAL.ValueBool(),
+	}
+	if withAddress {
+		opts.ExternalEmailAddress = m.ExternalEmailAddress.ValueString()
+	}
+	return opts
+}
+
+func (m *emailExchangeExternalContactModel) MergeWith(contact *EmailExchangeExternalContact) {
+	m.ID = ovhtypes.NewTfStringValue(contact.ExternalEmailAddress)
+	m.ExternalEmailAddress = ovhtypes.NewTfStringValue(contact.ExternalEmailAddress)
+	m.DisplayName = tfStringOrNull(contact.DisplayName)
+	m.FirstName = tfStringOrNull(contact.FirstName)
+	m.LastName = tfStringOrNull(contact.LastName)
+	m.Initials = tfStringOrNull(contact.Initials)
+	m.HiddenFromGAL = ovhtypes.NewTfBoolValue(contact.HiddenFromGAL)
+	m.State = ovhtypes.NewTfStringValue(contact.State)
+}
+
+// Domains
+
+// EmailExchangeDomain is the API representation of email.exchange.Domain and email.pro.Domain.
+type EmailExchangeDomain struct {
+	Name    string `json:"name"`
+	Type    string `json:"type"`
+	MxRelay string `json:"mxRelay"`
+	State   string `json:"state"`
+}
+
+type EmailExchangeDomainCreateOpts struct {
+	Name                  string  `json:"name"`
+	Type                  string  `json:"type"`
+	MxRelay               *string `json:"mxRelay,omitempty"`
+	ConfigureMx           bool    `json:"configureMx"`
+	ConfigureAutodiscover bool    `json:"configureAutodiscover"`
+}
+
+type EmailExchangeDomainUpdateOpts struct {
+	Type    string  `json:"type"`
+	MxRelay *string `json:"mxRelay,omitempty"`
+}
+
+type emailExchangeDomainModel struct {
+	ID                    ovhtypes.TfStringValue `tfsdk:"id"`
+	OrganizationName      ovhtypes.TfStringValue `tfsdk:"organization_name"`
+	ExchangeService       ovhtypes.TfStringValue `tfsdk:"exchange_service"`
+	Name                  ovhtypes.TfStringValue `tfsdk:"name"`
+	Type                  ovhtypes.TfStringValue `tfsdk:"type"`
+	MxRelay               ovhtypes.TfStringValue `tfsdk:"mx_relay"`
+	ConfigureMx           ovhtypes.TfBoolValue   `tfsdk:"configure_mx"`
+	ConfigureAutodiscover ovhtypes.TfBoolValue   `tfsdk:"configure_autodiscover"`
+	State                 ovhtypes.TfStringValue `tfsdk:"state"`
+}
+
+func (m *emailExchangeDomainModel) ToCreate() *EmailExchangeDomainCreateOpts {
+	return &EmailExchangeDomainCreateOpts{
+		Name:                  m.Name.ValueString(),
+		Type:                  m.Type.ValueString(),
+		MxRelay:               tfStringPtr(m.MxRelay),
+		ConfigureMx:           m.ConfigureMx.ValueBool(),
+		ConfigureAutodiscover: m.ConfigureAutodiscover.ValueBool(),
+	}
+}
+
+func (m *emailExchangeDomainModel) ToUpdate() *EmailExchangeDomainUpdateOpts {
+	return &EmailExchangeDomainUpdateOpts{
+		Type:    m.Type.ValueString(),
+		MxRelay: tfStringPtr(m.MxRelay),
+	}
+}
+
+func (m *emailExchangeDomainModel) MergeWith(domain *EmailExchangeDomain) {
+	m.ID = ovhtypes.NewTfStringValue(domain.Name)
+	m.Name = ovhtypes.NewTfStringValue(domain.Name)
+	m.Type = ovhtypes.NewTfStringValue(domain.Type)
+	m.MxRelay = tfStringOrNull(domain.MxRelay)
+	m.State = ovhtypes.NewTfStringValue(domain.State)
+}
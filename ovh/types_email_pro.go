@@ -0,0 +1,265 @@
+package ovh
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	ovhtypes "github.com/ovh/terraform-provider-ovh/v2/ovh/types"
+)
+
+// emailProUnconfiguredDomain is the domain of the Email Pro accounts that were
+// ordered but not configured yet.
+const emailProUnconfiguredDomain = "configureme.me"
+
+func emailProServiceEndpoint(serviceName string) string {
+	return "/email/pro/" + url.PathEscape(serviceName)
+}
+
+func isEmailProAccountUnconfigured(primaryEmailAddress string) bool {
+	return strings.HasSuffix(primaryEmailAddress, "@"+emailProUnconfiguredDomain)
+}
+
+// Accounts
+
+// EmailProAccount is the API representation of email.pro.Account.
+type EmailProAccount struct {
+	PrimaryEmailAddress string `json:"primaryEmailAddress"`
+	Login               string `json:"login"`
+	Domain              string `json:"domain"`
+	DisplayName         string `json:"displayName"`
+	FirstName           string `json:"firstName"`
+	LastName            string `json:"lastName"`
+	HiddenFromGAL       bool   `json:"hiddenFromGAL"`
+	Quota               int64  `json:"quota"`
+	CurrentUsage        int64  `json:"currentUsage"`
+	State               string `json:"state"`
+}
+
+type EmailProAccountUpdateOpts struct {
+	Login         string  `json:"login,omitempty"`
+	Domain        string  `json:"domain,omitempty"`
+	DisplayName   *string `json:"displayName,omitempty"`
+	FirstName     *string `json:"firstName,omitempty"`
+	LastName      *string `json:"lastName,omitempty"`
+	HiddenFromGAL bool    `json:"hiddenFromGAL"`
+	Quota         *int64  `json:"quota,omitempty"`
+}
+
+type emailProAccountModel struct {
+	ID                  ovhtypes.TfStringValue `tfsdk:"id"`
+	ServiceName         ovhtypes.TfStringValue `tfsdk:"service_name"`
+	Login               ovhtypes.TfStringValue `tfsdk:"login"`
+	Domain              ovhtypes.TfStringValue `tfsdk:"domain"`
+	Password            ovhtypes.TfStringValue `tfsdk:"password"`
+	DisplayName         ovhtypes.TfStringValue `tfsdk:"display_name"`
+	FirstName           ovhtypes.TfStringValue `tfsdk:"first_name"`
+	LastName            ovhtypes.TfStringValue `tfsdk:"last_name"`
+	HiddenFromGAL       ovhtypes.TfBoolValue   `tfsdk:"hidden_from_gal"`
+	Aliases             types.Set              `tfsdk:"aliases"`
+	PrimaryEmailAddress ovhtypes.TfStringValue `tfsdk:"primary_email_address"`
+	Quota               ovhtypes.TfInt64Value  `tfsdk:"quota"`
+	CurrentUsage        ovhtypes.TfInt64Value  `tfsdk:"current_usage"`
+	State               ovhtypes.TfStringValue `tfsdk:"state"`
+}
+
+// ToUpdate returns the payload configuring the account. The login and the domain
+// are only sent when configuring a new account, as they can't be changed afterwards.
+func (m *emailProAccountModel) ToUpdate(withAddress bool) *EmailProAccountUpdateOpts {
+	opts := &EmailProAccountUpdateOpts{
+		DisplayName:   tfStringPtr(m.DisplayName),
+		FirstName:     tfStringPtr(m.FirstName),
+		LastName:      tfStringPtr(m.LastName),
+		HiddenFromGAL: m.HiddenFromGAL.ValueBool(),
+		Quota:         tfInt64Ptr(m.Quota),
+	}
+	if withAddress {
+		opts.Login = m.Login.ValueString()
+		opts.Domain = m.Domain.ValueString()
+	}
+	return opts
+}
+
+func (m *emailProAccountModel) MergeWith(account *EmailProAccount, aliases []string) {
+	m.ID = ovhtypes.NewTfStringValue(account.PrimaryEmailAddress)
+	m.PrimaryEmailAddress = ovhtypes.NewTfStringValue(account.PrimaryEmailAddress)
+	m.Login = ovhtypes.NewTfStringValue(account.Login)
+	m.Domain = ovhtypes.NewTfStringValue(account.Domain)
+	m.DisplayName = tfStringOrNull(account.DisplayName)
+	m.FirstName = tfStringOrNull(account.FirstName)
+	m.LastName = tfStringOrNull(account.LastName)
+	m.HiddenFromGAL = ovhtypes.NewTfBoolValue(account.HiddenFromGAL)
+	m.Aliases = emailExchangeAliasesToSet(aliases)
+	m.Quota = ovhtypes.NewTfInt64Value(account.Quota)
+	m.CurrentUsage = ovhtypes.NewTfInt64Value(account.CurrentUsage)
+	m.State = ovhtypes.NewTfStringValue(account.State)
+}
+
+// Domains
+
+type emailProDomainModel struct {
+	ID                    ovhtypes.TfStringValue `tfsdk:"id"`
+	ServiceName           ovhtypes.TfStringValue `tfsdk:"service_name"`
+	Name                  ovhtypes.TfStringValue `tfsdk:"name"`
+	Type                  ovhtypes.TfStringValue `tfsdk:"type"`
+	MxRelay               ovhtypes.TfStringValue `tfsdk:"mx_relay"`
+	ConfigureMx           ovhtypes.TfBoolValue   `tfsdk:"configure_mx"`
+	ConfigureAutodiscover ovhtypes.TfBoolValue   `tfsdk:"configure_autodiscover"`
+	State                 ovhtypes.TfStringValue `tfsdk:"state"`
+}
+
+func (m *emailProDomainModel) ToCreate() *EmailExchangeDomainCreateOpts {
+	return &EmailExchangeDomainCreateOpts{
+		Name:                  m.Name.ValueString(),
+		Type:                  m.Type.ValueString(),
+		MxRelay:               tfStringPtr(m.MxRelay),
+		ConfigureMx:           m.ConfigureMx.ValueBool(),
+		ConfigureAutodiscover: m.ConfigureAutodiscover.ValueBool(),
+	}
+}
+
+func (m *emailProDomainModel) ToUpdate() *EmailExchangeDomainUpdateOpts {
+	return &EmailExchangeDomainUpdateOpts{
+		Type:    m.Type.ValueString(),
+		MxRelay: tfStringPtr(m.MxRelay),
+	}
+}
+
+func (m *emailProDomainModel) MergeWith(domain *EmailExchangeDomain) {
+	m.ID = ovhtypes.NewTfStringValue(domain.Name)
+	m.Name = ovhtypes.NewTfStringValue(domain.Name)
+	m.Type = ovhtypes.NewTfStringValue(domain.Type)
+	m.MxRelay = tfStringOrNull(domain.MxRelay)
+	m.State = ovhtypes.NewTfStringValue(domain.State)
+}
+
+// Shared accounts
+
+type emailProSharedAccountModel struct {
+	ID                 ovhtypes.TfStringValue `tfsdk:"id"`
+	ServiceName        ovhtypes.TfStringValue `tfsdk:"service_name"`
+	SharedEmailAddress ovhtypes.TfStringValue `tfsdk:"shared_email_address"`
+	DisplayName        ovhtypes.TfStringValue `tfsdk:"display_name"`
+	FirstName          ovhtypes.TfStringValue `tfsdk:"first_name"`
+	LastName           ovhtypes.TfStringValue `tfsdk:"last_name"`
+	HiddenFromGAL      ovhtypes.TfBoolValue   `tfsdk:"hidden_from_gal"`
+	Quota              ovhtypes.TfInt64Value  `tfsdk:"quota"`
+	CurrentUsage       ovhtypes.TfInt64Value  `tfsdk:"current_usage"`
+	State              ovhtypes.TfStringValue `tfsdk:"state"`
+}
+
+// ToOpts returns the payload of a shared account creation, or of an update when
+// withAddress is false as the address can't be changed.
+func (m *emailProSharedAccountModel) ToOpts(withAddress bool) *EmailExchangeSharedAccountOpts {
+	opts := &EmailExchangeSharedAccountOpts{
+		DisplayName:   tfStringPtr(m.DisplayName),
+		FirstName:     tfStringPtr(m.FirstName),
+		LastName:      tfStringPtr(m.LastName),
+		HiddenFromGAL: m.HiddenFromGAL.ValueBool(),
+		Quota:         m.Quota.ValueInt64(),
+	}
+	if withAddress {
+		opts.SharedEmailAddress = m.SharedEmailAddress.ValueString()
+	}
+	return opts
+}
+
+func (m *emailProSharedAccountModel) MergeWith(account *EmailExchangeSharedAccount) {
+	m.ID = ovhtypes.NewTfStringValue(account.SharedEmailAddress)
+	m.SharedEmailAddress = ovhtypes.NewTfStringValue(account.SharedEmailAddress)
+	m.DisplayName = tfStringOrNull(account.DisplayName)
+	m.FirstName = tfStringOrNull(account.FirstName)
+	m.LastName = tfStringOrNull(account.LastName)
+	m.HiddenFromGAL = ovhtypes.NewTfBoolValue(account.HiddenFromGAL)
+	m.Quota = ovhtypes.NewTfInt64Value(account.Quota)
+	m.CurrentUsage = ovhtypes.NewTfInt64Value(account.CurrentUsage)
+	m.State = ovhtypes.NewTfStringValue(account.State)
+}
+
+// Distribution groups
+
+type emailProMailingListModel struct {
+	ID                     ovhtypes.TfStringValue `tfsdk:"id"`
+	ServiceName            ovhtypes.TfStringValue `tfsdk:"service_name"`
+	MailingListAddress     ovhtypes.TfStringValue `tfsdk:"mailing_list_address"`
+	DisplayName            ovhtypes.TfStringValue `tfsdk:"display_name"`
+	DepartRestriction      ovhtypes.TfStringValue `tfsdk:"depart_restriction"`
+	JoinRestriction        ovhtypes.TfStringValue `tfsdk:"join_restriction"`
+	MaxReceiveSize         ovhtypes.TfInt64Value  `tfsdk:"max_receive_size"`
+	MaxSendSize            ovhtypes.TfInt64Value  `tfsdk:"max_send_size"`
+	SenderAuthentification ovhtypes.TfBoolValue   `tfsdk:"sender_authentification"`
+	HiddenFromGAL          ovhtypes.TfBoolValue   `tfsdk:"hidden_from_gal"`
+	State                  ovhtypes.TfStringValue `tfsdk:"state"`
+}
+
+// ToOpts returns the payload of a distribution group creation, or of an update when
+// withAddress is false as the address can't be changed.
+func (m *emailProMailingListModel) ToOpts(withAddress bool) *EmailExchangeMailingListOpts {
+	opts := &EmailExchangeMailingListOpts{
+		DisplayName:            tfStringPtr(m.DisplayName),
+		DepartRestriction:      m.DepartRestriction.ValueString(),
+		JoinRestriction:        m.JoinRestriction.ValueString(),
+		MaxReceiveSize:         tfInt64Ptr(m.MaxReceiveSize),
+		MaxSendSize:            tfInt64Ptr(m.MaxSendSize),
+		SenderAuthentification: m.SenderAuthentification.ValueBool(),
+		HiddenFromGAL:          m.HiddenFromGAL.ValueBool(),
+	}
+	if withAddress {
+		opts.MailingListAddress = m.MailingListAddress.ValueString()
+	}
+	return opts
+}
+
+func (m *emailProMailingListModel) MergeWith(mailingList *EmailExchangeMailingList) {
+	m.ID = ovhtypes.NewTfStringValue(mailingList.MailingListAddress)
+	m.MailingListAddress = ovhtypes.NewTfStringValue(mailingList.MailingListAddress)
+	m.DisplayName = tfStringOrNull(mailingList.DisplayName)
+	m.DepartRestriction = ovhtypes.NewTfStringValue(mailingList.DepartRestriction)
+	m.JoinRestriction = ovhtypes.NewTfStringValue(mailingList.JoinRestriction)
+	m.MaxReceiveSize = ovhtypes.NewTfInt64Value(mailingList.MaxReceiveSize)
+	m.MaxSendSize = ovhtypes.NewTfInt64Value(mailingList.MaxSendSize)
+	m.SenderAuthentification = ovhtypes.NewTfBoolValue(mailingList.SenderAuthentification)
+	m.HiddenFromGAL = ovhtypes.NewTfBoolValue(mailingList.HiddenFromGAL)
+	m.State = ovhtypes.NewTfStringValue(mailingList.State)
+}
+
+// External contacts
+
+type emailProExternalContactModel struct {
+	ID                   ovhtypes.TfStringValue `tfsdk:"id"`
+	ServiceName          ovhtypes.TfStringValue `tfsdk:"service_name"`
+	ExternalEmailAddress ovhtypes.TfStringValue `tfsdk:"external_email_address"`
+	DisplayName          ovhtypes.TfStringValue `tfsdk:"display_name"`
+	FirstName            ovhtypes.TfStringValue `tfsdk:"first_name"`
+	LastName             ovhtypes.TfStringValue `tfsdk:"last_name"`
+	Initials             ovhtypes.TfStringValue `tfsdk:"initials"`
+	HiddenFromGAL        ovhtypes.TfBoolValue   `tfsdk:"hidden_from_gal"`
+	State                ovhtypes.TfStringValue `tfsdk:"state"`
+}
+
+// ToOpts returns the payload of an external contact creation, or of an update when
+// withAddress is false as the address can't be changed.
+func (m *emailProExternalContactModel) ToOpts(withAddress bool) *EmailExchangeExternalContactOpts {
+	opts := &EmailExchangeExternalContactOpts{
+		DisplayName:   tfStringPtr(m.DisplayName),
+		FirstName:     tfStringPtr(m.FirstName),
+		LastName:      tfStringPtr(m.LastName),
+		Initials:      tfStringPtr(m.Initials),
+		HiddenFromGAL: m.HiddenFromGAL.ValueBool(),
+	}
+	if withAddress {
+		opts.ExternalEmailAddress = m.ExternalEmailAddress.ValueString()
+	}
+	return opts
+}
+
+func (m *emailProExternalContactModel) MergeWith(contact *EmailExchangeExternalContact) {
+	m.ID = ovhtypes.NewTfStringValue(contact.ExternalEmailAddress)
+	m.ExternalEmailAddress = ovhtypes.NewTfStringValue(contact.ExternalEmailAddress)
+	m.DisplayName = tfStringOrNull(contact.DisplayName)
+	m.FirstName = tfStringOrNull(contact.FirstName)
+	m.LastName = tfStringOrNull(contact.LastName)
+	m.Initials = tfStringOrNull(contact.Initials)
+	m.HiddenFromGAL = ovhtypes.NewTfBoolValue(contact.HiddenFromGAL)
+	m.State = ovhtypes.NewTfStringValue(contact.State)
+}
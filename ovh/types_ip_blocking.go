@@ -0,0 +1,99 @@
+package ovh
+
+import (
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	ovhtypes "github.com/ovh/terraform-provider-ovh/v2/ovh/types"
+)
+
+// IpSpamming is an IP blocked by the anti-spam protection
+type IpSpamming struct {
+	Date       string `json:"date"`
+	IpSpamming string `json:"ipSpamming"`
+	State      string `json:"state"`
+	Time       int64  `json:"time"`
+}
+
+type IpSpamStats struct {
+	AverageSpamscore *float64             `json:"averageSpamscore"`
+	NumberOfSpams    int64                `json:"numberOfSpams"`
+	Timestamp        int64                `json:"timestamp"`
+	Total            int64                `json:"total"`
+	DetectedSpams    []IpSpamDetectedSpam `json:"detectedSpams"`
+}
+
+type IpSpamDetectedSpam struct {
+	Date          string `json:"date"`
+	DestinationIp string `json:"destinationIp"`
+	MessageId     string `json:"messageId"`
+	Spamscore     int64  `json:"spamscore"`
+}
+
+// IpBlocked is an IP blocked by the anti-hack protection
+type IpBlocked struct {
+	BlockedSince string `json:"blockedSince"`
+	IpBlocked    string `json:"ipBlocked"`
+	Logs         string `json:"logs"`
+	State        string `json:"state"`
+	Time         int64  `json:"time"`
+}
+
+type ipSpamDataSourceModel struct {
+	Ip         ovhtypes.TfStringValue `tfsdk:"ip"`
+	IpSpamming ovhtypes.TfStringValue `tfsdk:"ip_spamming"`
+	StatsFrom  ovhtypes.TfStringValue `tfsdk:"stats_from"`
+	StatsTo    ovhtypes.TfStringValue `tfsdk:"stats_to"`
+	Blocked    ovhtypes.TfBoolValue   `tfsdk:"blocked"`
+	State      ovhtypes.TfStringValue `tfsdk:"state"`
+	Date       ovhtypes.TfStringValue `tfsdk:"date"`
+	Time       ovhtypes.TfInt64Value  `tfsdk:"time"`
+	Stats      []ipSpamStatsModel     `tfsdk:"stats"`
+}
+
+type ipSpamStatsModel struct {
+	Timestamp        ovhtypes.TfInt64Value     `tfsdk:"timestamp"`
+	Total            ovhtypes.TfInt64Value     `tfsdk:"total"`
+	NumberOfSpams    ovhtypes.TfInt64Value     `tfsdk:"number_of_spams"`
+	AverageSpamscore types.Float64             `tfsdk:"average_spamscore"`
+	DetectedSpams    []ipSpamDetectedSpamModel `tfsdk:"detected_spams"`
+}
+
+type ipSpamDetectedSpamModel struct {
+	Date          ovhtypes.TfStringValue `tfsdk:"date"`
+	DestinationIp ovhtypes.TfStringValue `tfsdk:"destination_ip"`
+	MessageId     ovhtypes.TfStringValue `tfsdk:"message_id"`
+	Spamscore     ovhtypes.TfInt64Value  `tfsdk:"spamscore"`
+}
+
+type ipAntihackDataSourceModel struct {
+	Ip           ovhtypes.TfStringValue `tfsdk:"ip"`
+	IpBlocked    ovhtypes.TfStringValue `tfsdk:"ip_blocked"`
+	Blocked      ovhtypes.TfBoolValue   `tfsdk:"blocked"`
+	State        ovhtypes.TfStringValue `tfsdk:"state"`
+	BlockedSince ovhtypes.TfStringValue `tfsdk:"blocked_since"`
+	Time         ovhtypes.TfInt64Value  `tfsdk:"time"`
+	Logs         ovhtypes.TfStringValue `tfsdk:"logs"`
+}
+
+type ipUnblockModel struct {
+	ID        ovhtypes.TfStringValue `tfsdk:"id"`
+	Ip        ovhtypes.TfStringValue `tfsdk:"ip"`
+	IpBlocked ovhtypes.TfStringValue `tfsdk:"ip_blocked"`
+	Type      ovhtypes.TfStringValue `tfsdk:"type"`
+	Triggers  types.Map              `tfsdk:"triggers"`
+	State     ovhtypes.TfStringValue `tfsdk:"state"`
+}
+
+// blockedState returns the state of a blocked IP for the protection
+// referenced by the model
+func (m *ipUnblockModel) blockedState() string {
+	if m.Type.ValueString() == "spam" {
+		return "blockedForSpam"
+	}
+	return "blocked"
+}
+
+func (m *ipUnblockModel) endpoint() string {
+	return "/ip/" + url.PathEscape(m.Ip.ValueString()) + "/" + m.Type.ValueString() + "/" + url.PathEscape(m.IpBlocked.ValueString())
+}
@@ -0,0 +1,94 @@
+package ovh
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	ovhtypes "github.com/ovh/terraform-provider-ovh/v2/ovh/types"
+)
+
+// IpGameMitigation is the game DDoS protection of an IP
+type IpGameMitigation struct {
+	IpOnGame            string   `json:"ipOnGame"`
+	FirewallModeEnabled bool     `json:"firewallModeEnabled"`
+	State               string   `json:"state"`
+	SupportedProtocols  []string `json:"supportedProtocols"`
+}
+
+type IpGameMitigationUpdateOpts struct {
+	FirewallModeEnabled bool `json:"firewallModeEnabled"`
+}
+
+type IpGameMitigationRulePorts struct {
+	From int64 `json:"from"`
+	To   int64 `json:"to"`
+}
+
+// IpGameMitigationRule is a rule of the game DDoS protection of an IP
+type IpGameMitigationRule struct {
+	Id       int64                     `json:"id"`
+	Ports    IpGameMitigationRulePorts `json:"ports"`
+	Protocol string                    `json:"protocol"`
+	State    string                    `json:"state"`
+}
+
+type IpGameMitigationRuleCreateOpts struct {
+	Ports    IpGameMitigationRulePorts `json:"ports"`
+	Protocol string                    `json:"protocol"`
+}
+
+type ipGameMitigationModel struct {
+	ID                  ovhtypes.TfStringValue `tfsdk:"id"`
+	Ip                  ovhtypes.TfStringValue `tfsdk:"ip"`
+	IpOnGame            ovhtypes.TfStringValue `tfsdk:"ip_on_game"`
+	FirewallModeEnabled ovhtypes.TfBoolValue   `tfsdk:"firewall_mode_enabled"`
+	State               ovhtypes.TfStringValue `tfsdk:"state"`
+	SupportedProtocols  types.List             `tfsdk:"supported_protocols"`
+}
+
+func (m *ipGameMitigationModel) MergeWith(ctx context.Context, game *IpGameMitigation) diag.Diagnostics {
+	m.ID = ovhtypes.NewTfStringValue(m.Ip.ValueString() + "/" + game.IpOnGame)
+	m.IpOnGame = ovhtypes.NewTfStringValue(game.IpOnGame)
+	m.FirewallModeEnabled = ovhtypes.NewTfBoolValue(game.FirewallModeEnabled)
+	m.State = ovhtypes.NewTfStringValue(game.State)
+
+	protocols, diags := types.ListValueFrom(ctx, types.StringType, game.SupportedProtocols)
+	m.SupportedProtocols = protocols
+
+	return diags
+}
+
+type ipGameRuleModel struct {
+	ID       ovhtypes.TfInt64Value  `tfsdk:"id"`
+	Ip       ovhtypes.TfStringValue `tfsdk:"ip"`
+	IpOnGame ovhtypes.TfStringValue `tfsdk:"ip_on_game"`
+	Protocol ovhtypes.TfStringValue `tfsdk:"protocol"`
+	PortFrom ovhtypes.TfInt64Value  `tfsdk:"port_from"`
+	PortTo   ovhtypes.TfInt64Value  `tfsdk:"port_to"`
+	State    ovhtypes.TfStringValue `tfsdk:"state"`
+}
+
+func (m *ipGameRuleModel) ToCreate() *IpGameMitigationRuleCreateOpts {
+	opts := &IpGameMitigationRuleCreateOpts{
+		Protocol: m.Protocol.ValueString(),
+		Ports: IpGameMitigationRulePorts{
+			From: m.PortFrom.ValueInt64(),
+			To:   m.PortFrom.ValueInt64(),
+		},
+	}
+
+	if !m.PortTo.IsNull() && !m.PortTo.IsUnknown() {
+		opts.Ports.To = m.PortTo.ValueInt64()
+	}
+
+	return opts
+}
+
+func (m *ipGameRuleModel) MergeWith(rule *IpGameMitigationRule) {
+	m.ID = ovhtypes.NewTfInt64Value(rule.Id)
+	m.Protocol = ovhtypes.NewTfStringValue(rule.Protocol)
+	m.PortFrom = ovhtypes.NewTfInt64Value(rule.Ports.From)
+	m.PortTo = ovhtypes.NewTfInt64Value(rule.Ports.To)
+	m.State = ovhtypes.NewTfStringValue(rule.State)
+}
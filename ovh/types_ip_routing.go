@@ -0,0 +1,84 @@
+package ovh
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ovh/terraform-provider-ovh/v2/ovh/helpers"
+)
+
+// Kinds of services an IP can be routed to with ovh_ip_routing
+const (
+	IpRoutingKindDedicatedServer    = "dedicatedServer"
+	IpRoutingKindVps                = "vps"
+	IpRoutingKindPublicCloudProject = "publicCloudProject"
+	IpRoutingKindVrack              = "vrack"
+)
+
+var IpRoutingKinds = []string{
+	IpRoutingKindDedicatedServer,
+	IpRoutingKindVps,
+	IpRoutingKindPublicCloudProject,
+	IpRoutingKindVrack,
+}
+
+// ipRoutingKindEndpoints are the endpoints of the services of each kind of
+// destination, used to find the kind of the service an IP block is routed to
+var ipRoutingKindEndpoints = map[string]string{
+	IpRoutingKindDedicatedServer:    "/dedicated/server/%s",
+	IpRoutingKindVps:                "/vps/%s",
+	IpRoutingKindPublicCloudProject: "/cloud/project/%s",
+	IpRoutingKindVrack:              "/vrack/%s",
+}
+
+// IpRoutingDestination is the service an IP is routed to, parsed from its URN
+type IpRoutingDestination struct {
+	Plate       string
+	Kind        string
+	ServiceName string
+	// InstanceId is only set for Public Cloud destinations
+	InstanceId string
+}
+
+// ParseIpRoutingDestination parses a destination URN formatted like
+// urn:v1:<plate>:resource:<kind>:<service_name>. Public Cloud destinations
+// reference an instance of the project with
+// urn:v1:<plate>:resource:publicCloudProject:<project_id>/instance/<instance_id>
+func ParseIpRoutingDestination(urn string) (*IpRoutingDestination, error) {
+	parts := strings.SplitN(urn, ":", 6)
+	if len(parts) != 6 || parts[0] != "urn" || parts[1] != "v1" || parts[3] != "resource" || parts[2] == "" || parts[5] == "" {
+		return nil, fmt.Errorf("%q is not a valid URN, expected urn:v1:<plate>:resource:<kind>:<service_name>", urn)
+	}
+
+	if err := helpers.ValidateStringEnum(parts[4], IpRoutingKinds); err != nil {
+		return nil, fmt.Errorf("unsupported destination kind in %q: %w", urn, err)
+	}
+
+	dest := &IpRoutingDestination{
+		Plate:       parts[2],
+		Kind:        parts[4],
+		ServiceName: parts[5],
+	}
+
+	if dest.Kind == IpRoutingKindPublicCloudProject {
+		project, instance, found := strings.Cut(parts[5], "/instance/")
+		if !found || project == "" || instance == "" || strings.Contains(instance, "/") {
+			return nil, fmt.Errorf("%q must reference an instance, expected urn:v1:<plate>:resource:publicCloudProject:<project_id>/instance/<instance_id>", urn)
+		}
+		dest.ServiceName = project
+		dest.InstanceId = instance
+	} else if strings.Contains(dest.ServiceName, "/") {
+		return nil, fmt.Errorf("%q must reference a %s service, not one of its sub-resources", urn, dest.Kind)
+	}
+
+	return dest, nil
+}
+
+// URN returns the URN of the destination
+func (d *IpRoutingDestination) URN() string {
+	name := d.ServiceName
+	if d.InstanceId != "" {
+		name += "/instance/" + d.InstanceId
+	}
+	return helpers.ServiceURN(d.Plate, d.Kind, name)
+}
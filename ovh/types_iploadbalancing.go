@@ -1,10 +1,17 @@
 package ovh
 
 import (
+	"context"
 	"fmt"
+	"net"
+	"strconv"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/ovh/terraform-provider-ovh/v2/ovh/helpers"
+	ovhtypes "github.com/ovh/terraform-provider-ovh/v2/ovh/types"
 )
 
 type IpLoadbalancing struct {
@@ -15,6 +22,7 @@ type IpLoadbalancing struct {
 	MetricsToken       *string                         `json:"metricsToken,omitempty"`
 	Offer              string                          `json:"offer"`
 	OrderableZones     []*IpLoadbalancingOrderableZone `json:"orderableZone"`
+	Region             *string                         `json:"region,omitempty"`
 	ServiceName        string                          `json:"serviceName"`
 	SslConfiguration   *string                         `json:"sslConfiguration"`
 	State              string                          `json:"state"`
@@ -96,6 +104,10 @@ func (opts *IpLoadbalancingUpdateOpts) FromResource(d *schema.ResourceData) *IpL
 	return opts
 }
 
+type IpLoadbalancingMetricsToken struct {
+	Token string `json:"token"`
+}
+
 type IpLoadbalancingConfirmTerminationOpts struct {
 	Token string `json:"token"`
 }
@@ -674,3 +686,310 @@ func (opts *IpLoadbalancingVrackNetworkUpdateOpts) FromResource(d *schema.Resour
 
 	return opts
 }
+
+// IpLoadbalancingSsl is the API representation of ipLoadbalancing.Ssl.Ssl
+type IpLoadbalancingSsl struct {
+	Id          int64    `json:"id"`
+	DisplayName *string  `json:"displayName"`
+	ExpireDate  string   `json:"expireDate"`
+	Fingerprint string   `json:"fingerprint"`
+	San         []string `json:"san"`
+	Serial      string   `json:"serial"`
+	Subject     string   `json:"subject"`
+	Type        string   `json:"type"`
+}
+
+// coversFqdns returns true if all the given FQDNs are names of the certificate
+func (v IpLoadbalancingSsl) coversFqdns(fqdns []string) bool {
+	names := make(map[string]struct{}, len(v.San)+1)
+	names[v.Subject] = struct{}{}
+	for _, san := range v.San {
+		names[san] = struct{}{}
+	}
+
+	for _, fqdn := range fqdns {
+		if _, ok := names[fqdn]; !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+type IpLoadbalancingFreeCertificateCreateOpts struct {
+	Fqdn []string `json:"fqdn"`
+}
+
+type iploadbalancingFreeCertificateModel struct {
+	Id          ovhtypes.TfInt64Value  `tfsdk:"id"`
+	ServiceName ovhtypes.TfStringValue `tfsdk:"service_name"`
+	Fqdn        types.Set              `tfsdk:"fqdn"`
+	DisplayName ovhtypes.TfStringValue `tfsdk:"display_name"`
+	ExpireDate  ovhtypes.TfStringValue `tfsdk:"expire_date"`
+	Fingerprint ovhtypes.TfStringValue `tfsdk:"fingerprint"`
+	San         types.Set              `tfsdk:"san"`
+	Serial      ovhtypes.TfStringValue `tfsdk:"serial"`
+	Subject     ovhtypes.TfStringValue `tfsdk:"subject"`
+	Type        ovhtypes.TfStringValue `tfsdk:"type"`
+}
+
+func (m *iploadbalancingFreeCertificateModel) MergeWith(ctx context.Context, ssl *IpLoadbalancingSsl) diag.Diagnostics {
+	m.Id = ovhtypes.NewTfInt64Value(ssl.Id)
+	m.ExpireDate = ovhtypes.NewTfStringValue(ssl.ExpireDate)
+	m.Fingerprint = ovhtypes.NewTfStringValue(ssl.Fingerprint)
+	m.Serial = ovhtypes.NewTfStringValue(ssl.Serial)
+	m.Subject = ovhtypes.NewTfStringValue(ssl.Subject)
+	m.Type = ovhtypes.NewTfStringValue(ssl.Type)
+	if ssl.DisplayName != nil {
+		m.DisplayName = ovhtypes.NewTfStringValue(*ssl.DisplayName)
+	} else {
+		m.DisplayName = ovhtypes.NewTfStringNull()
+	}
+
+	san, diags := types.SetValueFrom(ctx, types.StringType, ssl.San)
+	m.San = san
+
+	// fqdn is not known after an import, use the names of the certificate
+	if m.Fqdn.IsNull() || m.Fqdn.IsUnknown() {
+		fqdn, d := types.SetValueFrom(ctx, types.StringType, ssl.San)
+		diags.Append(d...)
+		m.Fqdn = fqdn
+	}
+
+	return diags
+}
+
+var (
+	IpLoadbalancingHttpRouteActionTypes = []string{"farm", "redirect", "reject", "rewrite"}
+	IpLoadbalancingHttpRouteRuleFields  = []string{"cookie", "header", "host", "method", "protocol", "query-param", "source", "uri"}
+	IpLoadbalancingRouteRuleMatches     = []string{"contains", "endswith", "exists", "in", "internal", "is", "matches", "startswith"}
+)
+
+// IpLoadbalancingAvailableRouteAction is an action usable in the routes of a service
+type IpLoadbalancingAvailableRouteAction struct {
+	Name        string  `json:"name"`        // Name of the action
+	Type        string  `json:"type"`        // Protocol of the routes the action is available for
+	Status      []int64 `json:"status"`      // Allowed HTTP status codes, if the action takes one
+	Destination *string `json:"destination"` // Kind of target of the action, if the action takes one
+}
+
+// Validate checks the action against the actions available on the service. If
+// available is empty, only the action type and the presence of its arguments are checked.
+func (opts *IPLoadbalancingHttpRouteActionOpts) Validate(available []IpLoadbalancingAvailableRouteAction) error {
+	if err := helpers.ValidateStringEnum(opts.Type, IpLoadbalancingHttpRouteActionTypes); err != nil {
+		return fmt.Errorf("invalid action type: %w", err)
+	}
+
+	switch opts.Type {
+	case "farm":
+		if opts.Target == nil {
+			return fmt.Errorf("action %q requires the ID of a farm as target", opts.Type)
+		}
+		if _, err := strconv.ParseInt(*opts.Target, 10, 64); err != nil {
+			return fmt.Errorf("action %q requires the ID of a farm as target, got %q", opts.Type, *opts.Target)
+		}
+	case "redirect", "rewrite":
+		if opts.Target == nil || *opts.Target == "" {
+			return fmt.Errorf("action %q requires a target", opts.Type)
+		}
+	case "reject":
+		if opts.Target != nil {
+			return fmt.Errorf("action %q doesn't take a target", opts.Type)
+		}
+	}
+
+	if len(available) == 0 {
+		return nil
+	}
+
+	for _, action := range available {
+		if action.Name != opts.Type || action.Type != "http" {
+			continue
+		}
+
+		if opts.Status == nil {
+			return nil
+		}
+		if len(action.Status) == 0 {
+			return fmt.Errorf("action %q doesn't take a status", opts.Type)
+		}
+		for _, status := range action.Status {
+			if status == *opts.Status {
+				return nil
+			}
+		}
+		return fmt.Errorf("status %d is not allowed for action %q, allowed values are %v", *opts.Status, opts.Type, action.Status)
+	}
+
+	return fmt.Errorf("action %q is not available for HTTP routes on this service", opts.Type)
+}
+
+// ValidateHttp checks that the rule is consistent for an HTTP route
+func (opts *IPLoadbalancingRouteRuleOpts) ValidateHttp() error {
+	if err := helpers.ValidateStringEnum(opts.Field, IpLoadbalancingHttpRouteRuleFields); err != nil {
+		return fmt.Errorf("invalid field: %w", err)
+	}
+	if err := helpers.ValidateStringEnum(opts.Match, IpLoadbalancingRouteRuleMatches); err != nil {
+		return fmt.Errorf("invalid match: %w", err)
+	}
+
+	switch opts.Field {
+	case "cookie", "header", "query-param":
+		if opts.SubField == nil || *opts.SubField == "" {
+			return fmt.Errorf("field %q requires a sub_field", opts.Field)
+		}
+	default:
+		if opts.SubField != nil && *opts.SubField != "" {
+			return fmt.Errorf("field %q doesn't take a sub_field", opts.Field)
+		}
+	}
+
+	switch opts.Match {
+	case "exists":
+		if opts.Pattern != nil && *opts.Pattern != "" {
+			return fmt.Errorf("match %q doesn't take a pattern", opts.Match)
+		}
+		return nil
+	case "internal":
+		return nil
+	}
+
+	if opts.Pattern == nil || *opts.Pattern == "" {
+		return fmt.Errorf("match %q requires a pattern", opts.Match)
+	}
+
+	patterns := []string{*opts.Pattern}
+	if opts.Match == "in" {
+		patterns = strings.Split(*opts.Pattern, ",")
+	}
+
+	for _, pattern := range patterns {
+		pattern = strings.TrimSpace(pattern)
+
+		switch {
+		case opts.Field == "source" && (opts.Match == "is" || opts.Match == "in"):
+			if net.ParseIP(pattern) == nil {
+				if _, _, err := net.ParseCIDR(pattern); err != nil {
+					return fmt.Errorf("field %q requires IP addresses or CIDR blocks as pattern, got %q", opts.Field, pattern)
+				}
+			}
+		case opts.Field == "protocol" && (opts.Match == "is" || opts.Match == "in"):
+			if err := helpers.ValidateStringEnum(pattern, []string{"http", "https"}); err != nil {
+				return fmt.Errorf("invalid protocol pattern: %w", err)
+			}
+		case opts.Field == "method" && (opts.Match == "is" || opts.Match == "in"):
+			if err := helpers.ValidateStringEnum(pattern, []string{"GET", "HEAD", "POST", "PUT", "DELETE", "CONNECT", "OPTIONS", "TRACE", "PATCH"}); err != nil {
+				return fmt.Errorf("invalid method pattern: %w", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (opts *IPLoadbalancingRouteRuleOpts) FromMap(rule map[string]interface{}) *IPLoadbalancingRouteRuleOpts {
+	opts.Field = rule["field"].(string)
+	opts.Match = rule["match"].(string)
+
+	negate := rule["negate"].(bool)
+	opts.Negate = &negate
+
+	if pattern := rule["pattern"].(string); pattern != "" {
+		opts.Pattern = &pattern
+	}
+	if subField := rule["sub_field"].(string); subField != "" {
+		opts.SubField = &subField
+	}
+
+	return opts
+}
+
+// IpLoadbalancingStatus is the live status of the objects of a service
+type IpLoadbalancingStatus struct {
+	Servers []IpLoadbalancingServerStatus `json:"server"`
+}
+
+// IpLoadbalancingServerStatus is the result of the health checks of a farm server in a zone
+type IpLoadbalancingServerStatus struct {
+	Zone     string `json:"zone"`
+	Type     string `json:"type"`
+	FarmId   int64  `json:"farmId"`
+	ServerId int64  `json:"serverId"`
+	Status   string `json:"status"`
+}
+
+// health maps the status of the health checks, as reported in the HAProxy
+// statistics of the load balancer, to whether the server is healthy. A server
+// in transition is suffixed with its checks count (e.g. "UP 1/3" going down,
+// "DOWN 1/2" going up) and keeps its current state. The health is unknown when
+// the server is not checked ("no check") or the status is not documented.
+func (v IpLoadbalancingServerStatus) health() (healthy, known bool) {
+	status := strings.ToUpper(strings.TrimSpace(v.Status))
+
+	switch {
+	case status == "UP" || strings.HasPrefix(status, "UP "):
+		return true, true
+	case status == "DOWN" || strings.HasPrefix(status, "DOWN "),
+		status == "NOLB",
+		status == "DRAIN",
+		strings.HasPrefix(status, "MAINT"):
+		return false, true
+	}
+
+	return false, false
+}
+
+// iploadbalancingServerHealth returns whether a farm server is healthy in all the
+// zones of the load balancer, or nil when it is unknown because the server is
+// not checked by a probe or has no health check result yet
+func iploadbalancingServerHealth(server IpLoadbalancingFarmServer, zones []IpLoadbalancingServerStatus) *bool {
+	healthy := false
+
+	// An inactive server doesn't get any traffic, whatever its checks
+	if server.Status != "active" {
+		return &healthy
+	}
+	if server.Probe == nil || !*server.Probe || len(zones) == 0 {
+		return nil
+	}
+
+	known := true
+	for _, zone := range zones {
+		zoneHealthy, zoneKnown := zone.health()
+		if zoneKnown && !zoneHealthy {
+			return &healthy
+		}
+		known = known && zoneKnown
+	}
+	if !known {
+		return nil
+	}
+
+	healthy = true
+	return &healthy
+}
+
+type iploadbalancingFarmHealthDataSourceModel struct {
+	ServiceName    ovhtypes.TfStringValue                 `tfsdk:"service_name"`
+	FarmType       ovhtypes.TfStringValue                 `tfsdk:"farm_type"`
+	FarmId         ovhtypes.TfInt64Value                  `tfsdk:"farm_id"`
+	AllHealthy     ovhtypes.TfBoolValue                   `tfsdk:"all_healthy"`
+	HealthyServers ovhtypes.TfInt64Value                  `tfsdk:"healthy_servers"`
+	Servers        []iploadbalancingFarmServerHealthModel `tfsdk:"servers"`
+}
+
+type iploadbalancingFarmServerHealthModel struct {
+	ServerId    ovhtypes.TfInt64Value            `tfsdk:"server_id"`
+	Address     ovhtypes.TfStringValue           `tfsdk:"address"`
+	Port        ovhtypes.TfInt64Value            `tfsdk:"port"`
+	DisplayName ovhtypes.TfStringValue           `tfsdk:"display_name"`
+	Status      ovhtypes.TfStringValue           `tfsdk:"status"`
+	Probe       ovhtypes.TfBoolValue             `tfsdk:"probe"`
+	Healthy     ovhtypes.TfBoolValue             `tfsdk:"healthy"`
+	Zones       []iploadbalancingZoneHealthModel `tfsdk:"zones"`
+}
+
+type iploadbalancingZoneHealthModel struct {
+	Zone   ovhtypes.TfStringValue `tfsdk:"zone"`
+	Status ovhtypes.TfStringValue `tfsdk:"status"`
+}
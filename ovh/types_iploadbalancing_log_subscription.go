@@ -0,0 +1,51 @@
+package ovh
+
+import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+type IpLoadbalancingLogSubscriptionCreateOpts struct {
+	Kind     string `json:"kind"`
+	StreamId string `json:"streamId"`
+}
+
+func (opts *IpLoadbalancingLogSubscriptionCreateOpts) FromResource(d *schema.ResourceData) *IpLoadbalancingLogSubscriptionCreateOpts {
+	opts.Kind = d.Get("kind").(string)
+	opts.StreamId = d.Get("stream_id").(string)
+	return opts
+}
+
+// IpLoadbalancingLogSubscriptionOperation is returned by the API when creating or
+// deleting a subscription, the operation being tracked on the Logs Data Platform service
+type IpLoadbalancingLogSubscriptionOperation struct {
+	OperationId string `json:"operationId"`
+	ServiceName string `json:"serviceName"`
+}
+
+type IpLoadbalancingLogSubscriptionResource struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+type IpLoadbalancingLogSubscription struct {
+	CreatedAt      string                                 `json:"createdAt"`
+	Kind           string                                 `json:"kind"`
+	Resource       IpLoadbalancingLogSubscriptionResource `json:"resource"`
+	LDPServiceName string                                 `json:"serviceName"`
+	StreamId       string                                 `json:"streamId"`
+	SubscriptionId string                                 `json:"subscriptionId"`
+	UpdatedAt      string                                 `json:"updatedAt"`
+}
+
+func (v IpLoadbalancingLogSubscription) ToMap() map[string]interface{} {
+	obj := make(map[string]interface{})
+
+	obj["created_at"] = v.CreatedAt
+	obj["kind"] = v.Kind
+	obj["resource_name"] = v.Resource.Name
+	obj["resource_type"] = v.Resource.Type
+	obj["ldp_service_name"] = v.LDPServiceName
+	obj["stream_id"] = v.StreamId
+	obj["subscription_id"] = v.SubscriptionId
+	obj["updated_at"] = v.UpdatedAt
+
+	return obj
+}
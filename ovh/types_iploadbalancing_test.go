@@ -0,0 +1,86 @@
+package ovh
+
+import (
+	"testing"
+)
+
+func TestIPLoadbalancingHttpRouteActionOptsValidate(t *testing.T) {
+	farm := "42"
+	url := "https://${host}${path}"
+	urlDestination := "url"
+	status301 := int64(301)
+	status404 := int64(404)
+
+	available := []IpLoadbalancingAvailableRouteAction{
+		{Name: "farm", Type: "http", Destination: &farm},
+		{Name: "redirect", Type: "http", Status: []int64{301, 302, 303, 307, 308}, Destination: &urlDestination},
+		{Name: "reject", Type: "http", Status: []int64{200, 400, 403, 405, 408, 429, 500, 502, 503, 504}},
+		{Name: "reject", Type: "tcp"},
+	}
+
+	tests := []struct {
+		name      string
+		opts      IPLoadbalancingHttpRouteActionOpts
+		available []IpLoadbalancingAvailableRouteAction
+		wantErr   bool
+	}{
+		{name: "farm", opts: IPLoadbalancingHttpRouteActionOpts{Type: "farm", Target: &farm}, available: available},
+		{name: "farm without target", opts: IPLoadbalancingHttpRouteActionOpts{Type: "farm"}, wantErr: true},
+		{name: "farm with url", opts: IPLoadbalancingHttpRouteActionOpts{Type: "farm", Target: &url}, wantErr: true},
+		{name: "redirect", opts: IPLoadbalancingHttpRouteActionOpts{Type: "redirect", Target: &url, Status: &status301}, available: available},
+		{name: "redirect with invalid status", opts: IPLoadbalancingHttpRouteActionOpts{Type: "redirect", Target: &url, Status: &status404}, available: available, wantErr: true},
+		{name: "reject with target", opts: IPLoadbalancingHttpRouteActionOpts{Type: "reject", Target: &url}, wantErr: true},
+		{name: "farm with status", opts: IPLoadbalancingHttpRouteActionOpts{Type: "farm", Target: &farm, Status: &status301}, available: available, wantErr: true},
+		{name: "rewrite not available", opts: IPLoadbalancingHttpRouteActionOpts{Type: "rewrite", Target: &url}, available: available, wantErr: true},
+		{name: "unknown type", opts: IPLoadbalancingHttpRouteActionOpts{Type: "drop"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.opts.Validate(tt.available)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestIPLoadbalancingRouteRuleOptsValidateHttp(t *testing.T) {
+	rule := func(field, match, subField, pattern string) IPLoadbalancingRouteRuleOpts {
+		return *(&IPLoadbalancingRouteRuleOpts{}).FromMap(map[string]interface{}{
+			"field":     field,
+			"match":     match,
+			"negate":    false,
+			"sub_field": subField,
+			"pattern":   pattern,
+		})
+	}
+
+	tests := []struct {
+		name    string
+		opts    IPLoadbalancingRouteRuleOpts
+		wantErr bool
+	}{
+		{name: "host", opts: rule("host", "is", "", "www.example.com")},
+		{name: "header", opts: rule("header", "exists", "X-Forwarded-For", "")},
+		{name: "header without sub field", opts: rule("header", "is", "", "value"), wantErr: true},
+		{name: "host with sub field", opts: rule("host", "is", "Host", "www.example.com"), wantErr: true},
+		{name: "exists with pattern", opts: rule("cookie", "exists", "session", "value"), wantErr: true},
+		{name: "missing pattern", opts: rule("uri", "startswith", "", ""), wantErr: true},
+		{name: "source list", opts: rule("source", "in", "", "10.0.0.0/8, 192.168.1.1")},
+		{name: "invalid source", opts: rule("source", "is", "", "10.0.0.0/33"), wantErr: true},
+		{name: "protocol", opts: rule("protocol", "is", "", "https")},
+		{name: "invalid protocol", opts: rule("protocol", "is", "", "udp"), wantErr: true},
+		{name: "invalid method", opts: rule("method", "in", "", "GET,FETCH"), wantErr: true},
+		{name: "unknown field", opts: rule("path", "is", "", "/"), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.opts.ValidateHttp()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateHttp() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}